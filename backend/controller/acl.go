@@ -0,0 +1,39 @@
+package controller
+
+import (
+	"fmt"
+
+	"github.com/TBD54566975/ftl/backend/schema"
+)
+
+// checkCallACL ensures that the immediate caller of a Verb, if any, declared
+// the call in its schema via MetadataCalls.
+//
+// Calls without a caller originate outside the Verb graph (eg. ingress, cron
+// or pubsub delivery) and are always allowed.
+func checkCallACL(sch *schema.Schema, callers []*schema.VerbRef, callee *schema.VerbRef) error {
+	if len(callers) == 0 {
+		return nil
+	}
+	caller := callers[len(callers)-1]
+	verb := sch.ResolveVerbRef(caller)
+	if verb == nil {
+		return fmt.Errorf("call to %s from unknown verb %s", callee, caller)
+	}
+	for _, metadata := range verb.Metadata {
+		calls, ok := metadata.(*schema.MetadataCalls)
+		if !ok {
+			continue
+		}
+		for _, call := range calls.Calls {
+			module := call.Module
+			if module == "" {
+				module = caller.Module
+			}
+			if module == callee.Module && call.Name == callee.Name {
+				return nil
+			}
+		}
+	}
+	return fmt.Errorf("verb %s does not declare a call to %s", caller, callee)
+}
@@ -0,0 +1,54 @@
+package controller
+
+import (
+	"testing"
+
+	"github.com/alecthomas/assert/v2"
+
+	"github.com/TBD54566975/ftl/backend/schema"
+)
+
+func TestCheckCallACL(t *testing.T) {
+	sch, err := schema.ParseString("", `
+		module echo {
+			verb echo(Unit) Unit
+				calls time.time, echo.internal
+			verb internal(Unit) Unit
+		}
+		module time {
+			verb time(Unit) Unit
+			verb secret(Unit) Unit
+		}
+	`)
+	assert.NoError(t, err)
+
+	echo := &schema.VerbRef{Module: "echo", Name: "echo"}
+	tests := []struct {
+		name    string
+		callers []*schema.VerbRef
+		callee  *schema.VerbRef
+		err     string
+	}{
+		{name: "NoCaller", callee: &schema.VerbRef{Module: "time", Name: "secret"}},
+		{name: "Declared", callers: []*schema.VerbRef{echo}, callee: &schema.VerbRef{Module: "time", Name: "time"}},
+		{name: "DeclaredLocal", callers: []*schema.VerbRef{echo}, callee: &schema.VerbRef{Module: "echo", Name: "internal"}},
+		{name: "Undeclared", callers: []*schema.VerbRef{echo}, callee: &schema.VerbRef{Module: "time", Name: "secret"},
+			err: "verb echo.echo does not declare a call to time.secret"},
+		{name: "ImmediateCaller",
+			callers: []*schema.VerbRef{echo, {Module: "time", Name: "time"}},
+			callee:  &schema.VerbRef{Module: "echo", Name: "internal"},
+			err:     "verb time.time does not declare a call to echo.internal"},
+		{name: "UnknownCaller", callers: []*schema.VerbRef{{Module: "echo", Name: "missing"}}, callee: &schema.VerbRef{Module: "time", Name: "time"},
+			err: "call to time.time from unknown verb echo.missing"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := checkCallACL(sch, test.callers, test.callee)
+			if test.err == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, test.err)
+			}
+		})
+	}
+}
@@ -0,0 +1,77 @@
+package controller
+
+import (
+	"errors"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/jpillora/backoff"
+
+	"github.com/TBD54566975/ftl/backend/controller/dal"
+	"github.com/TBD54566975/ftl/backend/schema"
+	"github.com/TBD54566975/ftl/internal/rpc/headers"
+)
+
+// callPolicy is the retry and timeout policy applied by the controller to
+// calls to a Verb, derived from the Verb's MetadataRetry and MetadataTimeout.
+type callPolicy struct {
+	// attempts is the maximum number of attempts, including the first.
+	attempts int
+	backoff  backoff.Backoff
+	// timeout of each attempt at a call, or zero if unbounded.
+	timeout time.Duration
+}
+
+// callPolicyForVerb returns the call policy of a Verb.
+//
+// Verbs without retry metadata are attempted once, and verbs without timeout
+// metadata are unbounded.
+func callPolicyForVerb(sch *schema.Schema, ref *schema.VerbRef) callPolicy {
+	policy := callPolicy{attempts: 1}
+	verb := sch.ResolveVerbRef(ref)
+	if verb == nil {
+		return policy
+	}
+	for _, md := range verb.Metadata {
+		switch md := md.(type) {
+		case *schema.MetadataRetry:
+			// The schema has been validated, so errors are not possible here.
+			minBackoff, maxBackoff, err := md.Backoff()
+			if err != nil {
+				continue
+			}
+			policy.attempts = md.Count + 1
+			policy.backoff = backoff.Backoff{Min: minBackoff, Max: maxBackoff, Factor: 2, Jitter: true}
+
+		case *schema.MetadataTimeout:
+			if timeout, err := md.Duration(); err == nil {
+				policy.timeout = timeout
+			}
+
+		default:
+		}
+	}
+	return policy
+}
+
+// nextRoute picks a route weighted by deployment, preferring routes other than
+// the one that last failed. Returns false if there are no routes.
+func nextRoute(routes []dal.Route, failed string) (dal.Route, bool) {
+	candidates := make([]dal.Route, 0, len(routes))
+	for _, route := range routes {
+		if route.Endpoint != failed {
+			candidates = append(candidates, route)
+		}
+	}
+	if len(candidates) == 0 {
+		candidates = routes
+	}
+	return dal.PickRoute(candidates)
+}
+
+// isDrainRejection returns true if a Runner rejected a call because the
+// deployment it was routed to is draining.
+func isDrainRejection(err error) bool {
+	var connectErr *connect.Error
+	return errors.As(err, &connectErr) && connectErr.Code() == connect.CodeUnavailable && headers.IsDraining(connectErr.Meta())
+}
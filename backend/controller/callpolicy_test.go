@@ -0,0 +1,68 @@
+package controller
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/alecthomas/assert/v2"
+
+	"github.com/TBD54566975/ftl/backend/controller/dal"
+	"github.com/TBD54566975/ftl/backend/schema"
+	"github.com/TBD54566975/ftl/internal/rpc/headers"
+)
+
+func TestCallPolicyForVerb(t *testing.T) {
+	sch, err := schema.ParseString("", `
+		module payments {
+			verb charge(Unit) Unit
+				retry 3 backoff 100ms..2s
+				timeout 5s
+			verb refund(Unit) Unit
+				retry 2
+			verb status(Unit) Unit
+		}
+	`)
+	assert.NoError(t, err)
+
+	charge := callPolicyForVerb(sch, &schema.VerbRef{Module: "payments", Name: "charge"})
+	assert.Equal(t, 4, charge.attempts)
+	assert.Equal(t, 100*time.Millisecond, charge.backoff.Min)
+	assert.Equal(t, 2*time.Second, charge.backoff.Max)
+	assert.Equal(t, 5*time.Second, charge.timeout)
+
+	refund := callPolicyForVerb(sch, &schema.VerbRef{Module: "payments", Name: "refund"})
+	assert.Equal(t, 3, refund.attempts)
+	assert.Equal(t, schema.DefaultMinRetryBackoff, refund.backoff.Min)
+	assert.Equal(t, schema.DefaultMaxRetryBackoff, refund.backoff.Max)
+	assert.Equal(t, time.Duration(0), refund.timeout)
+
+	status := callPolicyForVerb(sch, &schema.VerbRef{Module: "payments", Name: "status"})
+	assert.Equal(t, callPolicy{attempts: 1}, status)
+}
+
+func TestNextRoute(t *testing.T) {
+	routes := []dal.Route{{Endpoint: "http://a"}, {Endpoint: "http://b"}}
+	for range 10 {
+		route, ok := nextRoute(routes, "http://a")
+		assert.True(t, ok)
+		assert.Equal(t, "http://b", route.Endpoint)
+	}
+	// Falls back to the failed route if there is no other.
+	route, ok := nextRoute(routes[:1], "http://a")
+	assert.True(t, ok)
+	assert.Equal(t, "http://a", route.Endpoint)
+
+	_, ok = nextRoute(nil, "")
+	assert.False(t, ok)
+}
+
+func TestIsDrainRejection(t *testing.T) {
+	err := connect.NewError(connect.CodeUnavailable, errors.New("draining"))
+	assert.False(t, isDrainRejection(err))
+	headers.SetDraining(err.Meta())
+	assert.True(t, isDrainRejection(fmt.Errorf("call failed: %w", err)))
+	assert.False(t, isDrainRejection(errors.New("draining")))
+}
@@ -0,0 +1,113 @@
+package controller
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"connectrpc.com/connect"
+
+	"github.com/TBD54566975/ftl/backend/controller/dal"
+	ftlv1 "github.com/TBD54566975/ftl/backend/protos/xyz/block/ftl/v1"
+	"github.com/TBD54566975/ftl/internal/log"
+	"github.com/TBD54566975/ftl/internal/model"
+)
+
+func (s *Service) CanaryDeploy(ctx context.Context, c *connect.Request[ftlv1.CanaryDeployRequest]) (*connect.Response[ftlv1.CanaryDeployResponse], error) {
+	deploymentName, err := model.ParseDeploymentName(c.Msg.DeploymentName)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	if c.Msg.Weight < 0 || c.Msg.Weight > 100 {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("canary weight must be between 0 and 100, not %d", c.Msg.Weight))
+	}
+
+	logger := s.getDeploymentLogger(ctx, deploymentName)
+	logger.Debugf("Canary deployment %s at %d%%", deploymentName, c.Msg.Weight)
+
+	if !c.Msg.Force {
+		if err := s.checkDeploymentCompatibility(ctx, deploymentName); err != nil {
+			if errors.Is(err, dal.ErrNotFound) {
+				return nil, connect.NewError(connect.CodeNotFound, errors.New("deployment not found"))
+			}
+			logger.Errorf(err, "Incompatible canary deployment: %s", deploymentName)
+			return nil, err
+		}
+	}
+
+	err = s.dal.StartCanaryDeployment(ctx, deploymentName, int(c.Msg.MinReplicas), int(c.Msg.Weight))
+	if err != nil {
+		if errors.Is(err, dal.ErrNotFound) {
+			return nil, connect.NewError(connect.CodeNotFound, errors.New("deployment not found"))
+		} else if errors.Is(err, dal.ErrConflict) {
+			return nil, connect.NewError(connect.CodeAlreadyExists, err)
+		}
+		logger.Errorf(err, "Could not start canary deployment: %s", deploymentName)
+		return nil, fmt.Errorf("%s: %w", "could not start canary deployment", err)
+	}
+	return connect.NewResponse(&ftlv1.CanaryDeployResponse{}), nil
+}
+
+func (s *Service) SetCanaryWeight(ctx context.Context, c *connect.Request[ftlv1.SetCanaryWeightRequest]) (*connect.Response[ftlv1.SetCanaryWeightResponse], error) {
+	deploymentName, err := model.ParseDeploymentName(c.Msg.DeploymentName)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	if c.Msg.Weight < 0 || c.Msg.Weight > 100 {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("canary weight must be between 0 and 100, not %d", c.Msg.Weight))
+	}
+
+	logger := s.getDeploymentLogger(ctx, deploymentName)
+	logger.Debugf("Setting canary weight of %s to %d%%", deploymentName, c.Msg.Weight)
+
+	// A weight of 100 promotes the canary.
+	if c.Msg.Weight == 100 && !c.Msg.Force {
+		if err := s.checkDeploymentCompatibility(ctx, deploymentName); err != nil {
+			if errors.Is(err, dal.ErrNotFound) {
+				return nil, connect.NewError(connect.CodeNotFound, err)
+			}
+			logger.Errorf(err, "Incompatible canary deployment: %s", deploymentName)
+			return nil, err
+		}
+	}
+
+	err = s.dal.SetCanaryWeight(ctx, deploymentName, int(c.Msg.Weight))
+	if err != nil {
+		if errors.Is(err, dal.ErrNotFound) {
+			return nil, connect.NewError(connect.CodeNotFound, err)
+		}
+		logger.Errorf(err, "Could not set canary weight: %s", deploymentName)
+		return nil, fmt.Errorf("%s: %w", "could not set canary weight", err)
+	}
+	return connect.NewResponse(&ftlv1.SetCanaryWeightResponse{}), nil
+}
+
+// Roll back canary deployments whose error rate exceeds the threshold.
+func (s *Service) rollbackFailingCanaries(ctx context.Context) (time.Duration, error) {
+	logger := log.FromContext(ctx)
+	canaries, err := s.dal.GetCanaryDeployments(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("%s: %w", "failed to get canary deployments", err)
+	}
+	for _, canary := range canaries {
+		stats, err := s.dal.GetDeploymentCallStats(ctx, canary.Deployment, time.Now().Add(-s.config.CanaryAnalysisWindow))
+		if err != nil {
+			return 0, fmt.Errorf("%s: %w", "failed to get canary call statistics", err)
+		}
+		if !canaryFailing(stats, s.config.CanaryMinCalls, s.config.CanaryErrorThreshold) {
+			continue
+		}
+		logger.Warnf("Rolling back canary %s: %d of %d calls failed", canary.Deployment, stats.Errors, stats.Calls)
+		if err := s.dal.RollbackCanaryDeployment(ctx, canary.Deployment); err != nil && !errors.Is(err, dal.ErrNotFound) {
+			return 0, fmt.Errorf("%s: %w", "failed to roll back canary", err)
+		}
+	}
+	return time.Second * 10, nil
+}
+
+// canaryFailing returns true if a canary has received at least minCalls calls
+// and the fraction of them that failed exceeds threshold.
+func canaryFailing(stats dal.CallStats, minCalls int, threshold float64) bool {
+	return stats.Calls >= minCalls && stats.ErrorRate() > threshold
+}
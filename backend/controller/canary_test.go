@@ -0,0 +1,16 @@
+package controller
+
+import (
+	"testing"
+
+	"github.com/alecthomas/assert/v2"
+
+	"github.com/TBD54566975/ftl/backend/controller/dal"
+)
+
+func TestCanaryFailing(t *testing.T) {
+	assert.False(t, canaryFailing(dal.CallStats{Calls: 5, Errors: 5}, 10, 0.1))
+	assert.False(t, canaryFailing(dal.CallStats{Calls: 100, Errors: 10}, 10, 0.1))
+	assert.True(t, canaryFailing(dal.CallStats{Calls: 100, Errors: 11}, 10, 0.1))
+	assert.False(t, canaryFailing(dal.CallStats{}, 0, 0.1))
+}
@@ -0,0 +1,52 @@
+package controller
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"connectrpc.com/connect"
+
+	"github.com/TBD54566975/ftl/backend/schema"
+	"github.com/TBD54566975/ftl/internal/model"
+	"github.com/TBD54566975/ftl/internal/slices"
+)
+
+// checkDeploymentCompatibility returns a FailedPrecondition error if activating
+// a deployment would make breaking changes to declarations of its module that
+// other modules consume.
+func (s *Service) checkDeploymentCompatibility(ctx context.Context, name model.DeploymentName) error {
+	deployment, err := s.dal.GetDeployment(ctx, name)
+	if err != nil {
+		return err
+	}
+	existingModules, err := s.dal.GetActiveDeploymentSchemas(ctx)
+	if err != nil {
+		return fmt.Errorf("%s: %w", "could not get existing schemas", err)
+	}
+	if err := checkCompatibility(existingModules, deployment.Schema); err != nil {
+		return connect.NewError(connect.CodeFailedPrecondition, err)
+	}
+	return nil
+}
+
+// checkCompatibility returns an error if module makes breaking changes to
+// declarations of the active deployment of the module that other modules
+// consume.
+func checkCompatibility(existingModules []*schema.Module, module *schema.Module) error {
+	existing, ok := slices.Find(existingModules, func(m *schema.Module) bool { return m.Name == module.Name })
+	if !ok {
+		return nil
+	}
+	consumed := schema.ConsumedDecls(existing, existingModules)
+	errs := []error{}
+	for _, change := range schema.BreakingChanges(schema.Diff(existing, module)) {
+		if consumed[change.Decl.Name] {
+			errs = append(errs, errors.New(change.String()))
+		}
+	}
+	if len(errs) > 0 {
+		return fmt.Errorf("breaking changes to declarations used by other modules (use --force to deploy anyway): %w", errors.Join(errs...))
+	}
+	return nil
+}
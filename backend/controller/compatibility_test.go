@@ -0,0 +1,64 @@
+package controller
+
+import (
+	"testing"
+
+	"github.com/alecthomas/assert/v2"
+
+	"github.com/TBD54566975/ftl/backend/schema"
+)
+
+func TestCheckCompatibility(t *testing.T) {
+	sch, err := schema.ParseString("", `
+		module echo {
+			data EchoRequest {
+				name String
+			}
+			data Internal {
+				value String
+			}
+			verb echo(echo.EchoRequest) Unit
+			verb internal(echo.Internal) Unit
+		}
+		module caller {
+			verb call(Unit) Unit
+				calls echo.echo
+		}
+	`)
+	assert.NoError(t, err)
+
+	// Breaking changes to declarations no other module uses are allowed.
+	module, err := schema.ParseModuleString("", `
+		module echo {
+			data EchoRequest {
+				name String
+				nickname String?
+			}
+			data Internal {
+				value Int
+			}
+			verb echo(echo.EchoRequest) Unit
+			verb internal(echo.Internal) Unit
+		}
+	`)
+	assert.NoError(t, err)
+	assert.NoError(t, checkCompatibility(sch.Modules, module))
+
+	module, err = schema.ParseModuleString("", `
+		module echo {
+			data EchoRequest {
+				name Int
+			}
+			verb internal(Unit) Unit
+		}
+	`)
+	assert.NoError(t, err)
+	err = checkCompatibility(sch.Modules, module)
+	assert.EqualError(t, err, "breaking changes to declarations used by other modules (use --force to deploy anyway): "+
+		"breaking: echo.EchoRequest: field name changed type from String to Int\n"+
+		"breaking: echo.echo: verb removed")
+
+	// New modules are always compatible.
+	module.Name = "new"
+	assert.NoError(t, checkCompatibility(sch.Modules, module))
+}
@@ -6,11 +6,14 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"math"
 	"math/rand"
 	"net/http"
 	"net/url"
 	"reflect"
 	"runtime"
+	"sort"
+	"strconv"
 	"strings"
 	"sync"
 	"time"
@@ -39,7 +42,7 @@ import (
 	schemapb "github.com/TBD54566975/ftl/backend/protos/xyz/block/ftl/v1/schema"
 	"github.com/TBD54566975/ftl/backend/schema"
 	frontend "github.com/TBD54566975/ftl/frontend"
-	"github.com/TBD54566975/ftl/internal/cors"
+	"github.com/TBD54566975/ftl/internal/cron"
 	"github.com/TBD54566975/ftl/internal/log"
 	ftlmaps "github.com/TBD54566975/ftl/internal/maps"
 	"github.com/TBD54566975/ftl/internal/model"
@@ -54,13 +57,18 @@ type Config struct {
 	NoConsole                    bool                `help:"Disable the console."`
 	Advertise                    *url.URL            `help:"Endpoint the Controller should advertise (must be unique across the cluster, defaults to --bind if omitted)." env:"FTL_CONTROLLER_ADVERTISE"`
 	ConsoleURL                   *url.URL            `help:"The public URL of the console (for CORS)." env:"FTL_CONTROLLER_CONSOLE_URL"`
-	AllowOrigins                 []*url.URL          `help:"Allow CORS requests to ingress endpoints from these origins." env:"FTL_CONTROLLER_ALLOW_ORIGIN"`
 	ContentTime                  time.Time           `help:"Time to use for console resource timestamps." default:"${timestamp=1970-01-01T00:00:00Z}"`
 	Key                          model.ControllerKey `help:"Controller key (auto)." placeholder:"C<ULID>" default:"C00000000000000000000000000"`
 	DSN                          string              `help:"DAL DSN." default:"postgres://localhost:54320/ftl?sslmode=disable&user=postgres&password=secret" env:"FTL_CONTROLLER_DSN"`
 	RunnerTimeout                time.Duration       `help:"Runner heartbeat timeout." default:"10s"`
 	DeploymentReservationTimeout time.Duration       `help:"Deployment reservation timeout." default:"120s"`
 	ArtefactChunkSize            int                 `help:"Size of each chunk streamed to the client." default:"1048576"`
+	SubscriptionMaxAttempts      int                 `help:"Maximum number of attempts to deliver an event to a subscription before it is dead-lettered." default:"10"`
+	CallACLAuditOnly             bool                `help:"Log and record Verb calls that are not declared in the caller's schema instead of rejecting them." env:"FTL_CONTROLLER_CALL_ACL_AUDIT_ONLY"`
+	CanaryErrorThreshold         float64             `help:"Error rate above which canary deployments are automatically rolled back." default:"0.1" env:"FTL_CONTROLLER_CANARY_ERROR_THRESHOLD"`
+	CanaryMinCalls               int                 `help:"Minimum number of calls to a canary deployment before its error rate is considered." default:"20" env:"FTL_CONTROLLER_CANARY_MIN_CALLS"`
+	CanaryAnalysisWindow         time.Duration       `help:"Period over which the error rate of canary deployments is measured." default:"5m" env:"FTL_CONTROLLER_CANARY_ANALYSIS_WINDOW"`
+	IngressMaxBodySize           int64               `help:"Maximum size of an ingress request body, in bytes." default:"33554432" env:"FTL_CONTROLLER_INGRESS_MAX_BODY_SIZE"`
 }
 
 func (c *Config) SetDefaults() {
@@ -112,15 +120,13 @@ func Start(ctx context.Context, config Config, runnerScaling scaling.RunnerScali
 	console := NewConsoleService(dal)
 
 	ingressHandler := http.StripPrefix("/ingress", svc)
-	if len(config.AllowOrigins) > 0 {
-		ingressHandler = cors.Middleware(slices.Map(config.AllowOrigins, func(u *url.URL) string { return u.String() }), ingressHandler)
-	}
 
 	return rpc.Serve(ctx, config.Bind,
 		rpc.GRPC(ftlv1connect.NewVerbServiceHandler, svc),
 		rpc.GRPC(ftlv1connect.NewControllerServiceHandler, svc),
 		rpc.GRPC(pbconsoleconnect.NewConsoleServiceHandler, console),
 		rpc.HTTP("/ingress/", ingressHandler),
+		rpc.HTTP("/_ftl/openapi.json", http.HandlerFunc(svc.serveOpenAPI)),
 		rpc.HTTP("/", consoleHandler),
 	)
 }
@@ -145,8 +151,14 @@ type Service struct {
 
 	routesMu      sync.RWMutex
 	routes        map[string][]dal.Route
+	ingressRouter *ingress.Router
+	ingressSchema *schema.Schema
+	rateLimiter   *rateLimiter
+	ingressCache  *ingress.ResponseCache
 	config        Config
 	runnerScaling scaling.RunnerScaling
+
+	databasesMu sync.Mutex
 }
 
 func New(ctx context.Context, db *dal.DAL, config Config, runnerScaling scaling.RunnerScaling) (*Service, error) {
@@ -162,16 +174,28 @@ func New(ctx context.Context, db *dal.DAL, config Config, runnerScaling scaling.
 		deploymentLogsSink: newDeploymentLogsSink(ctx, db),
 		clients:            ttlcache.New[string, clients](ttlcache.WithTTL[string, clients](time.Minute)),
 		routes:             map[string][]dal.Route{},
+		rateLimiter:        newRateLimiter(db),
+		ingressCache:       ingress.NewResponseCache(),
 		config:             config,
 		runnerScaling:      runnerScaling,
 	}
 
 	svc.tasks.Parallel(backoff.Backoff{Min: time.Second, Max: time.Second * 5}, svc.syncRoutes)
+	svc.tasks.Parallel(backoff.Backoff{Min: time.Second, Max: time.Second * 5}, svc.syncIngress)
+	go svc.watchIngressChanges(ctx)
 	svc.tasks.Parallel(backoff.Backoff{Min: time.Second * 3, Max: time.Second * 3}, svc.heartbeatController)
 	svc.tasks.Singleton(backoff.Backoff{Min: time.Second, Max: time.Second * 10}, svc.reapStaleRunners)
 	svc.tasks.Singleton(backoff.Backoff{Min: time.Second, Max: time.Second * 20}, svc.releaseExpiredReservations)
 	svc.tasks.Singleton(backoff.Backoff{Min: time.Second, Max: time.Second * 5}, svc.reconcileDeployments)
 	svc.tasks.Singleton(backoff.Backoff{Min: time.Second, Max: time.Second * 5}, svc.reconcileRunners)
+	svc.tasks.Singleton(backoff.Backoff{Min: time.Second, Max: time.Second * 10}, svc.rollbackFailingCanaries)
+	svc.tasks.Singleton(backoff.Backoff{Min: time.Second, Max: time.Second * 10}, svc.pruneRateLimitBuckets)
+	// Each cron job execution is claimed atomically in the DB, so it is safe
+	// to run this on all controllers.
+	svc.tasks.Parallel(backoff.Backoff{Min: time.Second, Max: time.Second * 5}, svc.executeCronJobs)
+	// Subscription events are claimed with SKIP LOCKED, so it is safe to run
+	// this on all controllers.
+	svc.tasks.Parallel(backoff.Backoff{Min: time.Second, Max: time.Second * 5}, svc.deliverSubscriptionEvents)
 	// This should only run on one controller, but because dead controllers
 	// might be selected by the hash ring, we have to run it on all controllers.
 	// We should use a DB lock at some point.
@@ -183,16 +207,17 @@ func New(ctx context.Context, db *dal.DAL, config Config, runnerScaling scaling.
 func (s *Service) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	logger := log.FromContext(r.Context())
 	logger.Debugf("%s %s", r.Method, r.URL.Path)
-	routes, err := s.dal.GetIngressRoutes(r.Context(), r.Method)
+	router, sch, err := s.getIngressRouter(r.Context())
 	if err != nil {
-		if errors.Is(err, dal.ErrNotFound) {
-			http.NotFound(w, r)
-			return
-		}
 		http.Error(w, err.Error(), http.StatusInternalServerError)
 		return
 	}
-	route, err := ingress.GetIngressRoute(routes, r.Method, r.URL.Path)
+	// Preflight requests are answered from the route they are for.
+	method := r.Method
+	if ingress.IsPreflight(r) {
+		method = r.Header.Get("Access-Control-Request-Method")
+	}
+	route, err := router.Route(method, r.URL.Path)
 	if err != nil {
 		if errors.Is(err, dal.ErrNotFound) {
 			http.NotFound(w, r)
@@ -201,15 +226,82 @@ func (s *Service) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		http.Error(w, err.Error(), http.StatusInternalServerError)
 		return
 	}
+	routeMetadata := optional.None[*schema.MetadataIngress]()
+	if verb := sch.ResolveVerbRef(&schema.VerbRef{Name: route.Verb, Module: route.Module}); verb != nil {
+		routeMetadata = ingressRouteMetadata(verb, route)
+	}
+	if metadata, ok := routeMetadata.Get(); ok {
+		if cors, ok := metadata.GetCORS().Get(); ok {
+			ingress.SetCORSHeaders(w, r, route.Method, cors)
+		}
+	}
+	if ingress.IsPreflight(r) {
+		w.WriteHeader(http.StatusNoContent)
+		return
+	}
 
-	sch, err := s.getActiveSchema(r.Context())
+	client := rateLimitClient{addr: r.RemoteAddr, header: r.Header}
+	if metadata, ok := routeMetadata.Get(); ok {
+		if limit, ok := metadata.GetRateLimit().Get(); ok {
+			if err := s.rateLimiter.allowRoute(r.Context(), route, limit, client, time.Now()); err != nil {
+				if rateLimitErr := new(rateLimitError); errors.As(err, &rateLimitErr) {
+					err = connect.NewError(connect.CodeResourceExhausted, err)
+				}
+				writeCallError(w, err)
+				return
+			}
+		}
+	}
+
+	requestName, err := s.dal.CreateIngressRequest(r.Context(), fmt.Sprintf("%s %s", r.Method, r.URL.Path), r.RemoteAddr)
 	if err != nil {
 		http.Error(w, err.Error(), http.StatusInternalServerError)
 		return
 	}
 
-	body, err := ingress.BuildRequestBody(route, r, sch)
+	var principal optional.Option[string]
+	if metadata, ok := routeMetadata.Get(); ok {
+		principal, err = s.authorizeIngress(r.Context(), r, metadata, requestName, client)
+		if err != nil {
+			code := connect.CodeOf(err)
+			if code == connect.CodeUnauthenticated {
+				w.Header().Set("WWW-Authenticate", metadata.Auth)
+			}
+			http.Error(w, err.Error(), connectCodeToHTTP(code))
+			return
+		}
+	}
+
+	if metadata, ok := routeMetadata.Get(); ok {
+		if metadata.IsStreaming() {
+			s.serveIngressStream(w, r, route, sch, metadata, principal, requestName, client)
+			return
+		}
+		if cache, ok := metadata.GetCache().Get(); ok && r.Method == http.MethodGet {
+			s.serveCachedIngress(w, r, route, cache, principal, requestName, func(w http.ResponseWriter, r *http.Request, requestName model.RequestName) {
+				s.serveIngressVerb(w, r, route, sch, principal, requestName, client)
+			})
+			return
+		}
+	}
+	s.serveIngressVerb(w, r, route, sch, principal, requestName, client)
+}
+
+// serveIngressVerb calls the Verb of an ingress route and writes its response.
+func (s *Service) serveIngressVerb(w http.ResponseWriter, r *http.Request, route *dal.IngressRoute, sch *schema.Schema, principal optional.Option[string], requestName model.RequestName, client rateLimitClient) {
+	logger := log.FromContext(r.Context())
+	r.Body = http.MaxBytesReader(w, r.Body, s.config.IngressMaxBodySize)
+	if err := ingress.DecompressRequest(r, s.config.IngressMaxBodySize); err != nil {
+		http.Error(w, err.Error(), http.StatusUnsupportedMediaType)
+		return
+	}
+	body, err := ingress.BuildRequestBody(route, r, sch, principal)
 	if err != nil {
+		var maxBytesErr *http.MaxBytesError
+		if errors.As(err, &maxBytesErr) {
+			http.Error(w, err.Error(), http.StatusRequestEntityTooLarge)
+			return
+		}
 		http.Error(w, err.Error(), http.StatusBadRequest)
 		return
 	}
@@ -219,20 +311,10 @@ func (s *Service) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		Verb:     &schemapb.VerbRef{Module: route.Module, Name: route.Verb},
 		Body:     body,
 	})
-
-	requestName, err := s.dal.CreateIngressRequest(r.Context(), fmt.Sprintf("%s %s", r.Method, r.URL.Path), r.RemoteAddr)
-	if err != nil {
-		http.Error(w, err.Error(), http.StatusInternalServerError)
-		return
-	}
 	headers.SetRequestName(creq.Header(), requestName)
-	resp, err := s.Call(r.Context(), creq)
+	resp, err := s.call(r.Context(), creq, client)
 	if err != nil {
-		if connectErr := new(connect.Error); errors.As(err, &connectErr) {
-			http.Error(w, err.Error(), connectCodeToHTTP(connectErr.Code()))
-		} else {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-		}
+		writeCallError(w, err)
 		return
 	}
 	switch msg := resp.Msg.Response.(type) {
@@ -244,6 +326,7 @@ func (s *Service) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		}
 
 		var responseBody []byte
+		status := http.StatusOK
 
 		if metadata, ok := verb.GetMetadataIngress().Get(); ok && metadata.Type == "http" {
 			var response ingress.HTTPResponse
@@ -253,31 +336,72 @@ func (s *Service) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 			}
 
 			var responseHeaders http.Header
-			responseBody, responseHeaders, err = ingress.ResponseForVerb(sch, verb, response)
+			responseBody, responseHeaders, err = ingress.ResponseForVerb(sch, verb, response, r.Header.Get("Accept"))
 			if err != nil {
 				http.Error(w, err.Error(), http.StatusInternalServerError)
 				return
 			}
 
 			for k, v := range responseHeaders {
-				w.Header()[k] = v
+				if k == "Vary" {
+					w.Header()[k] = append(w.Header()[k], v...)
+				} else {
+					w.Header()[k] = v
+				}
 			}
 
 			if response.Status != 0 {
-				w.WriteHeader(response.Status)
+				status = response.Status
 			}
 		} else {
-			w.WriteHeader(http.StatusOK)
 			w.Header().Set("Content-Type", "application/json; charset=utf-8")
 			responseBody = msg.Body
 		}
+		responseBody, err = ingress.CompressResponseBody(w.Header(), r.Header.Get("Accept-Encoding"), responseBody)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		w.WriteHeader(status)
 		_, err = w.Write(responseBody)
 		if err != nil {
 			logger.Errorf(err, "Could not write response body")
 		}
 
 	case *ftlv1.CallResponse_Error_:
-		http.Error(w, msg.Error.Message, http.StatusInternalServerError)
+		writeVerbError(w, msg.Error)
+	}
+}
+
+// writeCallError writes an error calling an ingress Verb as the HTTP
+// response.
+func writeCallError(w http.ResponseWriter, err error) {
+	if rateLimitErr := new(rateLimitError); errors.As(err, &rateLimitErr) {
+		w.Header().Set("Retry-After", strconv.Itoa(int(math.Ceil(rateLimitErr.retryAfter.Seconds()))))
+	}
+	if connectErr := new(connect.Error); errors.As(err, &connectErr) {
+		http.Error(w, err.Error(), connectCodeToHTTP(connectErr.Code()))
+	} else {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+	}
+}
+
+// serveOpenAPI serves an OpenAPI document describing the HTTP ingress verbs of
+// the active deployments.
+func (s *Service) serveOpenAPI(w http.ResponseWriter, r *http.Request) {
+	sch, err := s.getActiveSchema(r.Context())
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	doc, err := ingress.OpenAPI(sch, "/ingress")
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(doc); err != nil {
+		log.FromContext(r.Context()).Errorf(err, "Could not write OpenAPI document")
 	}
 }
 
@@ -337,22 +461,21 @@ func (s *Service) Status(ctx context.Context, req *connect.Request[ftlv1.StatusR
 	s.routesMu.RUnlock()
 	replicas := map[string]int32{}
 	protoRunners, err := slices.MapErr(status.Runners, func(r dal.Runner) (*ftlv1.StatusResponse_Runner, error) {
-		var deployment *string
-		if d, ok := r.Deployment.Get(); ok {
-			asString := d.String()
-			deployment = &asString
-			replicas[asString]++
+		deployments := slices.Map(r.Deployments, func(d model.DeploymentName) string { return d.String() })
+		for _, deployment := range deployments {
+			replicas[deployment]++
 		}
 		labels, err := structpb.NewStruct(r.Labels)
 		if err != nil {
 			return nil, fmt.Errorf("could not marshal attributes for runner %s: %w", r.Key, err)
 		}
 		return &ftlv1.StatusResponse_Runner{
-			Key:        r.Key.String(),
-			Endpoint:   r.Endpoint,
-			State:      r.State.ToProto(),
-			Deployment: deployment,
-			Labels:     labels,
+			Key:         r.Key.String(),
+			Endpoint:    r.Endpoint,
+			State:       r.State.ToProto(),
+			Deployments: deployments,
+			Capacity:    int32(r.Capacity),
+			Labels:      labels,
 		}, nil
 	})
 	if err != nil {
@@ -485,6 +608,16 @@ func (s *Service) ReplaceDeploy(ctx context.Context, c *connect.Request[ftlv1.Re
 	logger := s.getDeploymentLogger(ctx, newDeploymentName)
 	logger.Debugf("Replace deployment for: %s", newDeploymentName)
 
+	if !c.Msg.Force {
+		if err := s.checkDeploymentCompatibility(ctx, newDeploymentName); err != nil {
+			if errors.Is(err, dal.ErrNotFound) {
+				return nil, connect.NewError(connect.CodeNotFound, errors.New("deployment not found"))
+			}
+			logger.Errorf(err, "Incompatible deployment: %s", newDeploymentName)
+			return nil, err
+		}
+	}
+
 	err = s.dal.ReplaceDeployment(ctx, newDeploymentName, int(c.Msg.MinReplicas))
 	if err != nil {
 		if errors.Is(err, dal.ErrNotFound) {
@@ -497,11 +630,14 @@ func (s *Service) ReplaceDeploy(ctx context.Context, c *connect.Request[ftlv1.Re
 			return nil, fmt.Errorf("%s: %w", "could not replace deployment", err)
 		}
 	}
+	// Responses cached from the old deployment are no longer valid.
+	s.ingressCache.PurgeModule(newDeploymentName.Module())
 	return connect.NewResponse(&ftlv1.ReplaceDeployResponse{}), nil
 }
 
 func (s *Service) RegisterRunner(ctx context.Context, stream *connect.ClientStream[ftlv1.RegisterRunnerRequest]) (*connect.Response[ftlv1.RegisterRunnerResponse], error) {
 	initialised := false
+	unhealthy := false
 
 	logger := log.FromContext(ctx)
 	for stream.Receive() {
@@ -536,16 +672,29 @@ func (s *Service) RegisterRunner(ctx context.Context, stream *connect.ClientStre
 			initialised = true
 		}
 
-		maybeDeployment, err := msg.DeploymentAsOptional()
+		deployments, err := msg.DeploymentNames()
 		if err != nil {
 			return nil, connect.NewError(connect.CodeInvalidArgument, err)
 		}
+		if msg.Unhealthy != unhealthy {
+			unhealthy = msg.Unhealthy
+			for _, deployment := range deployments {
+				deploymentLogger := s.getDeploymentLogger(ctx, deployment)
+				if unhealthy {
+					deploymentLogger.Warnf("Runner %s is unhealthy, a deployment process is crash-looping", runnerStr)
+				} else {
+					deploymentLogger.Infof("Runner %s has recovered", runnerStr)
+				}
+			}
+		}
 		err = s.dal.UpsertRunner(ctx, dal.Runner{
-			Key:        runnerKey,
-			Endpoint:   msg.Endpoint,
-			State:      dal.RunnerStateFromProto(msg.State),
-			Deployment: maybeDeployment,
-			Labels:     msg.Labels.AsMap(),
+			Key:         runnerKey,
+			Endpoint:    msg.Endpoint,
+			State:       dal.RunnerStateFromProto(msg.State),
+			Deployments: deployments,
+			Capacity:    int(msg.Capacity),
+			Labels:      msg.Labels.AsMap(),
+			Unhealthy:   msg.Unhealthy,
 		})
 		if errors.Is(err, dal.ErrConflict) {
 			return nil, connect.NewError(connect.CodeAlreadyExists, err)
@@ -594,6 +743,7 @@ func (s *Service) GetDeployment(ctx context.Context, req *connect.Request[ftlv1.
 	return connect.NewResponse(&ftlv1.GetDeploymentResponse{
 		Schema:    deployment.Schema.ToProto().(*schemapb.Module), //nolint:forcetypeassert
 		Artefacts: slices.Map(deployment.Artefacts, ftlv1.ArtefactToProto),
+		Limits:    ftlv1.LimitsToProto(deployment.Limits),
 	}), nil
 }
 
@@ -640,67 +790,174 @@ func (s *Service) Ping(ctx context.Context, req *connect.Request[ftlv1.PingReque
 }
 
 func (s *Service) Call(ctx context.Context, req *connect.Request[ftlv1.CallRequest]) (*connect.Response[ftlv1.CallResponse], error) {
+	return s.call(ctx, req, s.newRateLimitClient(req.Peer().Addr, req.Header()))
+}
+
+func (s *Service) CallStream(ctx context.Context, req *connect.Request[ftlv1.CallRequest], stream *connect.ServerStream[ftlv1.CallResponse]) error {
+	return s.callStream(ctx, req, s.newRateLimitClient(req.Peer().Addr, req.Header()), stream.Send)
+}
+
+// preparedCall is a Verb call that has been validated, checked against the
+// caller's ACL and rate limits, and routed to a deployment.
+type preparedCall struct {
+	start       time.Time
+	sch         *schema.Schema
+	verbRef     *schema.VerbRef
+	callers     []*schema.VerbRef
+	requestName model.RequestName
+	routes      []dal.Route
+	route       dal.Route
+}
+
+// prepareCall validates and routes a call to a Verb, returning the context the
+// call should be made with.
+func (s *Service) prepareCall(ctx context.Context, req *connect.Request[ftlv1.CallRequest], client rateLimitClient) (context.Context, *preparedCall, error) {
 	start := time.Now()
 	if req.Msg.Verb == nil {
-		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("verb is required"))
+		return nil, nil, connect.NewError(connect.CodeInvalidArgument, errors.New("verb is required"))
 	}
 	if req.Msg.Body == nil {
-		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("body is required"))
+		return nil, nil, connect.NewError(connect.CodeInvalidArgument, errors.New("body is required"))
 	}
 	verbRef := schema.VerbRefFromProto(req.Msg.Verb)
 
 	sch, err := s.getActiveSchema(ctx)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	err = ingress.ValidateCallBody(req.Msg.Body, verbRef, sch)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	module := verbRef.Module
 	s.routesMu.RLock()
-	routes, ok := s.routes[module]
+	routes := s.routes[module]
 	s.routesMu.RUnlock()
+	route, ok := nextRoute(routes, "")
 	if !ok {
-		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("no routes for module %q", module))
+		return nil, nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("no routes for module %q", module))
 	}
-	route := routes[rand.Intn(len(routes))] //nolint:gosec
-	client := s.clientsForEndpoint(route.Endpoint)
 
 	callers, err := headers.GetCallers(req.Header())
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	requestName, ok, err := headers.GetRequestName(req.Header())
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 	if !ok {
 		// Inject the request key if this is an ingress call.
 		requestName, err = s.dal.CreateIngressRequest(ctx, "grpc", req.Peer().Addr)
 		if err != nil {
-			return nil, err
+			return nil, nil, err
 		}
 		headers.SetRequestName(req.Header(), requestName)
 	}
+	// Carry the originating client through to any calls the Verb makes,
+	// replacing any address set by an untrusted caller.
+	headers.SetClientAddr(req.Header(), client.addr)
+
+	if err := checkCallACL(sch, callers, verbRef); err != nil {
+		s.recordCall(ctx, &Call{
+			deploymentName: route.Deployment,
+			requestName:    requestName,
+			startTime:      start,
+			destVerb:       verbRef,
+			callers:        callers,
+			callError:      optional.Some(err),
+			request:        req.Msg,
+			rejected:       true,
+		})
+		if !s.config.CallACLAuditOnly {
+			return nil, nil, connect.NewError(connect.CodePermissionDenied, err)
+		}
+		log.FromContext(ctx).Warnf("Allowing undeclared call in audit-only mode: %s", err)
+	}
+
+	if err := s.rateLimiter.allow(ctx, sch, verbRef, client, start); err != nil {
+		if rateLimitErr := new(rateLimitError); !errors.As(err, &rateLimitErr) {
+			return nil, nil, err
+		}
+		s.recordCall(ctx, &Call{
+			deploymentName: route.Deployment,
+			requestName:    requestName,
+			startTime:      start,
+			destVerb:       verbRef,
+			callers:        callers,
+			callError:      optional.Some(err),
+			request:        req.Msg,
+			rejected:       true,
+		})
+		return nil, nil, connect.NewError(connect.CodeResourceExhausted, err)
+	}
 
 	ctx = rpc.WithVerbs(ctx, append(callers, verbRef))
 	headers.AddCaller(req.Header(), schema.VerbRefFromProto(req.Msg.Verb))
+	return ctx, &preparedCall{
+		start:       start,
+		sch:         sch,
+		verbRef:     verbRef,
+		callers:     callers,
+		requestName: requestName,
+		routes:      routes,
+		route:       route,
+	}, nil
+}
+
+// call a Verb on behalf of a client, which is identified for rate limiting.
+func (s *Service) call(ctx context.Context, req *connect.Request[ftlv1.CallRequest], client rateLimitClient) (*connect.Response[ftlv1.CallResponse], error) {
+	ctx, call, err := s.prepareCall(ctx, req, client)
+	if err != nil {
+		return nil, err
+	}
+	verbRef, routes, route := call.verbRef, call.routes, call.route
 
-	resp, err := client.verb.Call(ctx, req)
+	policy := callPolicyForVerb(call.sch, verbRef)
+	var resp *connect.Response[ftlv1.CallResponse]
+	attempt, rerouted := 1, 0
+	for {
+		resp, err = s.callAttempt(ctx, route, req, policy.timeout)
+		if err == nil || connect.CodeOf(err) != connect.CodeUnavailable {
+			break
+		}
+		// Calls rejected by a draining deployment never reached the Verb, so
+		// they are re-routed straight away whatever the retry policy.
+		if isDrainRejection(err) && rerouted < len(routes) {
+			rerouted++
+			log.FromContext(ctx).Debugf("Call to %s via %s rejected while draining, re-routing: %s", verbRef, route, err)
+			route, _ = nextRoute(routes, route.Endpoint)
+			continue
+		}
+		if attempt >= policy.attempts {
+			break
+		}
+		attempt++
+		delay := policy.backoff.Duration()
+		log.FromContext(ctx).Debugf("Call to %s via %s unavailable, retrying in %s: %s", verbRef, route, delay, err)
+		select {
+		case <-time.After(delay):
+		case <-ctx.Done():
+		}
+		if ctx.Err() != nil {
+			break
+		}
+		// There is always a route to retry, as routes is never empty here.
+		route, _ = nextRoute(routes, route.Endpoint)
+	}
 	var maybeResponse optional.Option[*ftlv1.CallResponse]
 	if resp != nil {
 		maybeResponse = optional.Some(resp.Msg)
 	}
 	s.recordCall(ctx, &Call{
 		deploymentName: route.Deployment,
-		requestName:    requestName,
-		startTime:      start,
+		requestName:    call.requestName,
+		startTime:      call.start,
 		destVerb:       verbRef,
-		callers:        callers,
+		callers:        call.callers,
 		callError:      optional.Nil(err),
 		request:        req.Msg,
 		response:       maybeResponse,
@@ -708,6 +965,105 @@ func (s *Service) Call(ctx context.Context, req *connect.Request[ftlv1.CallReque
 	return resp, err
 }
 
+// callAttempt makes a single attempt at a call via a route, bounded by the
+// timeout if it is non-zero.
+func (s *Service) callAttempt(ctx context.Context, route dal.Route, req *connect.Request[ftlv1.CallRequest], timeout time.Duration) (*connect.Response[ftlv1.CallResponse], error) {
+	if timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+	client := s.clientsForEndpoint(route.Endpoint)
+	headers.SetDeployment(req.Header(), route.Deployment)
+	return client.verb.Call(ctx, req)
+}
+
+// callStream calls a streaming Verb on behalf of a client, passing each
+// message it sends to send.
+func (s *Service) callStream(ctx context.Context, req *connect.Request[ftlv1.CallRequest], client rateLimitClient, send func(*ftlv1.CallResponse) error) error {
+	ctx, call, err := s.prepareCall(ctx, req, client)
+	if err != nil {
+		return err
+	}
+	return s.streamCall(ctx, call, req, send)
+}
+
+// streamCall makes a prepared call to a streaming Verb.
+//
+// Unlike unary calls, streams are never retried as messages may already have
+// been delivered. Streams rejected by a draining deployment are re-routed, as
+// they are rejected before any message is sent.
+func (s *Service) streamCall(ctx context.Context, call *preparedCall, req *connect.Request[ftlv1.CallRequest], send func(*ftlv1.CallResponse) error) error {
+	route := call.route
+	var err error
+	for rerouted := 0; ; rerouted++ {
+		sent := false
+		err = s.forwardCallStream(ctx, route, req, func(msg *ftlv1.CallResponse) error {
+			sent = true
+			return send(msg)
+		})
+		if sent || !isDrainRejection(err) || rerouted >= len(call.routes) {
+			break
+		}
+		log.FromContext(ctx).Debugf("Stream to %s via %s rejected while draining, re-routing: %s", call.verbRef, route, err)
+		route, _ = nextRoute(call.routes, route.Endpoint)
+	}
+	// The call is recorded even if the client went away.
+	s.recordCall(context.WithoutCancel(ctx), &Call{
+		deploymentName: route.Deployment,
+		requestName:    call.requestName,
+		startTime:      call.start,
+		destVerb:       call.verbRef,
+		callers:        call.callers,
+		callError:      optional.Nil(err),
+		request:        req.Msg,
+	})
+	return err
+}
+
+// forwardCallStream passes each message of a stream from a runner to send.
+//
+// Each message is sent before the next is received, so a slow client applies
+// backpressure all the way to the Verb.
+func (s *Service) forwardCallStream(ctx context.Context, route dal.Route, req *connect.Request[ftlv1.CallRequest], send func(*ftlv1.CallResponse) error) error {
+	headers.SetDeployment(req.Header(), route.Deployment)
+	resp, err := s.clientsForEndpoint(route.Endpoint).verb.CallStream(ctx, req)
+	if err != nil {
+		return err
+	}
+	defer resp.Close()
+	for resp.Receive() {
+		if err := send(resp.Msg()); err != nil {
+			return err
+		}
+	}
+	return resp.Err()
+}
+
+func (s *Service) PublishEvent(ctx context.Context, req *connect.Request[ftlv1.PublishEventRequest]) (*connect.Response[ftlv1.PublishEventResponse], error) {
+	if req.Msg.Topic == nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("topic is required"))
+	}
+	topicRef := schema.TopicRefFromProto(req.Msg.Topic)
+
+	sch, err := s.getActiveSchema(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	err = ingress.ValidateEventBody(req.Msg.Body, topicRef, sch)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	count, err := s.dal.PublishEvent(ctx, *topicRef, req.Msg.Body)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", "failed to publish event", err)
+	}
+	log.FromContext(ctx).Debugf("Published event to %s for %d subscriptions", topicRef, count)
+	return connect.NewResponse(&ftlv1.PublishEventResponse{}), nil
+}
+
 func (s *Service) GetArtefactDiffs(ctx context.Context, req *connect.Request[ftlv1.GetArtefactDiffsRequest]) (*connect.Response[ftlv1.GetArtefactDiffsResponse], error) {
 	byteDigests, err := slices.MapErr(req.Msg.ClientDigests, sha256.ParseSHA256)
 	if err != nil {
@@ -765,9 +1121,24 @@ func (s *Service) CreateDeployment(ctx context.Context, req *connect.Request[ftl
 		logger.Errorf(err, "Invalid module schema")
 		return nil, fmt.Errorf("%s: %w", "invalid module schema", err)
 	}
+	if !req.Msg.Force {
+		existingModules, err := s.dal.GetActiveDeploymentSchemas(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", "could not get existing schemas", err)
+		}
+		if err := checkCompatibility(existingModules, module); err != nil {
+			logger.Errorf(err, "Incompatible module schema")
+			return nil, connect.NewError(connect.CodeFailedPrecondition, err)
+		}
+	}
+
+	limits, err := req.Msg.Limits.ToModel()
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
 
 	ingressRoutes := extractIngressRoutingEntries(req.Msg)
-	dname, err := s.dal.CreateDeployment(ctx, ms.Runtime.Language, module, artefacts, ingressRoutes)
+	dname, err := s.dal.CreateDeployment(ctx, ms.Runtime.Language, module, artefacts, ingressRoutes, limits)
 	if err != nil {
 		logger.Errorf(err, "Could not create deployment")
 		return nil, fmt.Errorf("%s: %w", "could not create deployment", err)
@@ -905,10 +1276,22 @@ func (s *Service) reconcileRunners(ctx context.Context) (time.Duration, error) {
 		return 0, fmt.Errorf("%s: %w", "failed to get deployments needing reconciliation", err)
 	}
 
+	activeRunners, err := s.dal.GetActiveRunners(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("%s: %w", "failed to get active runners", err)
+	}
+
+	// Replicas of a deployment can't share a runner, so at least as many
+	// runners as the most replicated deployment are needed, otherwise enough
+	// to pack every replica onto a runner.
+	totalReplicas := 0
 	totalRunners := 0
 	for _, deployment := range activeDeployments {
-		totalRunners += deployment.MinReplicas
+		totalReplicas += deployment.MinReplicas
+		totalRunners = max(totalRunners, deployment.MinReplicas)
 	}
+	capacities := slices.Map(activeRunners, func(r dal.Runner) int { return r.Capacity })
+	totalRunners = max(totalRunners, runnersRequired(totalReplicas, capacities))
 
 	// It's possible that idles runners will get terminated here, but they will get recreated in the next
 	// reconciliation cycle.
@@ -927,6 +1310,32 @@ func (s *Service) reconcileRunners(ctx context.Context) (time.Duration, error) {
 	return time.Second, nil
 }
 
+// runnersRequired returns the number of runners needed to host a number of
+// deployment replicas, given the capacity each active runner reports.
+//
+// The largest runners are filled first. Runners that have yet to start are
+// assumed to have the smallest reported capacity.
+func runnersRequired(replicas int, capacities []int) int {
+	capacities = append([]int(nil), capacities...)
+	sort.Sort(sort.Reverse(sort.IntSlice(capacities)))
+	runners := 0
+	for _, capacity := range capacities {
+		if replicas <= 0 {
+			return runners
+		}
+		replicas -= max(capacity, 1)
+		runners++
+	}
+	if replicas <= 0 {
+		return runners
+	}
+	next := 1
+	if len(capacities) > 0 {
+		next = max(capacities[len(capacities)-1], 1)
+	}
+	return runners + (replicas+next-1)/next
+}
+
 func (s *Service) terminateRandomRunner(ctx context.Context, key model.DeploymentName) (bool, error) {
 	runners, err := s.dal.GetRunnersForDeployment(ctx, key)
 	if err != nil {
@@ -936,27 +1345,61 @@ func (s *Service) terminateRandomRunner(ctx context.Context, key model.Deploymen
 		return false, nil
 	}
 	runner := runners[rand.Intn(len(runners))] //nolint:gosec
+	// Stop routing calls to the deployment on the runner before terminating
+	// it, so that the runner only has to drain the calls already in flight.
+	err = s.dal.DrainRunnerDeployment(ctx, runner.Key, key)
+	if err != nil {
+		return false, fmt.Errorf("failed to drain %s on runner %s: %w", key, runner.Key, err)
+	}
+	if _, err := s.syncRoutes(ctx); err != nil {
+		return false, err
+	}
 	client := s.clientsForEndpoint(runner.Endpoint)
 	resp, err := client.runner.Terminate(ctx, connect.NewRequest(&ftlv1.TerminateRequest{DeploymentName: key.String()}))
+	if err != nil {
+		// Route calls to the runner again, otherwise the deployment would be
+		// left draining for as long as the runner keeps reporting it.
+		if uerr := s.dal.UndrainRunnerDeployment(context.WithoutCancel(ctx), runner.Key, key); uerr != nil && !errors.Is(uerr, dal.ErrNotFound) {
+			err = errors.Join(err, fmt.Errorf("failed to undrain %s on runner %s: %w", key, runner.Key, uerr))
+		}
+		return false, err
+	}
+	deployments, err := resp.Msg.DeploymentNames()
 	if err != nil {
 		return false, err
 	}
 	err = s.dal.UpsertRunner(ctx, dal.Runner{
-		Key:      runner.Key,
-		Endpoint: runner.Endpoint,
-		State:    dal.RunnerStateFromProto(resp.Msg.State),
-		Labels:   runner.Labels,
+		Key:         runner.Key,
+		Endpoint:    runner.Endpoint,
+		State:       dal.RunnerStateFromProto(resp.Msg.State),
+		Deployments: deployments,
+		Capacity:    int(resp.Msg.Capacity),
+		Labels:      runner.Labels,
+		Unhealthy:   resp.Msg.Unhealthy,
 	})
 	return true, err
 }
 
 func (s *Service) deploy(ctx context.Context, reconcile model.Deployment) error {
+	deployment, err := s.dal.GetDeployment(ctx, reconcile.Name)
+	if err != nil {
+		return fmt.Errorf("%s: %w", "could not retrieve deployment", err)
+	}
+	defer deployment.Close()
+	databaseDSNs, err := s.provisionDatabases(ctx, deployment)
+	if err != nil {
+		return err
+	}
+
 	client, err := s.reserveRunner(ctx, reconcile)
 	if err != nil {
 		return err
 	}
 
-	_, err = client.runner.Deploy(ctx, connect.NewRequest(&ftlv1.DeployRequest{DeploymentName: reconcile.Name.String()}))
+	_, err = client.runner.Deploy(ctx, connect.NewRequest(&ftlv1.DeployRequest{
+		DeploymentName: reconcile.Name.String(),
+		DatabaseDsns:   databaseDSNs,
+	}))
 	if err != nil {
 		return err
 	}
@@ -982,6 +1425,156 @@ func (s *Service) reserveRunner(ctx context.Context, reconcile model.Deployment)
 	return
 }
 
+// Execute any cron jobs that are due.
+func (s *Service) executeCronJobs(ctx context.Context) (time.Duration, error) {
+	logger := log.FromContext(ctx)
+	jobs, err := s.dal.GetCronJobs(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("%s: %w", "failed to get cron jobs", err)
+	}
+	now := time.Now().UTC()
+	for _, job := range jobs {
+		if job.NextExecution.After(now) {
+			break
+		}
+		pattern, err := cron.Parse(job.Schedule)
+		if err != nil {
+			logger.Errorf(err, "Invalid schedule for cron job %s", job.Verb)
+			continue
+		}
+		claimed, err := s.dal.ClaimCronJobExecution(ctx, job, pattern.Next(now))
+		if err != nil {
+			return 0, fmt.Errorf("failed to claim cron job %s: %w", job.Verb, err)
+		} else if !claimed {
+			continue
+		}
+		go s.callCronJob(ctx, job)
+	}
+	return time.Second, nil
+}
+
+func (s *Service) callCronJob(ctx context.Context, job dal.CronJob) {
+	logger := s.getDeploymentLogger(ctx, job.Deployment)
+	logger.Debugf("Executing cron job %s", job.Verb)
+	requestName, err := s.dal.CreateCronRequest(ctx, job.Verb, s.config.Advertise.String())
+	if err != nil {
+		logger.Errorf(err, "Could not create request for cron job %s", job.Verb)
+		return
+	}
+	req := connect.NewRequest(&ftlv1.CallRequest{
+		Metadata: &ftlv1.Metadata{},
+		Verb:     &schemapb.VerbRef{Module: job.Verb.Module, Name: job.Verb.Name},
+		Body:     []byte(`{}`),
+	})
+	headers.SetRequestName(req.Header(), requestName)
+	resp, err := s.Call(ctx, req)
+	if err != nil {
+		logger.Errorf(err, "Cron job %s failed", job.Verb)
+		return
+	}
+	if callErr, ok := resp.Msg.Response.(*ftlv1.CallResponse_Error_); ok {
+		logger.Errorf(errors.New(callErr.Error.Message), "Cron job %s failed", job.Verb)
+	}
+}
+
+const (
+	// Maximum number of subscription events to claim at once, and so to
+	// deliver concurrently.
+	subscriptionEventBatchSize = 100
+	// How long a controller has to deliver a claimed event before it can be
+	// claimed by another controller.
+	subscriptionEventLease = time.Minute
+)
+
+// Delay between failed attempts to deliver a subscription event.
+var subscriptionRetryBackoff = backoff.Backoff{Min: time.Second, Max: time.Minute * 10}
+
+// Deliver any subscription events that are due.
+func (s *Service) deliverSubscriptionEvents(ctx context.Context) (time.Duration, error) {
+	events, err := s.dal.ClaimSubscriptionEvents(ctx, subscriptionEventBatchSize, subscriptionEventLease)
+	if err != nil {
+		return 0, fmt.Errorf("%s: %w", "failed to claim subscription events", err)
+	}
+	// The batch is delivered before claiming more so that the number of
+	// deliveries in flight is bounded.
+	wg := sync.WaitGroup{}
+	for _, event := range events {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			s.deliverSubscriptionEvent(ctx, event)
+		}()
+	}
+	wg.Wait()
+	if len(events) == subscriptionEventBatchSize {
+		return 0, nil
+	}
+	return time.Second, nil
+}
+
+func (s *Service) deliverSubscriptionEvent(ctx context.Context, event dal.SubscriptionEvent) {
+	logger := log.FromContext(ctx)
+	err := s.callSubscribers(ctx, event)
+	if err == nil {
+		if err := s.dal.CompleteSubscriptionEvent(ctx, event); err != nil {
+			logger.Errorf(err, "Could not complete event %d for subscription %s", event.ID, event.Subscription.String())
+		}
+		return
+	}
+	if event.Attempts >= s.config.SubscriptionMaxAttempts {
+		logger.Errorf(err, "Event %d for subscription %s moved to dead-letter queue after %d attempts", event.ID, event.Subscription.String(), event.Attempts)
+	} else {
+		logger.Warnf("Delivery of event %d for subscription %s failed (attempt %d): %s", event.ID, event.Subscription.String(), event.Attempts, err)
+	}
+	nextAttempt := time.Now().UTC().Add(subscriptionRetryBackoff.ForAttempt(float64(event.Attempts - 1)))
+	if err := s.dal.FailSubscriptionEvent(ctx, event, err, nextAttempt, s.config.SubscriptionMaxAttempts); err != nil {
+		logger.Errorf(err, "Could not record failure of event %d for subscription %s", event.ID, event.Subscription.String())
+	}
+}
+
+// Call every Verb subscribed to the event's subscription.
+func (s *Service) callSubscribers(ctx context.Context, event dal.SubscriptionEvent) error {
+	sch, err := s.getActiveSchema(ctx)
+	if err != nil {
+		return err
+	}
+	module, ok := sch.Module(event.Subscription.Module).Get()
+	if !ok {
+		return fmt.Errorf("module %q is not deployed", event.Subscription.Module)
+	}
+	var subscribers []*schema.Verb
+	for _, verb := range module.Verbs() {
+		for _, md := range verb.Metadata {
+			if md, ok := md.(*schema.MetadataSubscriber); ok && md.Name == event.Subscription.Name {
+				subscribers = append(subscribers, verb)
+			}
+		}
+	}
+	if len(subscribers) == 0 {
+		return fmt.Errorf("no verbs are subscribed to %s", event.Subscription.String())
+	}
+	requestName, err := s.dal.CreatePubSubRequest(ctx, event.Subscription, s.config.Advertise.String())
+	if err != nil {
+		return fmt.Errorf("%s: %w", "could not create request", err)
+	}
+	for _, verb := range subscribers {
+		req := connect.NewRequest(&ftlv1.CallRequest{
+			Metadata: &ftlv1.Metadata{},
+			Verb:     &schemapb.VerbRef{Module: module.Name, Name: verb.Name},
+			Body:     event.Payload,
+		})
+		headers.SetRequestName(req.Header(), requestName)
+		resp, err := s.Call(ctx, req)
+		if err != nil {
+			return fmt.Errorf("%s.%s: %w", module.Name, verb.Name, err)
+		}
+		if callErr, ok := resp.Msg.Response.(*ftlv1.CallResponse_Error_); ok {
+			return fmt.Errorf("%s.%s: %s", module.Name, verb.Name, callErr.Error.Message)
+		}
+	}
+	return nil
+}
+
 // Periodically remove stale (ie. have not heartbeat recently) controllers from the database.
 func (s *Service) reapStaleControllers(ctx context.Context) (time.Duration, error) {
 	logger := log.FromContext(ctx)
@@ -1146,6 +1739,9 @@ func (s *Service) getActiveSchema(ctx context.Context) (*schema.Schema, error) {
 	if err != nil {
 		return nil, err
 	}
+	// Canaries share their module's name with the module's existing deployment,
+	// so only the existing deployment contributes to the schema.
+	deployments = slices.Filter(deployments, func(d dal.Deployment) bool { return !d.Canary })
 	return schema.Validate(&schema.Schema{
 		Modules: slices.Map(deployments, func(d dal.Deployment) *schema.Module {
 			return d.Schema
@@ -1251,6 +1847,21 @@ func extractIngressRoutingEntries(req *ftlv1.CreateDeploymentRequest) []dal.Ingr
 	return ingressRoutes
 }
 
+// ingressRouteMetadata returns the ingress metadata of a Verb that declares
+// the given route, as a Verb may be exposed on several routes.
+func ingressRouteMetadata(verb *schema.Verb, route *dal.IngressRoute) optional.Option[*schema.MetadataIngress] {
+	for _, m := range verb.Metadata {
+		metadata, ok := m.(*schema.MetadataIngress)
+		if !ok || metadata.Method != route.Method {
+			continue
+		}
+		if ingressPathString(metadata.ToProto().(*schemapb.MetadataIngress).Path) == route.Path { //nolint:forcetypeassert
+			return optional.Some(metadata)
+		}
+	}
+	return optional.None[*schema.MetadataIngress]()
+}
+
 func ingressPathString(path []*schemapb.IngressPathComponent) string {
 	pathString := make([]string, len(path))
 	for i, p := range path {
@@ -1258,7 +1869,11 @@ func ingressPathString(path []*schemapb.IngressPathComponent) string {
 		case *schemapb.IngressPathComponent_IngressPathLiteral:
 			pathString[i] = p.GetIngressPathLiteral().Text
 		case *schemapb.IngressPathComponent_IngressPathParameter:
-			pathString[i] = fmt.Sprintf("{%s}", p.GetIngressPathParameter().Name)
+			if p.GetIngressPathParameter().CatchAll {
+				pathString[i] = fmt.Sprintf("{%s...}", p.GetIngressPathParameter().Name)
+			} else {
+				pathString[i] = fmt.Sprintf("{%s}", p.GetIngressPathParameter().Name)
+			}
 		}
 	}
 	return "/" + strings.Join(pathString, "/")
@@ -0,0 +1,42 @@
+package controller
+
+import (
+	"testing"
+
+	"github.com/alecthomas/assert/v2"
+
+	"github.com/TBD54566975/ftl/backend/controller/dal"
+	"github.com/TBD54566975/ftl/backend/schema"
+)
+
+func TestRunnersRequired(t *testing.T) {
+	assert.Equal(t, 0, runnersRequired(0, nil))
+	assert.Equal(t, 3, runnersRequired(3, nil))
+	assert.Equal(t, 1, runnersRequired(3, []int{4, 1}))
+	assert.Equal(t, 2, runnersRequired(5, []int{4, 1}))
+	// New runners are assumed to have the smallest reported capacity.
+	assert.Equal(t, 3, runnersRequired(8, []int{4, 2}))
+	assert.Equal(t, 5, runnersRequired(6, []int{2, 0}))
+}
+
+func TestIngressRouteMetadata(t *testing.T) {
+	public := &schema.MetadataIngress{Type: "http", Method: "GET", Path: []schema.IngressPathComponent{
+		&schema.IngressPathLiteral{Text: "public"},
+	}}
+	admin := &schema.MetadataIngress{Type: "http", Method: "POST", Auth: "bearer", Path: []schema.IngressPathComponent{
+		&schema.IngressPathLiteral{Text: "admin"},
+		&schema.IngressPathParameter{Name: "id"},
+	}}
+	verb := &schema.Verb{Name: "handle", Metadata: []schema.Metadata{public, admin}}
+
+	metadata, ok := ingressRouteMetadata(verb, &dal.IngressRoute{Method: "GET", Path: "/public"}).Get()
+	assert.True(t, ok)
+	assert.Equal(t, public, metadata)
+
+	metadata, ok = ingressRouteMetadata(verb, &dal.IngressRoute{Method: "POST", Path: "/admin/{id}"}).Get()
+	assert.True(t, ok)
+	assert.Equal(t, admin, metadata)
+
+	_, ok = ingressRouteMetadata(verb, &dal.IngressRoute{Method: "GET", Path: "/admin/{id}"}).Get()
+	assert.False(t, ok)
+}
@@ -0,0 +1,285 @@
+package dal
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"time"
+
+	"github.com/alecthomas/types/optional"
+
+	"github.com/TBD54566975/ftl/backend/controller/sql"
+	"github.com/TBD54566975/ftl/internal/model"
+	"github.com/TBD54566975/ftl/internal/slices"
+)
+
+// A CanaryDeployment is a deployment receiving a share of its module's traffic
+// alongside the module's existing deployment.
+type CanaryDeployment struct {
+	Deployment model.DeploymentName
+	Module     string
+	// Weight is the percentage of the module's traffic routed to the canary.
+	Weight      int
+	MinReplicas int
+}
+
+// CallStats summarises the calls to a deployment over a period of time.
+type CallStats struct {
+	Calls  int
+	Errors int
+}
+
+// ErrorRate returns the fraction of calls that failed.
+func (c CallStats) ErrorRate() float64 {
+	if c.Calls == 0 {
+		return 0
+	}
+	return float64(c.Errors) / float64(c.Calls)
+}
+
+// StartCanaryDeployment activates a deployment alongside the module's existing
+// deployment, routing weight percent of the module's traffic to it.
+//
+// Any existing canary of the module is retired. If the module has no existing
+// deployment this is equivalent to ReplaceDeployment.
+func (d *DAL) StartCanaryDeployment(ctx context.Context, name model.DeploymentName, minReplicas, weight int) (err error) {
+	if weight < 0 || weight > 100 {
+		return fmt.Errorf("canary weight must be between 0 and 100, not %d", weight)
+	}
+	tx, err := d.db.Begin(ctx)
+	if err != nil {
+		return translatePGError(err)
+	}
+	defer tx.CommitOrRollback(ctx, &err)
+
+	deployment, err := tx.GetDeployment(ctx, name)
+	if err != nil {
+		return translatePGError(err)
+	}
+	existing, err := tx.GetExistingDeploymentForModule(ctx, deployment.ModuleName)
+	if isNotFound(err) {
+		// Nothing to canary against.
+		err = tx.SetDeploymentDesiredReplicas(ctx, name, int32(minReplicas))
+		if err != nil {
+			return translatePGError(err)
+		}
+	} else if err != nil {
+		return translatePGError(err)
+	} else {
+		if existing.Name == name {
+			return fmt.Errorf("%s: %w", "deployment is already active", ErrConflict)
+		}
+		canary, err := tx.GetCanaryDeploymentForModule(ctx, deployment.ModuleName)
+		if err == nil {
+			if err := retireCanary(ctx, tx, canary.Name); err != nil {
+				return err
+			}
+		} else if !isNotFound(err) {
+			return translatePGError(err)
+		}
+		err = tx.StartCanaryDeployment(ctx, int32(minReplicas), int32(weight), name)
+		if err != nil {
+			return translatePGError(err)
+		}
+	}
+
+	err = tx.InsertDeploymentCreatedEvent(ctx, sql.InsertDeploymentCreatedEventParams{
+		DeploymentName: name.String(),
+		Language:       deployment.Language,
+		ModuleName:     deployment.ModuleName,
+		MinReplicas:    int32(minReplicas),
+	})
+	if err != nil {
+		return translatePGError(err)
+	}
+	return nil
+}
+
+// SetCanaryWeight changes the percentage of its module's traffic routed to a
+// canary deployment.
+//
+// A weight of 100 promotes the canary, replacing the module's existing
+// deployment.
+func (d *DAL) SetCanaryWeight(ctx context.Context, name model.DeploymentName, weight int) (err error) {
+	if weight < 0 || weight > 100 {
+		return fmt.Errorf("canary weight must be between 0 and 100, not %d", weight)
+	}
+	if weight == 100 {
+		return d.promoteCanary(ctx, name)
+	}
+	count, err := d.db.SetCanaryWeight(ctx, int32(weight), name)
+	if err != nil {
+		return translatePGError(err)
+	}
+	if count == 0 {
+		return fmt.Errorf("%s: %w", "no active canary deployment", ErrNotFound)
+	}
+	return nil
+}
+
+func (d *DAL) promoteCanary(ctx context.Context, name model.DeploymentName) (err error) {
+	tx, err := d.db.Begin(ctx)
+	if err != nil {
+		return translatePGError(err)
+	}
+	defer tx.CommitOrRollback(ctx, &err)
+
+	deployment, err := tx.GetDeployment(ctx, name)
+	if err != nil {
+		return translatePGError(err)
+	}
+	if !deployment.Deployment.Canary || deployment.MinReplicas == 0 {
+		return fmt.Errorf("%s: %w", "no active canary deployment", ErrNotFound)
+	}
+	var replaced optional.Option[string]
+	existing, err := tx.GetExistingDeploymentForModule(ctx, deployment.ModuleName)
+	if err == nil {
+		err = tx.SetDeploymentDesiredReplicas(ctx, existing.Name, 0)
+		if err != nil {
+			return translatePGError(err)
+		}
+		replaced = optional.Some(existing.Name.String())
+	} else if !isNotFound(err) {
+		return translatePGError(err)
+	}
+	err = tx.PromoteCanaryDeployment(ctx, name)
+	if err != nil {
+		return translatePGError(err)
+	}
+	err = tx.InsertDeploymentCreatedEvent(ctx, sql.InsertDeploymentCreatedEventParams{
+		DeploymentName: name.String(),
+		Language:       deployment.Language,
+		ModuleName:     deployment.ModuleName,
+		MinReplicas:    deployment.MinReplicas,
+		Replaced:       replaced,
+	})
+	if err != nil {
+		return translatePGError(err)
+	}
+	return nil
+}
+
+// RollbackCanaryDeployment stops routing traffic to a canary deployment and
+// deactivates it, leaving the module's existing deployment in place.
+func (d *DAL) RollbackCanaryDeployment(ctx context.Context, name model.DeploymentName) (err error) {
+	tx, err := d.db.Begin(ctx)
+	if err != nil {
+		return translatePGError(err)
+	}
+	defer tx.CommitOrRollback(ctx, &err)
+	return retireCanary(ctx, tx, name)
+}
+
+// retireCanary removes a canary from routing and sets its desired replicas to
+// zero.
+func retireCanary(ctx context.Context, tx *sql.Tx, name model.DeploymentName) error {
+	deployment, err := tx.GetDeployment(ctx, name)
+	if err != nil {
+		return translatePGError(err)
+	}
+	count, err := tx.SetCanaryWeight(ctx, 0, name)
+	if err != nil {
+		return translatePGError(err)
+	}
+	if count == 0 {
+		return fmt.Errorf("%s: %w", "no active canary deployment", ErrNotFound)
+	}
+	err = tx.SetDeploymentDesiredReplicas(ctx, name, 0)
+	if err != nil {
+		return translatePGError(err)
+	}
+	err = tx.InsertDeploymentUpdatedEvent(ctx, sql.InsertDeploymentUpdatedEventParams{
+		DeploymentName:  name.String(),
+		Language:        deployment.Language,
+		ModuleName:      deployment.ModuleName,
+		PrevMinReplicas: deployment.MinReplicas,
+		MinReplicas:     0,
+	})
+	if err != nil {
+		return translatePGError(err)
+	}
+	return nil
+}
+
+// GetCanaryDeployments returns all active canary deployments.
+func (d *DAL) GetCanaryDeployments(ctx context.Context) ([]CanaryDeployment, error) {
+	rows, err := d.db.GetCanaryDeployments(ctx)
+	if err != nil {
+		return nil, translatePGError(err)
+	}
+	return slices.Map(rows, func(row sql.GetCanaryDeploymentsRow) CanaryDeployment {
+		return CanaryDeployment{
+			Deployment:  row.DeploymentName,
+			Module:      row.ModuleName,
+			Weight:      int(row.CanaryWeight),
+			MinReplicas: int(row.MinReplicas),
+		}
+	}), nil
+}
+
+// GetDeploymentCallStats returns statistics for the calls made to a deployment
+// since the given time.
+func (d *DAL) GetDeploymentCallStats(ctx context.Context, name model.DeploymentName, since time.Time) (CallStats, error) {
+	row, err := d.db.GetDeploymentCallStats(ctx, name, since)
+	if err != nil {
+		return CallStats{}, translatePGError(err)
+	}
+	return CallStats{Calls: int(row.Calls), Errors: int(row.Errors)}, nil
+}
+
+type weightedRoute interface {
+	deploymentWeight() (model.DeploymentName, int)
+}
+
+// PickRoute picks a random route, first choosing a deployment in proportion
+// to the deployments' weights and then a random route to that deployment.
+//
+// Deployments are chosen uniformly if all weights are zero. Returns false if
+// there are no routes.
+func PickRoute[R weightedRoute](routes []R) (route R, ok bool) {
+	if len(routes) == 0 {
+		return route, false
+	}
+	weights := map[model.DeploymentName]int{}
+	deployments := []model.DeploymentName{}
+	total := 0
+	for _, route := range routes {
+		deployment, weight := route.deploymentWeight()
+		if _, ok := weights[deployment]; ok {
+			continue
+		}
+		weights[deployment] = weight
+		deployments = append(deployments, deployment)
+		total += weight
+	}
+	chosen := deployments[rand.Intn(len(deployments))] //nolint:gosec
+	if total > 0 {
+		n := rand.Intn(total) //nolint:gosec
+		for _, deployment := range deployments {
+			n -= weights[deployment]
+			if n < 0 {
+				chosen = deployment
+				break
+			}
+		}
+	}
+	candidates := make([]R, 0, len(routes))
+	for _, route := range routes {
+		if deployment, _ := route.deploymentWeight(); deployment == chosen {
+			candidates = append(candidates, route)
+		}
+	}
+	return candidates[rand.Intn(len(candidates))], true //nolint:gosec
+}
+
+// deploymentWeight returns the percentage of a module's traffic that should be
+// routed to a deployment, given the weight of the module's canary, if any.
+func deploymentWeight(canary bool, canaryWeight int, moduleCanaryWeight optional.Option[int]) int {
+	if canary {
+		return canaryWeight
+	}
+	if weight, ok := moduleCanaryWeight.Get(); ok {
+		return 100 - weight
+	}
+	return 100
+}
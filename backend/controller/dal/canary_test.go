@@ -0,0 +1,52 @@
+package dal
+
+import (
+	"testing"
+
+	"github.com/alecthomas/assert/v2"
+	"github.com/alecthomas/types/optional"
+
+	"github.com/TBD54566975/ftl/internal/model"
+)
+
+func TestDeploymentWeight(t *testing.T) {
+	assert.Equal(t, 100, deploymentWeight(false, 0, optional.None[int]()))
+	assert.Equal(t, 75, deploymentWeight(false, 0, optional.Some(25)))
+	assert.Equal(t, 25, deploymentWeight(true, 25, optional.Some(25)))
+}
+
+func TestPickRoute(t *testing.T) {
+	stable := model.NewDeploymentName("echo")
+	canary := model.NewDeploymentName("echo")
+	routes := []Route{
+		{Deployment: stable, Endpoint: "http://a", Weight: 100},
+		{Deployment: stable, Endpoint: "http://b", Weight: 100},
+		{Deployment: canary, Endpoint: "http://c", Weight: 0},
+	}
+	for range 20 {
+		assert.NotEqual(t, "http://c", pickEndpoint(t, routes))
+	}
+
+	routes[0].Weight, routes[1].Weight, routes[2].Weight = 0, 0, 100
+	for range 20 {
+		assert.Equal(t, "http://c", pickEndpoint(t, routes))
+	}
+
+	// Deployments are picked uniformly if there are no weights.
+	routes[2].Weight = 0
+	seen := map[string]bool{}
+	for range 100 {
+		seen[pickEndpoint(t, routes)] = true
+	}
+	assert.Equal(t, map[string]bool{"http://a": true, "http://b": true, "http://c": true}, seen)
+
+	_, ok := PickRoute([]Route{})
+	assert.False(t, ok)
+}
+
+func pickEndpoint(t *testing.T, routes []Route) string {
+	t.Helper()
+	route, ok := PickRoute(routes)
+	assert.True(t, ok)
+	return route.Endpoint
+}
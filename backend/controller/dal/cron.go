@@ -0,0 +1,91 @@
+package dal
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/TBD54566975/ftl/backend/controller/sql"
+	"github.com/TBD54566975/ftl/backend/schema"
+	"github.com/TBD54566975/ftl/internal/cron"
+	"github.com/TBD54566975/ftl/internal/model"
+	"github.com/TBD54566975/ftl/internal/slices"
+)
+
+// A CronJob is a Verb that is called periodically according to its schedule.
+type CronJob struct {
+	ID            int64
+	Deployment    model.DeploymentName
+	Verb          schema.VerbRef
+	Schedule      string
+	NextExecution time.Time
+}
+
+// GetCronJobs returns the cron jobs of all active deployments, ordered by
+// their next execution time.
+func (d *DAL) GetCronJobs(ctx context.Context) ([]CronJob, error) {
+	rows, err := d.db.GetCronJobs(ctx)
+	if err != nil {
+		return nil, translatePGError(err)
+	}
+	return slices.Map(rows, func(row sql.GetCronJobsRow) CronJob {
+		return CronJob{
+			ID:            row.ID,
+			Deployment:    row.DeploymentName,
+			Verb:          schema.VerbRef{Module: row.ModuleName, Name: row.Verb},
+			Schedule:      row.Schedule,
+			NextExecution: row.NextExecution,
+		}
+	}), nil
+}
+
+// ClaimCronJobExecution claims the pending execution of a cron job and
+// schedules its next execution.
+//
+// Returns false if the execution has already been claimed, eg. by another
+// controller.
+func (d *DAL) ClaimCronJobExecution(ctx context.Context, job CronJob, next time.Time) (bool, error) {
+	count, err := d.db.ClaimCronJobExecution(ctx, next, job.ID, job.NextExecution)
+	if err != nil {
+		return false, translatePGError(err)
+	}
+	return count == 1, nil
+}
+
+// CreateCronRequest creates a new request originating from a cron job.
+func (d *DAL) CreateCronRequest(ctx context.Context, verb schema.VerbRef, addr string) (model.RequestName, error) {
+	name := model.NewRequestName(model.OriginCron, verb.String())
+	err := d.db.CreateIngressRequest(ctx, sql.OriginCron, string(name), addr)
+	return name, translatePGError(err)
+}
+
+// Create cron jobs for any Verbs in the module with a cron schedule.
+func createCronJobs(ctx context.Context, tx *sql.Tx, deploymentName model.DeploymentName, module *schema.Module, now time.Time) error {
+	for _, decl := range module.Decls {
+		verb, ok := decl.(*schema.Verb)
+		if !ok {
+			continue
+		}
+		for _, md := range verb.Metadata {
+			md, ok := md.(*schema.MetadataCron)
+			if !ok {
+				continue
+			}
+			pattern, err := cron.Parse(md.Cron)
+			if err != nil {
+				return fmt.Errorf("%s: %w", verb.Name, err)
+			}
+			err = tx.CreateCronJob(ctx, sql.CreateCronJobParams{
+				DeploymentName: deploymentName.String(),
+				ModuleName:     module.Name,
+				Verb:           verb.Name,
+				Schedule:       md.Cron,
+				NextExecution:  pattern.Next(now),
+			})
+			if err != nil {
+				return translatePGError(err)
+			}
+		}
+	}
+	return nil
+}
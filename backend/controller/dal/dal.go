@@ -43,11 +43,16 @@ type IngressRoute struct {
 	Runner     model.RunnerKey
 	Deployment model.DeploymentName
 	Endpoint   string
+	Method     string
 	Path       string
 	Module     string
 	Verb       string
+	// Weight is the percentage of the module's traffic routed to the deployment.
+	Weight int
 }
 
+func (r IngressRoute) deploymentWeight() (model.DeploymentName, int) { return r.Deployment, r.Weight }
+
 type IngressRouteEntry struct {
 	Deployment model.DeploymentName
 	Module     string
@@ -83,32 +88,37 @@ func DeploymentArtefactFromProto(in *ftlv1.DeploymentArtefact) (DeploymentArtefa
 }
 
 func runnerFromDB(row sql.GetRunnerRow) Runner {
-	var deployment optional.Option[model.DeploymentName]
-	if name, ok := row.DeploymentName.Get(); ok {
-		deployment = optional.Some(model.DeploymentName(name))
-	}
 	attrs := model.Labels{}
 	if err := json.Unmarshal(row.Labels, &attrs); err != nil {
 		return Runner{}
 	}
 	return Runner{
-		Key:        model.RunnerKey(row.RunnerKey),
-		Endpoint:   row.Endpoint,
-		State:      RunnerState(row.State),
-		Deployment: deployment,
-		Labels:     attrs,
+		Key:         model.RunnerKey(row.RunnerKey),
+		Endpoint:    row.Endpoint,
+		State:       RunnerState(row.State),
+		Deployments: deploymentNames(row.DeploymentNames),
+		Capacity:    int(row.Capacity),
+		Labels:      attrs,
+		Unhealthy:   row.Unhealthy,
 	}
 }
 
+func deploymentNames(names []string) []model.DeploymentName {
+	return slices.Map(names, func(name string) model.DeploymentName { return model.DeploymentName(name) })
+}
+
 type Runner struct {
-	Key                model.RunnerKey
-	Endpoint           string
-	State              RunnerState
-	ReservationTimeout optional.Option[time.Duration]
-	Module             optional.Option[string]
-	// Assigned deployment key, if any.
-	Deployment optional.Option[model.DeploymentName]
-	Labels     model.Labels
+	Key      model.RunnerKey
+	Endpoint string
+	State    RunnerState
+	// Deployments assigned to the runner.
+	Deployments []model.DeploymentName
+	// Maximum number of deployments the runner can host.
+	Capacity int
+	Labels   model.Labels
+	// True if any of the runner's deployments are crash-looping, in which case
+	// the runner is not routed to.
+	Unhealthy bool
 }
 
 func (r Runner) notification() {}
@@ -172,6 +182,10 @@ type Deployment struct {
 	Schema      *schema.Module
 	CreatedAt   time.Time
 	Labels      model.Labels
+	// Canary is true if the deployment is receiving a share of its module's
+	// traffic alongside the module's existing deployment.
+	Canary       bool
+	CanaryWeight int
 }
 
 func (d Deployment) String() string { return d.Name.String() }
@@ -204,6 +218,8 @@ type Route struct {
 	Runner     model.RunnerKey
 	Deployment model.DeploymentName
 	Endpoint   string
+	// Weight is the percentage of the module's traffic routed to the deployment.
+	Weight int
 }
 
 func (r Route) String() string {
@@ -212,6 +228,8 @@ func (r Route) String() string {
 
 func (r Route) notification() {}
 
+func (r Route) deploymentWeight() (model.DeploymentName, int) { return r.Deployment, r.Weight }
+
 func WithReservation(ctx context.Context, reservation Reservation, fn func() error) error {
 	if err := fn(); err != nil {
 		if rerr := reservation.Rollback(ctx); rerr != nil {
@@ -288,32 +306,32 @@ func (d *DAL) GetStatus(
 			return Deployment{}, fmt.Errorf("%q: invalid labels in database: %w", in.ModuleName, err)
 		}
 		return Deployment{
-			Name:        in.Deployment.Name,
-			Module:      in.ModuleName,
-			Language:    in.Language,
-			MinReplicas: int(in.Deployment.MinReplicas),
-			Schema:      in.Deployment.Schema,
-			Labels:      labels,
+			Name:         in.Deployment.Name,
+			Module:       in.ModuleName,
+			Language:     in.Language,
+			MinReplicas:  int(in.Deployment.MinReplicas),
+			Schema:       in.Deployment.Schema,
+			Labels:       labels,
+			Canary:       in.Deployment.Canary,
+			CanaryWeight: int(in.Deployment.CanaryWeight),
 		}, nil
 	})
 	if err != nil {
 		return Status{}, err
 	}
 	domainRunners, err := slices.MapErr(runners, func(in sql.GetActiveRunnersRow) (Runner, error) {
-		var deployment optional.Option[model.DeploymentName]
-		if name, ok := in.DeploymentName.Get(); ok {
-			deployment = optional.Some(model.DeploymentName(name))
-		}
 		attrs := model.Labels{}
 		if err := json.Unmarshal(in.Labels, &attrs); err != nil {
 			return Runner{}, fmt.Errorf("invalid attributes JSON for runner %s: %w", in.RunnerKey, err)
 		}
 		return Runner{
-			Key:        model.RunnerKey(in.RunnerKey),
-			Endpoint:   in.Endpoint,
-			State:      RunnerState(in.State),
-			Deployment: deployment,
-			Labels:     attrs,
+			Key:         model.RunnerKey(in.RunnerKey),
+			Endpoint:    in.Endpoint,
+			State:       RunnerState(in.State),
+			Deployments: deploymentNames(in.DeploymentNames),
+			Capacity:    int(in.Capacity),
+			Labels:      attrs,
+			Unhealthy:   in.Unhealthy,
 		}, nil
 	})
 	if err != nil {
@@ -334,7 +352,7 @@ func (d *DAL) GetStatus(
 		}),
 		Routes: slices.Map(routes, func(row sql.GetRoutingTableRow) Route {
 			return Route{
-				Module:     row.ModuleName.MustGet(),
+				Module:     row.ModuleName,
 				Runner:     model.RunnerKey(row.RunnerKey),
 				Deployment: row.DeploymentName,
 				Endpoint:   row.Endpoint,
@@ -351,15 +369,17 @@ func (d *DAL) GetRunnersForDeployment(ctx context.Context, deployment model.Depl
 	}
 	for _, row := range rows {
 		attrs := model.Labels{}
-		if err := json.Unmarshal(row.Labels, &attrs); err != nil {
-			return nil, fmt.Errorf("invalid attributes JSON for runner %d: %w", row.ID, err)
+		if err := json.Unmarshal(row.Runner.Labels, &attrs); err != nil {
+			return nil, fmt.Errorf("invalid attributes JSON for runner %d: %w", row.Runner.ID, err)
 		}
 		runners = append(runners, Runner{
-			Key:        model.RunnerKey(row.Key),
-			Endpoint:   row.Endpoint,
-			State:      RunnerState(row.State),
-			Deployment: optional.Some(deployment),
-			Labels:     attrs,
+			Key:         model.RunnerKey(row.Runner.Key),
+			Endpoint:    row.Runner.Endpoint,
+			State:       RunnerState(row.Runner.State),
+			Deployments: []model.DeploymentName{deployment},
+			Capacity:    int(row.Runner.Capacity),
+			Labels:      attrs,
+			Unhealthy:   row.Runner.Unhealthy,
 		})
 	}
 	return runners, nil
@@ -398,8 +418,9 @@ type IngressRoutingEntry struct {
 // CreateDeployment (possibly) creates a new deployment and associates
 // previously created artefacts with it.
 //
-// If an existing deployment with identical artefacts exists, it is returned.
-func (d *DAL) CreateDeployment(ctx context.Context, language string, moduleSchema *schema.Module, artefacts []DeploymentArtefact, ingressRoutes []IngressRoutingEntry) (key model.DeploymentName, err error) {
+// If an existing deployment with identical artefacts and limits exists, it is
+// returned.
+func (d *DAL) CreateDeployment(ctx context.Context, language string, moduleSchema *schema.Module, artefacts []DeploymentArtefact, ingressRoutes []IngressRoutingEntry, limits model.DeploymentLimits) (key model.DeploymentName, err error) {
 	logger := log.FromContext(ctx)
 
 	// Start the transaction
@@ -410,7 +431,12 @@ func (d *DAL) CreateDeployment(ctx context.Context, language string, moduleSchem
 
 	defer tx.CommitOrRollback(ctx, &err)
 
-	existingDeployment, err := d.checkForExistingDeployments(ctx, tx, moduleSchema, artefacts)
+	limitsBytes, err := json.Marshal(limits)
+	if err != nil {
+		return "", fmt.Errorf("%s: %w", "failed to JSON encode deployment limits", err)
+	}
+
+	existingDeployment, err := d.checkForExistingDeployments(ctx, tx, moduleSchema, artefacts, limitsBytes)
 	if err != nil {
 		return "", err
 	} else if existingDeployment != "" {
@@ -435,7 +461,12 @@ func (d *DAL) CreateDeployment(ctx context.Context, language string, moduleSchem
 
 	deploymentName := model.NewDeploymentName(moduleSchema.Name)
 	// Create the deployment
-	err = tx.CreateDeployment(ctx, deploymentName, moduleSchema.Name, schemaBytes)
+	err = tx.CreateDeployment(ctx, sql.CreateDeploymentParams{
+		Name:       deploymentName,
+		ModuleName: moduleSchema.Name,
+		Schema:     schemaBytes,
+		Limits:     limitsBytes,
+	})
 	if err != nil {
 		return "", fmt.Errorf("%s: %w", "failed to create deployment", translatePGError(err))
 	}
@@ -477,6 +508,16 @@ func (d *DAL) CreateDeployment(ctx context.Context, language string, moduleSchem
 		}
 	}
 
+	err = createCronJobs(ctx, tx, deploymentName, moduleSchema, time.Now().UTC())
+	if err != nil {
+		return "", fmt.Errorf("%s: %w", "failed to create cron jobs", err)
+	}
+
+	err = createTopicsAndSubscriptions(ctx, tx, moduleSchema)
+	if err != nil {
+		return "", fmt.Errorf("%s: %w", "failed to create topics and subscriptions", err)
+	}
+
 	return deploymentName, nil
 }
 
@@ -492,30 +533,42 @@ func (d *DAL) GetDeployment(ctx context.Context, name model.DeploymentName) (*mo
 //
 // ErrConflict will be returned if a runner with the same endpoint and a
 // different key already exists.
-func (d *DAL) UpsertRunner(ctx context.Context, runner Runner) error {
-	var pgDeploymentName optional.Option[string]
-	if dkey, ok := runner.Deployment.Get(); ok {
-		pgDeploymentName = optional.Some(dkey.String())
-	}
+func (d *DAL) UpsertRunner(ctx context.Context, runner Runner) (err error) {
 	attrBytes, err := json.Marshal(runner.Labels)
 	if err != nil {
 		return fmt.Errorf("%s: %w", "failed to JSON encode runner labels", err)
 	}
-	deploymentID, err := d.db.UpsertRunner(ctx, sql.UpsertRunnerParams{
-		Key:            sql.Key(runner.Key),
-		Endpoint:       runner.Endpoint,
-		State:          sql.RunnerState(runner.State),
-		DeploymentName: pgDeploymentName,
-		Labels:         attrBytes,
+	tx, err := d.db.Begin(ctx)
+	if err != nil {
+		return translatePGError(err)
+	}
+	defer tx.CommitOrRollback(ctx, &err)
+	runnerID, err := tx.UpsertRunner(ctx, sql.UpsertRunnerParams{
+		Key:       sql.Key(runner.Key),
+		Endpoint:  runner.Endpoint,
+		State:     sql.RunnerState(runner.State),
+		Labels:    attrBytes,
+		Capacity:  int32(runner.Capacity),
+		Unhealthy: runner.Unhealthy,
 	})
 	if err != nil {
 		return translatePGError(err)
 	}
+	names := slices.Map(runner.Deployments, func(name model.DeploymentName) string { return name.String() })
+	found, err := tx.SetRunnerDeployments(ctx, names, runnerID)
 	if err != nil {
 		return translatePGError(err)
 	}
-	if runner.Deployment.Ok() && !deploymentID.Ok() {
-		return fmt.Errorf("deployment %s not found", runner.Deployment)
+	// A runner can briefly report a deployment that has since been removed, so
+	// unknown deployments are skipped rather than failing the whole update.
+	known := map[model.DeploymentName]bool{}
+	for _, name := range found {
+		known[name] = true
+	}
+	for _, name := range runner.Deployments {
+		if !known[name] {
+			log.FromContext(ctx).Warnf("Runner %s reported unknown deployment %s", runner.Key, name)
+		}
 	}
 	return nil
 }
@@ -544,6 +597,34 @@ func (d *DAL) DeregisterRunner(ctx context.Context, key model.RunnerKey) error {
 	return nil
 }
 
+// DrainRunnerDeployment stops routing calls for a deployment to the given
+// runner, so that its in-flight calls can complete before it is terminated.
+//
+// The deployment is released once the runner no longer reports it.
+func (d *DAL) DrainRunnerDeployment(ctx context.Context, key model.RunnerKey, deployment model.DeploymentName) error {
+	count, err := d.db.DrainRunnerDeployment(ctx, sql.Key(key), deployment)
+	if err != nil {
+		return translatePGError(err)
+	}
+	if count == 0 {
+		return ErrNotFound
+	}
+	return nil
+}
+
+// UndrainRunnerDeployment resumes routing calls for a draining deployment to
+// the given runner, after the runner could not be told to terminate it.
+func (d *DAL) UndrainRunnerDeployment(ctx context.Context, key model.RunnerKey, deployment model.DeploymentName) error {
+	count, err := d.db.UndrainRunnerDeployment(ctx, sql.Key(key), deployment)
+	if err != nil {
+		return translatePGError(err)
+	}
+	if count == 0 {
+		return ErrNotFound
+	}
+	return nil
+}
+
 // ReserveRunnerForDeployment reserves a runner for the given deployment.
 //
 // It returns a Reservation that must be committed or rolled back.
@@ -558,14 +639,24 @@ func (d *DAL) ReserveRunnerForDeployment(ctx context.Context, deployment model.D
 		cancel()
 		return nil, translatePGError(err)
 	}
-	runner, err := tx.ReserveRunner(ctx, time.Now().Add(reservationTimeout), deployment, jsonLabels)
+	runner, err := tx.ReserveRunner(ctx, deployment, jsonLabels, time.Now().Add(reservationTimeout))
+	if err == nil {
+		// Now that the runner is locked, recheck its capacity against any
+		// reservations committed since ReserveRunner's snapshot was taken, and
+		// fail as if no runner had been found if it is now over capacity.
+		var count int64
+		count, err = tx.GetRunnerDeploymentCount(ctx, runner.ID)
+		if err == nil && count > int64(runner.Capacity) {
+			err = pgx.ErrNoRows
+		}
+	}
 	if err != nil {
 		if rerr := tx.Rollback(context.Background()); rerr != nil {
 			err = errors.Join(err, translatePGError(rerr))
 		}
 		cancel()
 		if isNotFound(err) {
-			return nil, fmt.Errorf("no idle runners found matching labels %s: %w", jsonLabels, ErrNotFound)
+			return nil, fmt.Errorf("no runners with spare capacity found matching labels %s: %w", jsonLabels, ErrNotFound)
 		}
 		return nil, translatePGError(err)
 	}
@@ -578,11 +669,13 @@ func (d *DAL) ReserveRunnerForDeployment(ctx context.Context, deployment model.D
 		cancel: cancel,
 		tx:     tx,
 		runner: Runner{
-			Key:        model.RunnerKey(runner.Key),
-			Endpoint:   runner.Endpoint,
-			State:      RunnerState(runner.State),
-			Deployment: optional.Some(deployment),
-			Labels:     runnerLabels,
+			Key:         model.RunnerKey(runner.Key),
+			Endpoint:    runner.Endpoint,
+			State:       RunnerState(runner.State),
+			Deployments: []model.DeploymentName{deployment},
+			Capacity:    int(runner.Capacity),
+			Labels:      runnerLabels,
+			Unhealthy:   runner.Unhealthy,
 		},
 	}, nil
 }
@@ -655,6 +748,21 @@ func (d *DAL) ReplaceDeployment(ctx context.Context, newDeploymentName model.Dep
 
 	var replacedDeployment optional.Option[string]
 
+	// Replacing a module's deployment retires any canary in progress.
+	canary, err := tx.GetCanaryDeploymentForModule(ctx, newDeployment.ModuleName)
+	if err == nil {
+		if err := retireCanary(ctx, tx, canary.Name); err != nil {
+			return err
+		}
+		if canary.Name == newDeploymentName {
+			if err := tx.PromoteCanaryDeployment(ctx, newDeploymentName); err != nil {
+				return translatePGError(err)
+			}
+		}
+	} else if !isNotFound(err) {
+		return translatePGError(err)
+	}
+
 	// If there's an existing deployment, set its desired replicas to 0
 	oldDeployment, err := tx.GetExistingDeploymentForModule(ctx, newDeployment.ModuleName)
 	if err == nil {
@@ -722,12 +830,14 @@ func (d *DAL) GetActiveDeployments(ctx context.Context) ([]Deployment, error) {
 	}
 	return slices.MapErr(rows, func(in sql.GetActiveDeploymentsRow) (Deployment, error) {
 		return Deployment{
-			Name:        in.Deployment.Name,
-			Module:      in.ModuleName,
-			Language:    in.Language,
-			MinReplicas: int(in.Deployment.MinReplicas),
-			Schema:      in.Deployment.Schema,
-			CreatedAt:   in.Deployment.CreatedAt,
+			Name:         in.Deployment.Name,
+			Module:       in.ModuleName,
+			Language:     in.Language,
+			MinReplicas:  int(in.Deployment.MinReplicas),
+			Schema:       in.Deployment.Schema,
+			CreatedAt:    in.Deployment.CreatedAt,
+			Canary:       in.Deployment.Canary,
+			CanaryWeight: int(in.Deployment.CanaryWeight),
 		}, nil
 	})
 }
@@ -740,6 +850,30 @@ func (d *DAL) GetActiveDeploymentSchemas(ctx context.Context) ([]*schema.Module,
 	return slices.MapErr(rows, func(in sql.GetActiveDeploymentSchemasRow) (*schema.Module, error) { return in.Schema, nil })
 }
 
+// GetDeploymentHistory returns all current and past deployments of a module,
+// most recent first.
+func (d *DAL) GetDeploymentHistory(ctx context.Context, module string) ([]Deployment, error) {
+	rows, err := d.db.GetDeploymentHistory(ctx, module)
+	if err != nil {
+		return nil, translatePGError(err)
+	}
+	if len(rows) == 0 {
+		return nil, fmt.Errorf("%s: %w", "no deployments found", ErrNotFound)
+	}
+	return slices.Map(rows, func(in sql.GetDeploymentHistoryRow) Deployment {
+		return Deployment{
+			Name:         in.Deployment.Name,
+			Module:       in.ModuleName,
+			Language:     in.Language,
+			MinReplicas:  int(in.Deployment.MinReplicas),
+			Schema:       in.Deployment.Schema,
+			CreatedAt:    in.Deployment.CreatedAt,
+			Canary:       in.Deployment.Canary,
+			CanaryWeight: int(in.Deployment.CanaryWeight),
+		}
+	}), nil
+}
+
 type ProcessRunner struct {
 	Key      model.RunnerKey
 	Endpoint string
@@ -813,10 +947,12 @@ func (d *DAL) GetIdleRunners(ctx context.Context, limit int, labels model.Labels
 			return Runner{}, fmt.Errorf("%s: %w", "could not unmarshal labels", err)
 		}
 		return Runner{
-			Key:      model.RunnerKey(row.Key),
-			Endpoint: row.Endpoint,
-			State:    RunnerState(row.State),
-			Labels:   labels,
+			Key:       model.RunnerKey(row.Key),
+			Endpoint:  row.Endpoint,
+			State:     RunnerState(row.State),
+			Capacity:  int(row.Capacity),
+			Labels:    labels,
+			Unhealthy: row.Unhealthy,
 		}, nil
 	})
 }
@@ -833,15 +969,21 @@ func (d *DAL) GetRoutingTable(ctx context.Context, modules []string) (map[string
 	if len(routes) == 0 {
 		return nil, fmt.Errorf("%s: %w", "no routes found", ErrNotFound)
 	}
+	canaryWeights := map[string]optional.Option[int]{}
+	for _, route := range routes {
+		if route.Canary {
+			canaryWeights[route.ModuleName] = optional.Some(int(route.CanaryWeight))
+		}
+	}
 	out := make(map[string][]Route, len(routes))
 	for _, route := range routes {
-		// This is guaranteed to be non-nil by the query, but sqlc doesn't quite understand that.
-		moduleName := route.ModuleName.MustGet()
+		moduleName := route.ModuleName
 		out[moduleName] = append(out[moduleName], Route{
 			Module:     moduleName,
 			Deployment: route.DeploymentName,
 			Runner:     model.RunnerKey(route.RunnerKey),
 			Endpoint:   route.Endpoint,
+			Weight:     deploymentWeight(route.Canary, int(route.CanaryWeight), canaryWeights[moduleName]),
 		})
 	}
 	return out, nil
@@ -896,6 +1038,9 @@ func (d *DAL) loadDeployment(ctx context.Context, deployment sql.GetDeploymentRo
 		Name:     deployment.Deployment.Name,
 		Schema:   deployment.Deployment.Schema,
 	}
+	if err := json.Unmarshal(deployment.Deployment.Limits, &out.Limits); err != nil {
+		return nil, fmt.Errorf("invalid limits JSON for deployment %s: %w", out.Name, err)
+	}
 	artefacts, err := d.db.GetDeploymentArtefacts(ctx, deployment.Deployment.ID)
 	if err != nil {
 		return nil, translatePGError(err)
@@ -917,22 +1062,32 @@ func (d *DAL) CreateIngressRequest(ctx context.Context, route, addr string) (mod
 	return name, err
 }
 
-func (d *DAL) GetIngressRoutes(ctx context.Context, method string) ([]IngressRoute, error) {
-	routes, err := d.db.GetIngressRoutes(ctx, method)
+// GetIngressRoutes returns the ingress routes of every runner serving an
+// active deployment.
+func (d *DAL) GetIngressRoutes(ctx context.Context) ([]IngressRoute, error) {
+	routes, err := d.db.GetIngressRoutes(ctx)
 	if err != nil {
 		return nil, translatePGError(err)
 	}
 	if len(routes) == 0 {
 		return nil, ErrNotFound
 	}
+	canaryWeights := map[string]optional.Option[int]{}
+	for _, row := range routes {
+		if row.Canary {
+			canaryWeights[row.Module] = optional.Some(int(row.CanaryWeight))
+		}
+	}
 	return slices.Map(routes, func(row sql.GetIngressRoutesRow) IngressRoute {
 		return IngressRoute{
 			Runner:     model.RunnerKey(row.RunnerKey),
 			Deployment: row.DeploymentName,
 			Endpoint:   row.Endpoint,
+			Method:     row.Method,
 			Path:       row.Path,
 			Module:     row.Module,
 			Verb:       row.Verb,
+			Weight:     deploymentWeight(row.Canary, int(row.CanaryWeight), canaryWeights[row.Module]),
 		}
 	}), nil
 }
@@ -964,6 +1119,7 @@ func (d *DAL) InsertCallEvent(ctx context.Context, call *CallEvent) error {
 		Response:       call.Response,
 		Error:          call.Error,
 		Stack:          call.Stack,
+		Rejected:       call.Rejected,
 	}))
 }
 
@@ -977,17 +1133,18 @@ func (d *DAL) GetActiveRunners(ctx context.Context) ([]Runner, error) {
 	}), nil
 }
 
-// Check if a deployment exists that exactly matches the given artefacts and schema.
-func (*DAL) checkForExistingDeployments(ctx context.Context, tx *sql.Tx, moduleSchema *schema.Module, artefacts []DeploymentArtefact) (model.DeploymentName, error) {
+// Check if a deployment exists that exactly matches the given artefacts, schema and limits.
+func (*DAL) checkForExistingDeployments(ctx context.Context, tx *sql.Tx, moduleSchema *schema.Module, artefacts []DeploymentArtefact, limits []byte) (model.DeploymentName, error) {
 	schemaBytes, err := schema.ModuleToBytes(moduleSchema)
 	if err != nil {
 		return "", fmt.Errorf("failed to marshal schema: %w", err)
 	}
-	existing, err := tx.GetDeploymentsWithArtefacts(ctx,
-		sha256esToBytes(slices.Map(artefacts, func(in DeploymentArtefact) sha256.SHA256 { return in.Digest })),
-		schemaBytes,
-		int64(len(artefacts)),
-	)
+	existing, err := tx.GetDeploymentsWithArtefacts(ctx, sql.GetDeploymentsWithArtefactsParams{
+		Digests: sha256esToBytes(slices.Map(artefacts, func(in DeploymentArtefact) sha256.SHA256 { return in.Digest })),
+		Schema:  schemaBytes,
+		Limits:  limits,
+		Count:   int64(len(artefacts)),
+	})
 	if err != nil {
 		return "", fmt.Errorf("%s: %w", "couldn't check for existing deployment", err)
 	}
@@ -3,6 +3,7 @@ package dal
 import (
 	"bytes"
 	"context"
+	"errors"
 	"io"
 	"reflect"
 	"testing"
@@ -42,13 +43,14 @@ func TestDAL(t *testing.T) {
 	})
 
 	module := &schema.Module{Name: "test"}
+	limits := model.DeploymentLimits{CPU: 0.5, MemoryBytes: 256 << 20, OpenFiles: 1024}
 	var deploymentName model.DeploymentName
 	t.Run("CreateDeployment", func(t *testing.T) {
 		deploymentName, err = dal.CreateDeployment(ctx, "go", module, []DeploymentArtefact{{
 			Digest:     testSha,
 			Executable: true,
 			Path:       "dir/filename",
-		}}, nil)
+		}}, nil, limits)
 		assert.NoError(t, err)
 	})
 
@@ -63,6 +65,7 @@ func TestDAL(t *testing.T) {
 				Digest:     testSHA,
 				Content:    io.NopCloser(bytes.NewReader(testContent))},
 		},
+		Limits: limits,
 	}
 	expectedContent := artefactContent(t, deployment.Artefacts)
 
@@ -95,6 +98,7 @@ func TestDAL(t *testing.T) {
 			Labels:   labels,
 			Endpoint: "http://localhost:8080",
 			State:    RunnerStateIdle,
+			Capacity: 1,
 		})
 		assert.NoError(t, err)
 	})
@@ -105,6 +109,7 @@ func TestDAL(t *testing.T) {
 			Labels:   labels,
 			Endpoint: "http://localhost:8080",
 			State:    RunnerStateIdle,
+			Capacity: 1,
 		})
 		assert.Error(t, err)
 		assert.IsError(t, err, ErrConflict)
@@ -116,6 +121,7 @@ func TestDAL(t *testing.T) {
 			Labels:   labels,
 			Endpoint: "http://localhost:8080",
 			State:    RunnerStateIdle,
+			Capacity: 1,
 		}
 		runners, err := dal.GetIdleRunners(ctx, 10, labels)
 		assert.NoError(t, err)
@@ -123,11 +129,12 @@ func TestDAL(t *testing.T) {
 	})
 
 	expectedRunner := Runner{
-		Key:        runnerID,
-		Labels:     labels,
-		Endpoint:   "http://localhost:8080",
-		State:      RunnerStateReserved,
-		Deployment: optional.Some(deploymentName),
+		Key:         runnerID,
+		Labels:      labels,
+		Endpoint:    "http://localhost:8080",
+		State:       RunnerStateReserved,
+		Deployments: []model.DeploymentName{deploymentName},
+		Capacity:    1,
 	}
 
 	t.Run("GetDeploymentsNeedingReconciliation", func(t *testing.T) {
@@ -185,11 +192,12 @@ func TestDAL(t *testing.T) {
 
 	t.Run("UpdateRunnerAssigned", func(t *testing.T) {
 		err := dal.UpsertRunner(ctx, Runner{
-			Key:        runnerID,
-			Labels:     labels,
-			Endpoint:   "http://localhost:8080",
-			State:      RunnerStateAssigned,
-			Deployment: optional.Some(deploymentName),
+			Key:         runnerID,
+			Labels:      labels,
+			Endpoint:    "http://localhost:8080",
+			State:       RunnerStateAssigned,
+			Deployments: []model.DeploymentName{deploymentName},
+			Capacity:    1,
 		})
 		assert.NoError(t, err)
 	})
@@ -204,11 +212,12 @@ func TestDAL(t *testing.T) {
 		runners, err := dal.GetRunnersForDeployment(ctx, deploymentName)
 		assert.NoError(t, err)
 		assert.Equal(t, []Runner{{
-			Key:        runnerID,
-			Labels:     labels,
-			Endpoint:   "http://localhost:8080",
-			State:      RunnerStateAssigned,
-			Deployment: optional.Some(deploymentName),
+			Key:         runnerID,
+			Labels:      labels,
+			Endpoint:    "http://localhost:8080",
+			State:       RunnerStateAssigned,
+			Deployments: []model.DeploymentName{deploymentName},
+			Capacity:    1,
 		}}, runners)
 	})
 
@@ -292,13 +301,63 @@ func TestDAL(t *testing.T) {
 		}}, routes[deployment.Module])
 	})
 
+	t.Run("UnhealthyRunnersAreNotRouted", func(t *testing.T) {
+		runner := Runner{
+			Key:         runnerID,
+			Labels:      labels,
+			Endpoint:    "http://localhost:8080",
+			State:       RunnerStateAssigned,
+			Deployments: []model.DeploymentName{deploymentName},
+			Capacity:    1,
+			Unhealthy:   true,
+		}
+		err := dal.UpsertRunner(ctx, runner)
+		assert.NoError(t, err)
+		_, err = dal.GetRoutingTable(ctx, []string{deployment.Module})
+		assert.IsError(t, err, ErrNotFound)
+
+		runner.Unhealthy = false
+		err = dal.UpsertRunner(ctx, runner)
+		assert.NoError(t, err)
+		routes, err := dal.GetRoutingTable(ctx, []string{deployment.Module})
+		assert.NoError(t, err)
+		assert.Equal(t, 1, len(routes[deployment.Module]))
+	})
+
+	t.Run("DrainRunnerDeployment", func(t *testing.T) {
+		err := dal.DrainRunnerDeployment(ctx, runnerID, deploymentName)
+		assert.NoError(t, err)
+		_, err = dal.GetRoutingTable(ctx, []string{deployment.Module})
+		assert.IsError(t, err, ErrNotFound)
+	})
+
+	t.Run("DrainingDeploymentRemainsUnrouted", func(t *testing.T) {
+		err := dal.UpsertRunner(ctx, Runner{
+			Key:         runnerID,
+			Labels:      labels,
+			Endpoint:    "http://localhost:8080",
+			State:       RunnerStateAssigned,
+			Deployments: []model.DeploymentName{deploymentName},
+			Capacity:    1,
+		})
+		assert.NoError(t, err)
+		_, err = dal.GetRoutingTable(ctx, []string{deployment.Module})
+		assert.IsError(t, err, ErrNotFound)
+	})
+
+	t.Run("DrainRunnerDeploymentFailsOnDraining", func(t *testing.T) {
+		err := dal.DrainRunnerDeployment(ctx, runnerID, deploymentName)
+		assert.IsError(t, err, ErrNotFound)
+	})
+
 	t.Run("UpdateRunnerInvalidDeployment", func(t *testing.T) {
 		err := dal.UpsertRunner(ctx, Runner{
-			Key:        runnerID,
-			Labels:     labels,
-			Endpoint:   "http://localhost:8080",
-			State:      RunnerStateAssigned,
-			Deployment: optional.Some(model.NewDeploymentName("test")),
+			Key:         runnerID,
+			Labels:      labels,
+			Endpoint:    "http://localhost:8080",
+			State:       RunnerStateAssigned,
+			Deployments: []model.DeploymentName{model.NewDeploymentName("test")},
+			Capacity:    1,
 		})
 		assert.Error(t, err)
 		assert.IsError(t, err, ErrNotFound)
@@ -310,6 +369,7 @@ func TestDAL(t *testing.T) {
 			Labels:   labels,
 			Endpoint: "http://localhost:8080",
 			State:    RunnerStateIdle,
+			Capacity: 1,
 		})
 		assert.NoError(t, err)
 	})
@@ -338,6 +398,155 @@ func TestDAL(t *testing.T) {
 	})
 }
 
+func TestCronJobs(t *testing.T) {
+	ctx := log.ContextWithNewDefaultLogger(context.Background())
+	conn := sqltest.OpenForTesting(ctx, t)
+	dal, err := New(ctx, conn)
+	assert.NoError(t, err)
+
+	module := &schema.Module{Name: "test", Decls: []schema.Decl{
+		&schema.Verb{
+			Name:     "tick",
+			Request:  &schema.Unit{},
+			Response: &schema.Unit{},
+			Metadata: []schema.Metadata{&schema.MetadataCron{Cron: "*/5 * * * *"}},
+		},
+	}}
+	deploymentName, err := dal.CreateDeployment(ctx, "go", module, nil, nil, model.DeploymentLimits{})
+	assert.NoError(t, err)
+
+	t.Run("InactiveDeploymentHasNoJobs", func(t *testing.T) {
+		jobs, err := dal.GetCronJobs(ctx)
+		assert.NoError(t, err)
+		assert.Equal(t, 0, len(jobs))
+	})
+
+	err = dal.SetDeploymentReplicas(ctx, deploymentName, 1)
+	assert.NoError(t, err)
+
+	var job CronJob
+	t.Run("GetCronJobs", func(t *testing.T) {
+		jobs, err := dal.GetCronJobs(ctx)
+		assert.NoError(t, err)
+		assert.Equal(t, 1, len(jobs))
+		job = jobs[0]
+		assert.Equal(t, deploymentName, job.Deployment)
+		assert.Equal(t, schema.VerbRef{Module: "test", Name: "tick"}, job.Verb)
+		assert.Equal(t, "*/5 * * * *", job.Schedule)
+	})
+
+	t.Run("ClaimCronJobExecutionOnlyOnce", func(t *testing.T) {
+		next := job.NextExecution.Add(time.Minute * 5)
+		claimed, err := dal.ClaimCronJobExecution(ctx, job, next)
+		assert.NoError(t, err)
+		assert.True(t, claimed)
+
+		claimed, err = dal.ClaimCronJobExecution(ctx, job, next)
+		assert.NoError(t, err)
+		assert.False(t, claimed)
+	})
+}
+
+func TestPubSub(t *testing.T) {
+	ctx := log.ContextWithNewDefaultLogger(context.Background())
+	conn := sqltest.OpenForTesting(ctx, t)
+	dal, err := New(ctx, conn)
+	assert.NoError(t, err)
+
+	module := &schema.Module{Name: "test", Decls: []schema.Decl{
+		&schema.Data{Name: "Event"},
+		&schema.Topic{Name: "events", Event: &schema.DataRef{Module: "test", Name: "Event"}},
+		&schema.Subscription{Name: "consumers", Topic: &schema.TopicRef{Module: "test", Name: "events"}},
+		&schema.Verb{
+			Name:     "consume",
+			Request:  &schema.DataRef{Module: "test", Name: "Event"},
+			Response: &schema.Unit{},
+			Metadata: []schema.Metadata{&schema.MetadataSubscriber{Name: "consumers"}},
+		},
+	}}
+	_, err = dal.CreateDeployment(ctx, "go", module, nil, nil, model.DeploymentLimits{})
+	assert.NoError(t, err)
+
+	topic := schema.TopicRef{Module: "test", Name: "events"}
+	subscription := schema.Ref{Module: "test", Name: "consumers"}
+	payload := []byte(`{"message": "hello"}`)
+
+	t.Run("PublishEvent", func(t *testing.T) {
+		count, err := dal.PublishEvent(ctx, topic, payload)
+		assert.NoError(t, err)
+		assert.Equal(t, 1, count)
+	})
+
+	var event SubscriptionEvent
+	t.Run("ClaimSubscriptionEvents", func(t *testing.T) {
+		events, err := dal.ClaimSubscriptionEvents(ctx, 10, time.Minute)
+		assert.NoError(t, err)
+		assert.Equal(t, 1, len(events))
+		event = events[0]
+		assert.Equal(t, subscription, event.Subscription)
+		assert.Equal(t, 1, event.Attempts)
+		assert.Equal(t, payload, event.Payload)
+	})
+
+	t.Run("ClaimedEventsAreLeased", func(t *testing.T) {
+		events, err := dal.ClaimSubscriptionEvents(ctx, 10, time.Minute)
+		assert.NoError(t, err)
+		assert.Equal(t, 0, len(events))
+	})
+
+	t.Run("FailedEventsAreRetried", func(t *testing.T) {
+		err := dal.FailSubscriptionEvent(ctx, event, errors.New("failed"), time.Now().Add(-time.Minute), 2)
+		assert.NoError(t, err)
+		events, err := dal.ClaimSubscriptionEvents(ctx, 10, time.Minute)
+		assert.NoError(t, err)
+		assert.Equal(t, 1, len(events))
+		event = events[0]
+		assert.Equal(t, 2, event.Attempts)
+	})
+
+	t.Run("ExhaustedEventsAreDeadLettered", func(t *testing.T) {
+		err := dal.FailSubscriptionEvent(ctx, event, errors.New("failed again"), time.Now().Add(-time.Minute), 2)
+		assert.NoError(t, err)
+		events, err := dal.ClaimSubscriptionEvents(ctx, 10, time.Minute)
+		assert.NoError(t, err)
+		assert.Equal(t, 0, len(events))
+
+		deadLetters, err := dal.GetDeadLetterEvents(ctx, subscription)
+		assert.NoError(t, err)
+		assert.Equal(t, 1, len(deadLetters))
+		assert.Equal(t, 2, deadLetters[0].Attempts)
+		assert.Equal(t, optional.Some("failed again"), deadLetters[0].LastError)
+	})
+
+	t.Run("CompleteSubscriptionEvent", func(t *testing.T) {
+		_, err := dal.PublishEvent(ctx, topic, payload)
+		assert.NoError(t, err)
+		events, err := dal.ClaimSubscriptionEvents(ctx, 10, time.Minute)
+		assert.NoError(t, err)
+		assert.Equal(t, 1, len(events))
+		err = dal.CompleteSubscriptionEvent(ctx, events[0])
+		assert.NoError(t, err)
+
+		// Make the event due again, which is a no-op if it has been deleted.
+		err = dal.FailSubscriptionEvent(ctx, events[0], errors.New("failed"), time.Now().Add(-time.Minute), 10)
+		assert.NoError(t, err)
+		events, err = dal.ClaimSubscriptionEvents(ctx, 10, time.Minute)
+		assert.NoError(t, err)
+		assert.Equal(t, 0, len(events))
+	})
+
+	t.Run("SubscribeBeforeTopicIsDeployed", func(t *testing.T) {
+		subscriber := &schema.Module{Name: "subscriber", Decls: []schema.Decl{
+			&schema.Subscription{Name: "consumers", Topic: &schema.TopicRef{Module: "publisher", Name: "events"}},
+		}}
+		_, err := dal.CreateDeployment(ctx, "go", subscriber, nil, nil, model.DeploymentLimits{})
+		assert.NoError(t, err)
+		count, err := dal.PublishEvent(ctx, schema.TopicRef{Module: "publisher", Name: "events"}, payload)
+		assert.NoError(t, err)
+		assert.Equal(t, 1, count)
+	})
+}
+
 func artefactContent(t testing.TB, artefacts []*model.Artefact) [][]byte {
 	t.Helper()
 	var result [][]byte
@@ -61,6 +61,9 @@ type CallEvent struct {
 	Response       []byte
 	Error          optional.Option[string]
 	Stack          optional.Option[string]
+	// Rejected is true if the call was rejected before it reached the
+	// deployment.
+	Rejected bool
 }
 
 func (e *CallEvent) GetID() int64 { return e.ID }
@@ -0,0 +1,150 @@
+package dal
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/alecthomas/types/optional"
+
+	"github.com/TBD54566975/ftl/backend/controller/sql"
+	"github.com/TBD54566975/ftl/backend/schema"
+	"github.com/TBD54566975/ftl/internal/model"
+	"github.com/TBD54566975/ftl/internal/slices"
+)
+
+// A SubscriptionEvent is an event that has been claimed for delivery to the
+// subscribers of a subscription.
+type SubscriptionEvent struct {
+	ID int64
+	// Module and name of the subscription.
+	Subscription schema.Ref
+	// Number of delivery attempts, including the current one.
+	Attempts int
+	Payload  []byte
+}
+
+// A DeadLetterEvent is an event that could not be delivered to a
+// subscription.
+type DeadLetterEvent struct {
+	ID        int64
+	CreatedAt time.Time
+	Attempts  int
+	LastError optional.Option[string]
+	Payload   []byte
+}
+
+// PublishEvent durably enqueues an event for every subscription to a topic.
+//
+// Returns the number of subscriptions the event was enqueued for.
+func (d *DAL) PublishEvent(ctx context.Context, topic schema.TopicRef, payload []byte) (int, error) {
+	count, err := d.db.PublishEventForTopic(ctx, payload, topic.Module, topic.Name)
+	if err != nil {
+		return 0, translatePGError(err)
+	}
+	return int(count), nil
+}
+
+// ClaimSubscriptionEvents claims up to max events that are due for delivery.
+//
+// Claimed events will not be claimed again until the lease expires, so they
+// must be either completed or failed before then.
+func (d *DAL) ClaimSubscriptionEvents(ctx context.Context, max int, lease time.Duration) ([]SubscriptionEvent, error) {
+	rows, err := d.db.ClaimSubscriptionEvents(ctx, int32(max), lease)
+	if err != nil {
+		return nil, translatePGError(err)
+	}
+	return slices.Map(rows, func(row sql.ClaimSubscriptionEventsRow) SubscriptionEvent {
+		return SubscriptionEvent{
+			ID:           row.ID,
+			Subscription: schema.Ref{Module: row.ModuleName, Name: row.Subscription},
+			Attempts:     int(row.Attempts),
+			Payload:      row.Payload,
+		}
+	}), nil
+}
+
+// CompleteSubscriptionEvent removes a successfully delivered event from its
+// subscription's queue.
+func (d *DAL) CompleteSubscriptionEvent(ctx context.Context, event SubscriptionEvent) error {
+	return translatePGError(d.db.CompleteSubscriptionEvent(ctx, event.ID))
+}
+
+// FailSubscriptionEvent records a failed delivery attempt and schedules the
+// next attempt.
+//
+// Once the event has been attempted maxAttempts times it is moved to the
+// dead-letter queue and will not be retried.
+func (d *DAL) FailSubscriptionEvent(ctx context.Context, event SubscriptionEvent, deliveryErr error, nextAttempt time.Time, maxAttempts int) error {
+	err := d.db.FailSubscriptionEvent(ctx, sql.FailSubscriptionEventParams{
+		Error:       deliveryErr.Error(),
+		NextAttempt: nextAttempt,
+		MaxAttempts: int32(maxAttempts),
+		ID:          event.ID,
+	})
+	return translatePGError(err)
+}
+
+// GetDeadLetterEvents returns the events that could not be delivered to a
+// subscription.
+func (d *DAL) GetDeadLetterEvents(ctx context.Context, subscription schema.Ref) ([]DeadLetterEvent, error) {
+	rows, err := d.db.GetDeadLetterEvents(ctx, subscription.Module, subscription.Name)
+	if err != nil {
+		return nil, translatePGError(err)
+	}
+	return slices.Map(rows, func(row sql.GetDeadLetterEventsRow) DeadLetterEvent {
+		return DeadLetterEvent{
+			ID:        row.ID,
+			CreatedAt: row.CreatedAt,
+			Attempts:  int(row.Attempts),
+			LastError: row.LastError,
+			Payload:   row.Payload,
+		}
+	}), nil
+}
+
+// CreatePubSubRequest creates a new request originating from the delivery of
+// an event to a subscription.
+func (d *DAL) CreatePubSubRequest(ctx context.Context, subscription schema.Ref, addr string) (model.RequestName, error) {
+	name := model.NewRequestName(model.OriginPubsub, subscription.Name)
+	err := d.db.CreateIngressRequest(ctx, sql.OriginPubsub, string(name), addr)
+	return name, translatePGError(err)
+}
+
+// Create or update the topics and subscriptions declared by a module.
+//
+// Topics and subscriptions are keyed by module rather than deployment so that
+// queued events are retained across deployments.
+func createTopicsAndSubscriptions(ctx context.Context, tx *sql.Tx, module *schema.Module) error {
+	for _, decl := range module.Decls {
+		if topic, ok := decl.(*schema.Topic); ok {
+			if err := tx.UpsertTopic(ctx, module.Name, topic.Name); err != nil {
+				return fmt.Errorf("topic %s: %w", topic.Name, translatePGError(err))
+			}
+		}
+	}
+	for _, decl := range module.Decls {
+		if subscription, ok := decl.(*schema.Subscription); ok {
+			topicModule := subscription.Topic.Module
+			if topicModule == "" {
+				topicModule = module.Name
+			}
+			// The topic's module may not have been deployed yet, in which case
+			// the topic is created here and events published once it is
+			// deployed are delivered to the subscription.
+			if err := tx.UpsertTopic(ctx, topicModule, subscription.Topic.Name); err != nil {
+				return fmt.Errorf("subscription %s to %s: %w", subscription.Name, subscription.Topic, translatePGError(err))
+			}
+			err := tx.UpsertSubscription(ctx, sql.UpsertSubscriptionParams{
+				TopicModule: topicModule,
+				Topic:       subscription.Topic.Name,
+				ModuleName:  module.Name,
+				Name:        subscription.Name,
+			})
+			if err != nil {
+				return fmt.Errorf("subscription %s to %s: %w", subscription.Name, subscription.Topic, translatePGError(err))
+			}
+		}
+	}
+	return nil
+}
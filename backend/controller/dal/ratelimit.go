@@ -0,0 +1,78 @@
+package dal
+
+import (
+	"context"
+	"math"
+	"time"
+
+	"github.com/TBD54566975/ftl/backend/controller/sql"
+)
+
+// TokenBucket is the state of a rate limit token bucket.
+type TokenBucket struct {
+	Tokens  float64
+	Updated time.Time
+}
+
+// Take refills the bucket with refill tokens per second, up to capacity, and
+// then takes a token if one is available. If not, it returns how long until
+// one will be.
+func (b *TokenBucket) Take(capacity, refill float64, now time.Time) (retryAfter time.Duration, ok bool) {
+	// The clocks of controllers sharing a bucket may disagree slightly, so
+	// buckets never go back in time.
+	if now.After(b.Updated) {
+		b.Tokens = math.Min(capacity, b.Tokens+now.Sub(b.Updated).Seconds()*refill)
+		b.Updated = now
+	}
+	if b.Tokens < 1 {
+		return time.Duration((1 - b.Tokens) / refill * float64(time.Second)), false
+	}
+	b.Tokens--
+	return 0, true
+}
+
+// FullAt returns the time at which the bucket will have refilled.
+func (b *TokenBucket) FullAt(capacity, refill float64) time.Time {
+	return b.Updated.Add(time.Duration(math.Max(capacity-b.Tokens, 0) / refill * float64(time.Second)))
+}
+
+// TakeRateLimitToken takes a token from the rate limit token bucket with the
+// given key, which holds up to capacity tokens and refills with refill tokens
+// per second. If the bucket is empty, it returns how long until a token will
+// be available.
+//
+// Buckets are held in the database so that limits are shared by all
+// controllers.
+func (d *DAL) TakeRateLimitToken(ctx context.Context, key string, capacity, refill float64, now time.Time) (retryAfter time.Duration, ok bool, err error) {
+	tx, err := d.db.Begin(ctx)
+	if err != nil {
+		return 0, false, translatePGError(err)
+	}
+	defer tx.CommitOrRollback(ctx, &err)
+	row, err := tx.LockRateLimitBucket(ctx, key, capacity, now)
+	if err != nil {
+		return 0, false, translatePGError(err)
+	}
+	bucket := TokenBucket{Tokens: row.Tokens, Updated: row.UpdatedAt}
+	retryAfter, ok = bucket.Take(capacity, refill, now)
+	err = tx.UpdateRateLimitBucket(ctx, sql.UpdateRateLimitBucketParams{
+		Tokens:    bucket.Tokens,
+		UpdatedAt: bucket.Updated,
+		FullAt:    bucket.FullAt(capacity, refill),
+		Key:       key,
+	})
+	if err != nil {
+		return 0, false, translatePGError(err)
+	}
+	return retryAfter, ok, nil
+}
+
+// DeleteFullRateLimitBuckets deletes rate limit token buckets that have
+// refilled by the given time, returning the number deleted.
+func (d *DAL) DeleteFullRateLimitBuckets(ctx context.Context, now time.Time) (int, error) {
+	count, err := d.db.DeleteFullRateLimitBuckets(ctx, now)
+	if err != nil {
+		return 0, translatePGError(err)
+	}
+	return int(count), nil
+}
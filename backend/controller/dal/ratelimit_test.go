@@ -0,0 +1,37 @@
+package dal
+
+import (
+	"testing"
+	"time"
+
+	"github.com/alecthomas/assert/v2"
+)
+
+func TestTokenBucket(t *testing.T) {
+	now := time.Now()
+	bucket := TokenBucket{Tokens: 2, Updated: now}
+	_, ok := bucket.Take(2, 2, now)
+	assert.True(t, ok)
+	_, ok = bucket.Take(2, 2, now)
+	assert.True(t, ok)
+	retryAfter, ok := bucket.Take(2, 2, now)
+	assert.False(t, ok)
+	assert.Equal(t, 500*time.Millisecond, retryAfter)
+	assert.Equal(t, now.Add(time.Second), bucket.FullAt(2, 2))
+
+	// Refills at 2 tokens per second.
+	_, ok = bucket.Take(2, 2, now.Add(500*time.Millisecond))
+	assert.True(t, ok)
+
+	// Never refills beyond capacity.
+	bucket = TokenBucket{Tokens: 0, Updated: now}
+	_, ok = bucket.Take(2, 2, now.Add(time.Hour))
+	assert.True(t, ok)
+	assert.Equal(t, 1.0, bucket.Tokens)
+
+	// Doesn't go back in time if another controller's clock is ahead.
+	bucket = TokenBucket{Tokens: 0.5, Updated: now}
+	_, ok = bucket.Take(2, 2, now.Add(-time.Second))
+	assert.False(t, ok)
+	assert.Equal(t, now, bucket.Updated)
+}
@@ -0,0 +1,203 @@
+package controller
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"github.com/amacneil/dbmate/v2/pkg/dbmate"
+	_ "github.com/amacneil/dbmate/v2/pkg/driver/postgres"
+	"github.com/jackc/pgx/v5"
+
+	"github.com/TBD54566975/ftl/backend/schema"
+	"github.com/TBD54566975/ftl/internal/log"
+	"github.com/TBD54566975/ftl/internal/model"
+)
+
+// migrationsDir is the artefact directory containing SQL migrations for each
+// of a deployment's databases, eg. "migrations/<database>/001_init.sql".
+const migrationsDir = "migrations"
+
+// Maximum length of a Postgres identifier, beyond which names are truncated.
+const maxPostgresIdentifierLen = 63
+
+// moduleDatabase is a database declared by a module, which is owned by a login
+// role of the same name that only has access to that database.
+type moduleDatabase struct {
+	// Name of the database and its role.
+	name     string
+	password string
+	// DSN the module connects to the database with, as its role.
+	dsn string
+}
+
+// provisionDatabases creates the Postgres databases declared by a deployment
+// on the controller's database server and applies any migrations shipped with
+// the deployment.
+//
+// Returns the DSN of each database keyed by database name. Each DSN carries
+// the credentials of the database's own role rather than the controller's.
+func (s *Service) provisionDatabases(ctx context.Context, deployment *model.Deployment) (map[string]string, error) {
+	databases := map[string]moduleDatabase{}
+	for _, decl := range deployment.Schema.Decls {
+		db, ok := decl.(*schema.Database)
+		if !ok {
+			continue
+		}
+		mdb, err := newModuleDatabase(s.config.DSN, deployment.Module, db.Name)
+		if err != nil {
+			return nil, err
+		}
+		databases[db.Name] = mdb
+	}
+	dsns := map[string]string{}
+	if len(databases) == 0 {
+		return dsns, nil
+	}
+
+	// Migrations are serialised to avoid replicas of the same deployment
+	// racing to create and migrate the database.
+	s.databasesMu.Lock()
+	defer s.databasesMu.Unlock()
+
+	dir, err := os.MkdirTemp("", "ftl-migrations-")
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", "failed to create migrations directory", err)
+	}
+	defer os.RemoveAll(dir)
+	if err := extractMigrations(deployment, dir); err != nil {
+		return nil, err
+	}
+
+	conn, err := pgx.Connect(ctx, s.config.DSN)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", "failed to connect to database server", err)
+	}
+	defer conn.Close(context.Background())
+
+	logger := log.FromContext(ctx)
+	for name, mdb := range databases {
+		logger.Debugf("Provisioning database %s.%s", deployment.Module, name)
+		if err := createModuleDatabase(ctx, conn, mdb); err != nil {
+			return nil, fmt.Errorf("failed to provision database %s.%s: %w", deployment.Module, name, err)
+		}
+		u, err := url.Parse(mdb.dsn)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", "invalid database DSN", err)
+		}
+		// Migrations are applied as the module's role so that it owns the
+		// objects they create.
+		db := dbmate.New(u)
+		db.Log = logger.Scope("migrate").WriterAt(log.Debug)
+		db.MigrationsDir = []string{filepath.Join(dir, name)}
+		db.AutoDumpSchema = false
+		if err := os.MkdirAll(db.MigrationsDir[0], 0700); err != nil {
+			return nil, fmt.Errorf("%s: %w", "failed to create migrations directory", err)
+		}
+		if err := db.Migrate(); err != nil && !errors.Is(err, dbmate.ErrNoMigrationFiles) {
+			return nil, fmt.Errorf("failed to migrate database %s.%s: %w", deployment.Module, name, err)
+		}
+		dsns[name] = mdb.dsn
+	}
+	return dsns, nil
+}
+
+// createModuleDatabase creates the role and database of a module database if
+// they do not exist, and resets the role's password.
+func createModuleDatabase(ctx context.Context, conn *pgx.Conn, mdb moduleDatabase) error {
+	ident := pgx.Identifier{mdb.name}.Sanitize()
+	// The password is hex encoded, so it is safe to quote.
+	password := "'" + mdb.password + "'"
+	var exists bool
+	err := conn.QueryRow(ctx, "SELECT EXISTS (SELECT 1 FROM pg_roles WHERE rolname = $1)", mdb.name).Scan(&exists)
+	if err != nil {
+		return fmt.Errorf("%s: %w", "failed to look up role", err)
+	}
+	if exists {
+		_, err = conn.Exec(ctx, "ALTER ROLE "+ident+" WITH LOGIN PASSWORD "+password)
+	} else {
+		_, err = conn.Exec(ctx, "CREATE ROLE "+ident+" WITH LOGIN PASSWORD "+password)
+	}
+	if err != nil {
+		return fmt.Errorf("%s: %w", "failed to create role", err)
+	}
+	err = conn.QueryRow(ctx, "SELECT EXISTS (SELECT 1 FROM pg_database WHERE datname = $1)", mdb.name).Scan(&exists)
+	if err != nil {
+		return fmt.Errorf("%s: %w", "failed to look up database", err)
+	}
+	if !exists {
+		if _, err := conn.Exec(ctx, "CREATE DATABASE "+ident+" OWNER "+ident); err != nil {
+			return fmt.Errorf("%s: %w", "failed to create database", err)
+		}
+	}
+	// Only the database's own role may connect to it.
+	if _, err := conn.Exec(ctx, "REVOKE ALL ON DATABASE "+ident+" FROM PUBLIC"); err != nil {
+		return fmt.Errorf("%s: %w", "failed to restrict access to database", err)
+	}
+	return nil
+}
+
+// extractMigrations writes the deployment's migration artefacts to dir.
+func extractMigrations(deployment *model.Deployment, dir string) error {
+	for _, artefact := range deployment.Artefacts {
+		rel := path.Clean(artefact.Path)
+		if !strings.HasPrefix(rel, migrationsDir+"/") || path.Ext(rel) != ".sql" {
+			continue
+		}
+		dest := filepath.Join(dir, filepath.FromSlash(strings.TrimPrefix(rel, migrationsDir+"/")))
+		if err := os.MkdirAll(filepath.Dir(dest), 0700); err != nil {
+			return fmt.Errorf("%s: %w", "failed to create migrations directory", err)
+		}
+		content, err := io.ReadAll(artefact.Content)
+		if err != nil {
+			return fmt.Errorf("failed to read migration %s: %w", artefact.Path, err)
+		}
+		if err := os.WriteFile(dest, content, 0600); err != nil {
+			return fmt.Errorf("failed to write migration %s: %w", artefact.Path, err)
+		}
+	}
+	return nil
+}
+
+// newModuleDatabase returns the database of a module on the same server as
+// controllerDSN.
+//
+// The database and its role are named "<module>-<name>", which is unique as
+// "-" can't appear in either name. The role's password is derived from
+// controllerDSN, so that every controller sharing the server agrees on it
+// without storing it.
+func newModuleDatabase(controllerDSN, module, name string) (moduleDatabase, error) {
+	u, err := url.Parse(controllerDSN)
+	if err != nil {
+		return moduleDatabase{}, fmt.Errorf("%s: %w", "invalid controller DSN", err)
+	}
+	if u.Scheme != "postgres" && u.Scheme != "postgresql" {
+		return moduleDatabase{}, fmt.Errorf("controller DSN must be a postgres:// URL to provision databases, not %q", u.Scheme)
+	}
+	dbName := module + "-" + name
+	if len(dbName) > maxPostgresIdentifierLen {
+		return moduleDatabase{}, fmt.Errorf("database name %q is longer than %d characters", dbName, maxPostgresIdentifierLen)
+	}
+	mac := hmac.New(sha256.New, []byte(controllerDSN))
+	mac.Write([]byte(dbName))
+	password := hex.EncodeToString(mac.Sum(nil))
+
+	u.Path = "/" + dbName
+	u.RawPath = ""
+	u.User = url.UserPassword(dbName, password)
+	// Credentials in the query string take precedence over the URL's.
+	query := u.Query()
+	query.Del("user")
+	query.Del("password")
+	u.RawQuery = query.Encode()
+	return moduleDatabase{name: dbName, password: password, dsn: u.String()}, nil
+}
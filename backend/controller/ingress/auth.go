@@ -0,0 +1,45 @@
+package ingress
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+
+	"github.com/alecthomas/types/optional"
+)
+
+// AuthResponse is the builtin.AuthResponse returned by an ingress authorizer
+// Verb.
+type AuthResponse struct {
+	Allowed   bool    `json:"allowed"`
+	Principal *string `json:"principal,omitempty"`
+}
+
+// Credentials returns the credentials for an authentication scheme, eg.
+// "bearer", from the Authorization header of a request.
+func Credentials(r *http.Request, scheme string) (string, bool) {
+	kind, credentials, ok := strings.Cut(r.Header.Get("Authorization"), " ")
+	credentials = strings.TrimSpace(credentials)
+	if !ok || !strings.EqualFold(kind, scheme) || credentials == "" {
+		return "", false
+	}
+	return credentials, true
+}
+
+// BuildAuthRequestBody builds the builtin.AuthRequest passed to an ingress
+// authorizer Verb.
+func BuildAuthRequestBody(r *http.Request, credentials optional.Option[string]) ([]byte, error) {
+	headers := r.Header
+	if headers == nil {
+		headers = http.Header{}
+	}
+	request := map[string]any{
+		"method":  r.Method,
+		"path":    r.URL.Path,
+		"headers": headers,
+	}
+	if credentials, ok := credentials.Get(); ok {
+		request["credentials"] = credentials
+	}
+	return json.Marshal(request)
+}
@@ -0,0 +1,52 @@
+package ingress
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/alecthomas/assert/v2"
+	"github.com/alecthomas/types/optional"
+)
+
+func TestCredentials(t *testing.T) {
+	tests := []struct {
+		header      string
+		scheme      string
+		credentials string
+		ok          bool
+	}{
+		{"Bearer abc123", "bearer", "abc123", true},
+		{"bearer abc123", "bearer", "abc123", true},
+		{"Basic dXNlcjpwYXNz", "basic", "dXNlcjpwYXNz", true},
+		{"Basic dXNlcjpwYXNz", "bearer", "", false},
+		{"Bearer ", "bearer", "", false},
+		{"", "bearer", "", false},
+	}
+	for _, test := range tests {
+		r, err := http.NewRequest(http.MethodGet, "http://127.0.0.1/", nil) //nolint:noctx
+		assert.NoError(t, err)
+		if test.header != "" {
+			r.Header.Set("Authorization", test.header)
+		}
+		credentials, ok := Credentials(r, test.scheme)
+		assert.Equal(t, test.ok, ok, "header = %q", test.header)
+		assert.Equal(t, test.credentials, credentials, "header = %q", test.header)
+	}
+}
+
+func TestBuildAuthRequestBody(t *testing.T) {
+	r, err := http.NewRequest(http.MethodGet, "http://127.0.0.1/users/me", nil) //nolint:noctx
+	assert.NoError(t, err)
+	r.Header.Set("Authorization", "Bearer abc123")
+	body, err := BuildAuthRequestBody(r, optional.Some("abc123"))
+	assert.NoError(t, err)
+	var actual map[string]any
+	assert.NoError(t, json.Unmarshal(body, &actual))
+	assert.Equal(t, map[string]any{
+		"method":      "GET",
+		"path":        "/users/me",
+		"headers":     map[string]any{"Authorization": []any{"Bearer abc123"}},
+		"credentials": "abc123",
+	}, actual)
+}
@@ -0,0 +1,324 @@
+package ingress
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/alecthomas/types/optional"
+
+	"github.com/TBD54566975/ftl/backend/controller/dal"
+	"github.com/TBD54566975/ftl/backend/schema"
+	"github.com/TBD54566975/ftl/internal/model"
+)
+
+// Maximum number of responses held by a ResponseCache.
+const maxCachedResponses = 10000
+
+// Response is a complete ingress response.
+type Response struct {
+	Status int
+	Header http.Header
+	Body   []byte
+}
+
+// Write the response, or a 304 Not Modified if the request's conditional
+// headers match it.
+func (resp *Response) Write(w http.ResponseWriter, r *http.Request) error {
+	header := w.Header()
+	for k, v := range resp.Header {
+		if k == "Vary" {
+			header[k] = append(header[k], v...)
+		} else {
+			header[k] = v
+		}
+	}
+	if resp.Status == http.StatusOK && NotModified(r, resp.Header) {
+		header.Del("Content-Type")
+		header.Del("Content-Length")
+		header.Del("Content-Encoding")
+		w.WriteHeader(http.StatusNotModified)
+		return nil
+	}
+	w.WriteHeader(resp.Status)
+	_, err := w.Write(resp.Body)
+	return err
+}
+
+// ResponseRecorder is an http.ResponseWriter that records a Response.
+type ResponseRecorder struct {
+	resp        Response
+	body        bytes.Buffer
+	wroteHeader bool
+}
+
+var _ http.ResponseWriter = (*ResponseRecorder)(nil)
+
+func NewResponseRecorder() *ResponseRecorder {
+	return &ResponseRecorder{resp: Response{Header: http.Header{}}}
+}
+
+func (r *ResponseRecorder) Header() http.Header { return r.resp.Header }
+
+func (r *ResponseRecorder) WriteHeader(status int) {
+	if r.wroteHeader {
+		return
+	}
+	r.wroteHeader = true
+	r.resp.Status = status
+}
+
+func (r *ResponseRecorder) Write(data []byte) (int, error) {
+	r.WriteHeader(http.StatusOK)
+	return r.body.Write(data)
+}
+
+// Response returns the recorded response.
+func (r *ResponseRecorder) Response() *Response {
+	r.WriteHeader(http.StatusOK)
+	resp := r.resp
+	resp.Body = r.body.Bytes()
+	return &resp
+}
+
+// CacheKey returns the key of the cached response to a request for an ingress
+// route.
+//
+// Responses are keyed by the deployment serving the route, the request path
+// and its query parameters, the headers the route's cache policy varies on,
+// the headers used for content negotiation, and the principal of the request
+// if it was authenticated.
+func CacheKey(route *dal.IngressRoute, r *http.Request, cache *schema.IngressCache, principal optional.Option[string]) string {
+	key := &strings.Builder{}
+	fmt.Fprintf(key, "%s\x00%s\x00%s\x00%s", route.Deployment, r.Method, r.URL.Path, r.URL.Query().Encode())
+	headers := append([]string{"Accept", "Accept-Encoding"}, cache.Vary...)
+	for i, header := range headers {
+		headers[i] = http.CanonicalHeaderKey(header)
+	}
+	sort.Strings(headers)
+	for _, header := range headers {
+		fmt.Fprintf(key, "\x00%s=%q", header, r.Header.Values(header))
+	}
+	if p, ok := principal.Get(); ok {
+		fmt.Fprintf(key, "\x00principal=%s", p)
+	}
+	return key.String()
+}
+
+// ResponseCache is an in-memory cache of successful responses to cacheable
+// ingress routes.
+type ResponseCache struct {
+	lock    sync.Mutex
+	entries map[string]*cacheEntry
+}
+
+type cacheEntry struct {
+	module       string
+	deployment   model.DeploymentName
+	resp         *Response
+	stored       time.Time
+	expires      time.Time
+	staleUntil   time.Time
+	revalidating bool
+}
+
+func NewResponseCache() *ResponseCache {
+	return &ResponseCache{entries: map[string]*cacheEntry{}}
+}
+
+// Get returns the cached response for a key.
+//
+// If the response is stale but may still be served while it is revalidated,
+// revalidate is true. Only one caller is asked to revalidate a response at a
+// time, and it should Put the refreshed response, or call Revalidated if it
+// could not be refreshed.
+func (c *ResponseCache) Get(key string, now time.Time) (resp *Response, revalidate bool, ok bool) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	entry, ok := c.entries[key]
+	if !ok {
+		return nil, false, false
+	}
+	switch {
+	case now.After(entry.staleUntil):
+		delete(c.entries, key)
+		return nil, false, false
+
+	case now.After(entry.expires) && !entry.revalidating:
+		entry.revalidating = true
+		revalidate = true
+	}
+	resp = &Response{Status: entry.resp.Status, Header: entry.resp.Header.Clone(), Body: entry.resp.Body}
+	resp.Header.Set("Age", strconv.Itoa(int(now.Sub(entry.stored).Seconds())))
+	return resp, revalidate, true
+}
+
+// Revalidated marks a stale response as no longer being revalidated, so that
+// a later request can retry.
+func (c *ResponseCache) Revalidated(key string) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	if entry, ok := c.entries[key]; ok {
+		entry.revalidating = false
+	}
+}
+
+// Put caches the response to a request for an ingress route, returning the
+// response with its caching headers set.
+//
+// Only 200 OK responses are cached, and not if they set cookies or the Verb
+// marked them "no-store" or "private". Cached responses are given an ETag if
+// the Verb did not set one, and a Last-Modified time that changes only when
+// their body does.
+func (c *ResponseCache) Put(key string, route *dal.IngressRoute, cache *schema.IngressCache, private bool, resp *Response, now time.Time) (*Response, error) {
+	if resp.Status != http.StatusOK {
+		return resp, nil
+	}
+	if !storable(resp.Header) {
+		c.lock.Lock()
+		delete(c.entries, key)
+		c.lock.Unlock()
+		return resp, nil
+	}
+	ttl, stale, err := cache.Durations()
+	if err != nil {
+		return nil, err
+	}
+	resp.Header = resp.Header.Clone()
+	if resp.Header.Get("ETag") == "" {
+		sum := sha256.Sum256(resp.Body)
+		resp.Header.Set("ETag", strconv.Quote(hex.EncodeToString(sum[:16])))
+	}
+	if resp.Header.Get("Cache-Control") == "" {
+		cacheControl := fmt.Sprintf("max-age=%d", int(ttl.Seconds()))
+		if stale > 0 {
+			cacheControl += fmt.Sprintf(", stale-while-revalidate=%d", int(stale.Seconds()))
+		}
+		if private {
+			cacheControl = "private, " + cacheControl
+		}
+		resp.Header.Set("Cache-Control", cacheControl)
+	}
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	if resp.Header.Get("Last-Modified") == "" {
+		lastModified := now.UTC().Format(http.TimeFormat)
+		if previous, ok := c.entries[key]; ok && previous.resp.Header.Get("ETag") == resp.Header.Get("ETag") {
+			lastModified = previous.resp.Header.Get("Last-Modified")
+		}
+		resp.Header.Set("Last-Modified", lastModified)
+	}
+	if _, ok := c.entries[key]; !ok && len(c.entries) >= maxCachedResponses {
+		c.evict(now)
+	}
+	c.entries[key] = &cacheEntry{
+		module:     route.Module,
+		deployment: route.Deployment,
+		resp:       resp,
+		stored:     now,
+		expires:    now.Add(ttl),
+		staleUntil: now.Add(ttl + stale),
+	}
+	return resp, nil
+}
+
+// storable returns true if a response with the given headers may be stored in
+// a shared cache.
+func storable(header http.Header) bool {
+	if len(header.Values("Set-Cookie")) > 0 {
+		return false
+	}
+	for _, value := range header.Values("Cache-Control") {
+		for _, directive := range strings.Split(value, ",") {
+			directive, _, _ = strings.Cut(strings.TrimSpace(directive), "=")
+			switch strings.ToLower(directive) {
+			case "no-store", "private":
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// evict removes expired responses, or the oldest response if none have
+// expired.
+func (c *ResponseCache) evict(now time.Time) {
+	var oldest string
+	for key, entry := range c.entries {
+		if now.After(entry.staleUntil) {
+			delete(c.entries, key)
+		} else if oldest == "" || entry.stored.Before(c.entries[oldest].stored) {
+			oldest = key
+		}
+	}
+	if len(c.entries) >= maxCachedResponses {
+		delete(c.entries, oldest)
+	}
+}
+
+// PurgeModule removes all cached responses from deployments of a module.
+func (c *ResponseCache) PurgeModule(module string) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	for key, entry := range c.entries {
+		if entry.module == module {
+			delete(c.entries, key)
+		}
+	}
+}
+
+// Prune removes cached responses from deployments that no longer serve
+// ingress routes, and responses that can no longer be served.
+func (c *ResponseCache) Prune(routes []dal.IngressRoute, now time.Time) {
+	active := map[model.DeploymentName]bool{}
+	for _, route := range routes {
+		active[route.Deployment] = true
+	}
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	for key, entry := range c.entries {
+		if !active[entry.deployment] || now.After(entry.staleUntil) {
+			delete(c.entries, key)
+		}
+	}
+}
+
+// NotModified returns true if the conditional headers of a GET or HEAD
+// request match a response with the given headers.
+//
+// If-None-Match takes precedence over If-Modified-Since, as required by RFC 9110.
+func NotModified(r *http.Request, header http.Header) bool {
+	if r.Method != http.MethodGet && r.Method != http.MethodHead {
+		return false
+	}
+	if inm := r.Header.Get("If-None-Match"); inm != "" {
+		etag := strings.TrimPrefix(header.Get("ETag"), "W/")
+		if etag == "" {
+			return false
+		}
+		for _, candidate := range strings.Split(inm, ",") {
+			candidate = strings.TrimSpace(candidate)
+			if candidate == "*" || strings.TrimPrefix(candidate, "W/") == etag {
+				return true
+			}
+		}
+		return false
+	}
+	ims, err := http.ParseTime(r.Header.Get("If-Modified-Since"))
+	if err != nil {
+		return false
+	}
+	lastModified, err := http.ParseTime(header.Get("Last-Modified"))
+	if err != nil {
+		return false
+	}
+	return !lastModified.After(ims)
+}
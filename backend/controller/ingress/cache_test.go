@@ -0,0 +1,165 @@
+package ingress
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/alecthomas/assert/v2"
+	"github.com/alecthomas/types/optional"
+
+	"github.com/TBD54566975/ftl/backend/controller/dal"
+	"github.com/TBD54566975/ftl/backend/schema"
+)
+
+func TestCacheKey(t *testing.T) {
+	route := &dal.IngressRoute{Deployment: "test-0000000001", Module: "test"}
+	cache := &schema.IngressCache{TTL: "1m", Vary: []string{"accept-language"}}
+	key := func(target string, headers map[string]string, principal optional.Option[string]) string {
+		r := httptest.NewRequest(http.MethodGet, target, nil)
+		for k, v := range headers {
+			r.Header.Set(k, v)
+		}
+		return CacheKey(route, r, cache, principal)
+	}
+	base := key("/users?a=1&b=2", nil, optional.None[string]())
+	assert.Equal(t, base, key("/users?b=2&a=1", map[string]string{"User-Agent": "curl"}, optional.None[string]()))
+	assert.NotEqual(t, base, key("/users?a=1", nil, optional.None[string]()))
+	assert.NotEqual(t, base, key("/users?a=1&b=2", map[string]string{"Accept-Language": "en"}, optional.None[string]()))
+	assert.NotEqual(t, base, key("/users?a=1&b=2", map[string]string{"Accept": "application/x-protobuf"}, optional.None[string]()))
+	assert.NotEqual(t, base, key("/users?a=1&b=2", nil, optional.Some("alice")))
+}
+
+func TestResponseCache(t *testing.T) {
+	route := &dal.IngressRoute{Deployment: "test-0000000001", Module: "test"}
+	cache := &schema.IngressCache{TTL: "1m", StaleWhileRevalidate: "30s"}
+	now := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+	c := NewResponseCache()
+
+	_, _, ok := c.Get("key", now)
+	assert.False(t, ok)
+
+	resp, err := c.Put("key", route, cache, false, &Response{Status: http.StatusOK, Header: http.Header{}, Body: []byte("hello")}, now)
+	assert.NoError(t, err)
+	etag := resp.Header.Get("ETag")
+	assert.NotEqual(t, "", etag)
+	assert.Equal(t, "max-age=60, stale-while-revalidate=30", resp.Header.Get("Cache-Control"))
+	assert.Equal(t, "Wed, 01 May 2024 12:00:00 GMT", resp.Header.Get("Last-Modified"))
+
+	resp, revalidate, ok := c.Get("key", now.Add(time.Second*30))
+	assert.True(t, ok)
+	assert.False(t, revalidate)
+	assert.Equal(t, "hello", string(resp.Body))
+	assert.Equal(t, "30", resp.Header.Get("Age"))
+
+	// Stale responses are served, but only the first caller revalidates.
+	_, revalidate, ok = c.Get("key", now.Add(time.Second*70))
+	assert.True(t, ok)
+	assert.True(t, revalidate)
+	_, revalidate, ok = c.Get("key", now.Add(time.Second*71))
+	assert.True(t, ok)
+	assert.False(t, revalidate)
+
+	// An unchanged body keeps its Last-Modified time.
+	resp, err = c.Put("key", route, cache, false, &Response{Status: http.StatusOK, Header: http.Header{}, Body: []byte("hello")}, now.Add(time.Second*72))
+	assert.NoError(t, err)
+	assert.Equal(t, etag, resp.Header.Get("ETag"))
+	assert.Equal(t, "Wed, 01 May 2024 12:00:00 GMT", resp.Header.Get("Last-Modified"))
+
+	_, _, ok = c.Get("key", now.Add(time.Minute*3))
+	assert.False(t, ok)
+
+	// Only successful responses are cached.
+	resp, err = c.Put("error", route, cache, true, &Response{Status: http.StatusNotFound, Header: http.Header{}}, now)
+	assert.NoError(t, err)
+	assert.Equal(t, "", resp.Header.Get("ETag"))
+	_, _, ok = c.Get("error", now)
+	assert.False(t, ok)
+
+	resp, err = c.Put("private", route, cache, true, &Response{Status: http.StatusOK, Header: http.Header{}}, now)
+	assert.NoError(t, err)
+	assert.Equal(t, "private, max-age=60, stale-while-revalidate=30", resp.Header.Get("Cache-Control"))
+
+	c.Prune([]dal.IngressRoute{*route}, now)
+	_, _, ok = c.Get("private", now)
+	assert.True(t, ok)
+	c.PurgeModule("test")
+	_, _, ok = c.Get("private", now)
+	assert.False(t, ok)
+
+	_, err = c.Put("other", route, cache, false, &Response{Status: http.StatusOK, Header: http.Header{}}, now)
+	assert.NoError(t, err)
+	c.Prune([]dal.IngressRoute{{Deployment: "test-0000000002", Module: "test"}}, now)
+	_, _, ok = c.Get("other", now)
+	assert.False(t, ok)
+}
+
+func TestResponseCacheUncacheable(t *testing.T) {
+	route := &dal.IngressRoute{Deployment: "test-0000000001", Module: "test"}
+	cache := &schema.IngressCache{TTL: "1m"}
+	now := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name   string
+		header http.Header
+	}{
+		{"SetCookie", http.Header{"Set-Cookie": []string{"session=secret"}}},
+		{"NoStore", http.Header{"Cache-Control": []string{"no-store"}}},
+		{"Private", http.Header{"Cache-Control": []string{"max-age=60, Private"}}},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			c := NewResponseCache()
+			_, err := c.Put("key", route, cache, false, &Response{Status: http.StatusOK, Header: http.Header{}, Body: []byte("old")}, now)
+			assert.NoError(t, err)
+
+			resp, err := c.Put("key", route, cache, false, &Response{Status: http.StatusOK, Header: test.header, Body: []byte("new")}, now)
+			assert.NoError(t, err)
+			assert.Equal(t, "", resp.Header.Get("ETag"))
+			_, _, ok := c.Get("key", now)
+			assert.False(t, ok)
+		})
+	}
+}
+
+func TestResponseWriteNotModified(t *testing.T) {
+	resp := &Response{
+		Status: http.StatusOK,
+		Header: http.Header{
+			"Content-Type":  {"application/json"},
+			"Etag":          {`"abc"`},
+			"Last-Modified": {"Wed, 01 May 2024 12:00:00 GMT"},
+		},
+		Body: []byte(`{}`),
+	}
+	tests := []struct {
+		name    string
+		headers map[string]string
+		status  int
+	}{
+		{name: "Unconditional", status: http.StatusOK},
+		{name: "MatchingETag", headers: map[string]string{"If-None-Match": `"xyz", W/"abc"`}, status: http.StatusNotModified},
+		{name: "AnyETag", headers: map[string]string{"If-None-Match": "*"}, status: http.StatusNotModified},
+		{name: "ChangedETag", headers: map[string]string{"If-None-Match": `"xyz"`, "If-Modified-Since": "Wed, 01 May 2024 13:00:00 GMT"}, status: http.StatusOK},
+		{name: "NotModifiedSince", headers: map[string]string{"If-Modified-Since": "Wed, 01 May 2024 12:00:00 GMT"}, status: http.StatusNotModified},
+		{name: "ModifiedSince", headers: map[string]string{"If-Modified-Since": "Wed, 01 May 2024 11:00:00 GMT"}, status: http.StatusOK},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			r := httptest.NewRequest(http.MethodGet, "/", nil)
+			for k, v := range test.headers {
+				r.Header.Set(k, v)
+			}
+			w := httptest.NewRecorder()
+			assert.NoError(t, resp.Write(w, r))
+			assert.Equal(t, test.status, w.Code)
+			assert.Equal(t, `"abc"`, w.Header().Get("ETag"))
+			if test.status == http.StatusNotModified {
+				assert.Equal(t, "", w.Body.String())
+				assert.Equal(t, "", w.Header().Get("Content-Type"))
+			} else {
+				assert.Equal(t, `{}`, w.Body.String())
+			}
+		})
+	}
+}
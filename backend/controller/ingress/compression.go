@@ -0,0 +1,155 @@
+package ingress
+
+import (
+	"bytes"
+	"compress/gzip"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/andybalholm/brotli"
+	"github.com/klauspost/compress/zstd"
+)
+
+// Response bodies smaller than this are not worth compressing.
+const minCompressedBodySize = 1024
+
+// Supported content encodings, in order of preference.
+var contentEncodings = []string{"zstd", "br", "gzip"}
+
+// DecompressRequest replaces the body of a request with a Content-Encoding of
+// gzip, br or zstd with a reader that decompresses it as it is read.
+//
+// Reading more than maxSize decompressed bytes fails with an
+// *http.MaxBytesError.
+func DecompressRequest(r *http.Request, maxSize int64) error {
+	encoding := strings.ToLower(strings.TrimSpace(r.Header.Get("Content-Encoding")))
+	var body io.ReadCloser
+	switch encoding {
+	case "", "identity":
+		return nil
+
+	case "gzip", "x-gzip":
+		reader, err := gzip.NewReader(r.Body)
+		if err != nil {
+			return fmt.Errorf("HTTP request body is not valid gzip: %w", err)
+		}
+		body = readCloser{Reader: reader, closers: []io.Closer{reader, r.Body}}
+
+	case "br":
+		body = readCloser{Reader: brotli.NewReader(r.Body), closers: []io.Closer{r.Body}}
+
+	case "zstd":
+		decoder, err := zstd.NewReader(r.Body, zstd.WithDecoderConcurrency(1))
+		if err != nil {
+			return fmt.Errorf("HTTP request body is not valid zstd: %w", err)
+		}
+		body = readCloser{Reader: decoder, closers: []io.Closer{decoder.IOReadCloser(), r.Body}}
+
+	default:
+		return fmt.Errorf("unsupported Content-Encoding %q", encoding)
+	}
+	r.Body = &maxBytesReadCloser{ReadCloser: body, limit: maxSize, remaining: maxSize}
+	r.Header.Del("Content-Encoding")
+	r.Header.Del("Content-Length")
+	r.ContentLength = -1
+	return nil
+}
+
+// maxBytesReadCloser fails reads once more than a limited number of bytes
+// have been read.
+type maxBytesReadCloser struct {
+	io.ReadCloser
+	limit     int64
+	remaining int64
+}
+
+func (m *maxBytesReadCloser) Read(p []byte) (int, error) {
+	if m.remaining < 0 {
+		return 0, &http.MaxBytesError{Limit: m.limit}
+	}
+	// Read one byte more than remains to detect bodies over the limit.
+	if int64(len(p)) > m.remaining+1 {
+		p = p[:m.remaining+1]
+	}
+	n, err := m.ReadCloser.Read(p)
+	m.remaining -= int64(n)
+	if m.remaining < 0 {
+		return n + int(m.remaining), &http.MaxBytesError{Limit: m.limit}
+	}
+	return n, err
+}
+
+type readCloser struct {
+	io.Reader
+	closers []io.Closer
+}
+
+func (r readCloser) Close() error {
+	var err error
+	for _, closer := range r.closers {
+		if cerr := closer.Close(); err == nil {
+			err = cerr
+		}
+	}
+	return err
+}
+
+// CompressResponseBody compresses a response body with the encoding preferred
+// by the request's Accept-Encoding header, and sets the Content-Encoding and
+// Vary headers of the response.
+//
+// Bodies are returned as-is if they are small, already encoded, or if the
+// client does not accept a supported encoding.
+func CompressResponseBody(header http.Header, acceptEncoding string, body []byte) ([]byte, error) {
+	header.Add("Vary", "Accept-Encoding")
+	if len(body) < minCompressedBodySize || header.Get("Content-Encoding") != "" {
+		return body, nil
+	}
+	encoding := NegotiateEncoding(acceptEncoding)
+	if encoding == "" {
+		return body, nil
+	}
+	w := &bytes.Buffer{}
+	var encoder io.WriteCloser
+	switch encoding {
+	case "zstd":
+		zw, err := zstd.NewWriter(w, zstd.WithEncoderConcurrency(1))
+		if err != nil {
+			return nil, fmt.Errorf("failed to create zstd encoder: %w", err)
+		}
+		encoder = zw
+	case "br":
+		encoder = brotli.NewWriter(w)
+	case "gzip":
+		encoder = gzip.NewWriter(w)
+	}
+	if _, err := encoder.Write(body); err != nil {
+		return nil, fmt.Errorf("failed to %s encode response body: %w", encoding, err)
+	}
+	if err := encoder.Close(); err != nil {
+		return nil, fmt.Errorf("failed to %s encode response body: %w", encoding, err)
+	}
+	header.Set("Content-Encoding", encoding)
+	header.Del("Content-Length")
+	return w.Bytes(), nil
+}
+
+// NegotiateEncoding returns the supported content encoding most preferred by
+// an Accept-Encoding header, or "" if the body should not be encoded.
+func NegotiateEncoding(acceptEncoding string) string {
+	accepted := parseQualityValues(acceptEncoding)
+	best := ""
+	bestQuality := 0.0
+	for _, encoding := range contentEncodings {
+		quality, ok := accepted[encoding]
+		if !ok {
+			quality, ok = accepted["*"]
+		}
+		if ok && quality > bestQuality {
+			best, bestQuality = encoding, quality
+		}
+	}
+	return best
+}
@@ -0,0 +1,89 @@
+package ingress
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/alecthomas/assert/v2"
+)
+
+func TestNegotiateEncoding(t *testing.T) {
+	tests := []struct {
+		acceptEncoding string
+		expected       string
+	}{
+		{"", ""},
+		{"identity", ""},
+		{"gzip", "gzip"},
+		{"gzip, br", "br"},
+		{"gzip, deflate, br, zstd", "zstd"},
+		{"zstd;q=0.5, gzip", "gzip"},
+		{"*", "zstd"},
+		{"*, zstd;q=0", "br"},
+	}
+	for _, test := range tests {
+		t.Run(test.acceptEncoding, func(t *testing.T) {
+			assert.Equal(t, test.expected, NegotiateEncoding(test.acceptEncoding))
+		})
+	}
+}
+
+func TestCompressionRoundTrip(t *testing.T) {
+	body := []byte(strings.Repeat(`{"message":"hello world"}`, 100))
+	for _, encoding := range contentEncodings {
+		t.Run(encoding, func(t *testing.T) {
+			header := http.Header{}
+			compressed, err := CompressResponseBody(header, encoding, body)
+			assert.NoError(t, err)
+			assert.Equal(t, encoding, header.Get("Content-Encoding"))
+			assert.Equal(t, "Accept-Encoding", header.Get("Vary"))
+			assert.True(t, len(compressed) < len(body))
+
+			r := httptest.NewRequest(http.MethodPost, "/", bytes.NewReader(compressed))
+			r.Header.Set("Content-Encoding", encoding)
+			assert.NoError(t, DecompressRequest(r, 1<<20))
+			assert.Equal(t, "", r.Header.Get("Content-Encoding"))
+			decompressed, err := io.ReadAll(r.Body)
+			assert.NoError(t, err)
+			assert.NoError(t, r.Body.Close())
+			assert.Equal(t, body, decompressed)
+		})
+	}
+}
+
+func TestCompressResponseBodySkipped(t *testing.T) {
+	header := http.Header{}
+	body, err := CompressResponseBody(header, "gzip", []byte("small"))
+	assert.NoError(t, err)
+	assert.Equal(t, []byte("small"), body)
+	assert.Equal(t, "", header.Get("Content-Encoding"))
+
+	large := bytes.Repeat([]byte("a"), minCompressedBodySize)
+	header = http.Header{"Content-Encoding": {"br"}}
+	body, err = CompressResponseBody(header, "gzip", large)
+	assert.NoError(t, err)
+	assert.Equal(t, large, body)
+	assert.Equal(t, "br", header.Get("Content-Encoding"))
+}
+
+func TestDecompressRequestUnsupported(t *testing.T) {
+	r := httptest.NewRequest(http.MethodPost, "/", strings.NewReader("data"))
+	r.Header.Set("Content-Encoding", "compress")
+	assert.EqualError(t, DecompressRequest(r, 1<<20), `unsupported Content-Encoding "compress"`)
+}
+
+func TestDecompressRequestTooLarge(t *testing.T) {
+	body := []byte(strings.Repeat("a", 2*minCompressedBodySize))
+	compressed, err := CompressResponseBody(http.Header{}, "gzip", body)
+	assert.NoError(t, err)
+	r := httptest.NewRequest(http.MethodPost, "/", bytes.NewReader(compressed))
+	r.Header.Set("Content-Encoding", "gzip")
+	assert.NoError(t, DecompressRequest(r, int64(len(body)-1)))
+	decompressed, err := io.ReadAll(r.Body)
+	assert.EqualError(t, err, "http: request body too large")
+	assert.Equal(t, len(body)-1, len(decompressed))
+}
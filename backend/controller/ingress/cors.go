@@ -0,0 +1,57 @@
+package ingress
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/TBD54566975/ftl/backend/schema"
+)
+
+// IsPreflight returns true if the request is a CORS preflight request.
+func IsPreflight(r *http.Request) bool {
+	return r.Method == http.MethodOptions && r.Header.Get("Origin") != "" && r.Header.Get("Access-Control-Request-Method") != ""
+}
+
+// SetCORSHeaders sets the CORS headers of the response to a request for an
+// ingress route with the given method and CORS policy.
+//
+// No CORS headers are set if the request's origin is not allowed by the
+// policy, which browsers treat as a rejection.
+func SetCORSHeaders(w http.ResponseWriter, r *http.Request, method string, cors *schema.IngressCORS) {
+	header := w.Header()
+	header.Add("Vary", "Origin")
+	if IsPreflight(r) {
+		header.Add("Vary", "Access-Control-Request-Method")
+		header.Add("Vary", "Access-Control-Request-Headers")
+	}
+	origin := r.Header.Get("Origin")
+	if origin == "" || !cors.AllowsOrigin(origin) {
+		return
+	}
+	if len(cors.Origins) == 1 && cors.Origins[0] == "*" {
+		header.Set("Access-Control-Allow-Origin", "*")
+	} else {
+		header.Set("Access-Control-Allow-Origin", origin)
+	}
+	if cors.Credentials {
+		header.Set("Access-Control-Allow-Credentials", "true")
+	}
+	if !IsPreflight(r) {
+		return
+	}
+	methods := []string{strings.ToUpper(method)}
+	for _, m := range cors.Methods {
+		if !strings.EqualFold(m, method) {
+			methods = append(methods, strings.ToUpper(m))
+		}
+	}
+	header.Set("Access-Control-Allow-Methods", strings.Join(methods, ", "))
+	if len(cors.Headers) > 0 {
+		header.Set("Access-Control-Allow-Headers", strings.Join(cors.Headers, ", "))
+	}
+	if maxAge, err := time.ParseDuration(cors.MaxAge); err == nil {
+		header.Set("Access-Control-Max-Age", strconv.Itoa(int(maxAge.Seconds())))
+	}
+}
@@ -0,0 +1,77 @@
+package ingress
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/alecthomas/assert/v2"
+
+	"github.com/TBD54566975/ftl/backend/schema"
+)
+
+func TestSetCORSHeaders(t *testing.T) {
+	cors := &schema.IngressCORS{
+		Origins:     []string{"https://example.com"},
+		Methods:     []string{"GET", "PUT"},
+		Headers:     []string{"Authorization", "Content-Type"},
+		Credentials: true,
+		MaxAge:      "10m",
+	}
+	tests := []struct {
+		name     string
+		method   string
+		headers  map[string]string
+		cors     *schema.IngressCORS
+		expected http.Header
+	}{
+		{name: "Preflight",
+			method: http.MethodOptions,
+			headers: map[string]string{
+				"Origin":                        "https://example.com",
+				"Access-Control-Request-Method": "POST",
+			},
+			cors: cors,
+			expected: http.Header{
+				"Vary":                             {"Origin", "Access-Control-Request-Method", "Access-Control-Request-Headers"},
+				"Access-Control-Allow-Origin":      {"https://example.com"},
+				"Access-Control-Allow-Credentials": {"true"},
+				"Access-Control-Allow-Methods":     {"POST, GET, PUT"},
+				"Access-Control-Allow-Headers":     {"Authorization, Content-Type"},
+				"Access-Control-Max-Age":           {"600"},
+			}},
+		{name: "Request",
+			method:  http.MethodPost,
+			headers: map[string]string{"Origin": "https://example.com"},
+			cors:    cors,
+			expected: http.Header{
+				"Vary":                             {"Origin"},
+				"Access-Control-Allow-Origin":      {"https://example.com"},
+				"Access-Control-Allow-Credentials": {"true"},
+			}},
+		{name: "DisallowedOrigin",
+			method:   http.MethodPost,
+			headers:  map[string]string{"Origin": "https://evil.com"},
+			cors:     cors,
+			expected: http.Header{"Vary": {"Origin"}}},
+		{name: "AnyOrigin",
+			method:  http.MethodGet,
+			headers: map[string]string{"Origin": "https://example.com"},
+			cors:    &schema.IngressCORS{Origins: []string{"*"}},
+			expected: http.Header{
+				"Vary":                        {"Origin"},
+				"Access-Control-Allow-Origin": {"*"},
+			}},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			r := httptest.NewRequest(test.method, "/users", nil)
+			for k, v := range test.headers {
+				r.Header.Set(k, v)
+			}
+			w := httptest.NewRecorder()
+			SetCORSHeaders(w, r, "POST", test.cors)
+			assert.Equal(t, test.expected, w.Header())
+		})
+	}
+}
@@ -4,15 +4,12 @@ import (
 	"encoding/base64"
 	"encoding/json"
 	"fmt"
-	"math/rand"
 	"reflect"
 	"strconv"
 	"strings"
 	"time"
 
-	"github.com/TBD54566975/ftl/backend/controller/dal"
 	"github.com/TBD54566975/ftl/backend/schema"
-	"github.com/TBD54566975/ftl/internal/slices"
 )
 
 type path []string
@@ -21,34 +18,38 @@ func (p path) String() string {
 	return strings.TrimLeft(strings.Join(p, ""), ".")
 }
 
-func GetIngressRoute(routes []dal.IngressRoute, method string, path string) (*dal.IngressRoute, error) {
-	var matchedRoutes = slices.Filter(routes, func(route dal.IngressRoute) bool {
-		return matchSegments(route.Path, path, func(segment, value string) {})
-	})
+// matchSegments matches a URL path against an ingress route pattern, calling
+// onMatch with the name and value of each path parameter.
+//
+// A trailing "{name...}" segment matches the remainder of the path.
+func matchSegments(pattern, urlPath string, onMatch func(segment, value string)) bool {
+	patternSegments := splitPath(pattern)
+	urlSegments := splitPath(urlPath)
 
-	if len(matchedRoutes) == 0 {
-		return nil, dal.ErrNotFound
+	if last := len(patternSegments) - 1; isCatchAllSegment(patternSegments[last]) {
+		if len(urlSegments) < last {
+			return false
+		}
+		if !matchSegmentList(patternSegments[:last], urlSegments[:last], onMatch) {
+			return false
+		}
+		onMatch(strings.TrimSuffix(strings.Trim(patternSegments[last], "{}"), "..."), strings.Join(urlSegments[last:], "/"))
+		return true
 	}
 
-	// TODO: add load balancing at some point
-	route := matchedRoutes[rand.Intn(len(matchedRoutes))] //nolint:gosec
-	return &route, nil
-}
-
-func matchSegments(pattern, urlPath string, onMatch func(segment, value string)) bool {
-	patternSegments := strings.Split(strings.Trim(pattern, "/"), "/")
-	urlSegments := strings.Split(strings.Trim(urlPath, "/"), "/")
-
 	if len(patternSegments) != len(urlSegments) {
 		return false
 	}
+	return matchSegmentList(patternSegments, urlSegments, onMatch)
+}
 
+func matchSegmentList(patternSegments, urlSegments []string, onMatch func(segment, value string)) bool {
 	for i, segment := range patternSegments {
 		if segment == "" && urlSegments[i] == "" {
 			continue // Skip empty segments
 		}
 
-		if strings.HasPrefix(segment, "{") && strings.HasSuffix(segment, "}") {
+		if isParamSegment(segment) {
 			key := strings.Trim(segment, "{}") // Dynamic segment
 			onMatch(key, urlSegments[i])
 		} else if segment != urlSegments[i] {
@@ -73,6 +74,22 @@ func ValidateCallBody(body []byte, verbRef *schema.VerbRef, sch *schema.Schema)
 	return validateValue(verb.Request, []string{verb.Request.String()}, requestMap, sch)
 }
 
+// ValidateEventBody validates that body is a valid event for the given topic.
+func ValidateEventBody(body []byte, topicRef *schema.TopicRef, sch *schema.Schema) error {
+	topic := sch.ResolveTopicRef(topicRef)
+	if topic == nil {
+		return fmt.Errorf("unknown topic %s", topicRef)
+	}
+
+	var eventMap map[string]any
+	err := json.Unmarshal(body, &eventMap)
+	if err != nil {
+		return fmt.Errorf("event body is not valid JSON: %w", err)
+	}
+
+	return validateValue(topic.Event, []string{topic.Event.String()}, eventMap, sch)
+}
+
 func getBodyField(dataRef *schema.DataRef, sch *schema.Schema) (*schema.Field, error) {
 	data, err := sch.ResolveDataRefMonomorphised(dataRef)
 	if err != nil {
@@ -93,6 +110,28 @@ func getBodyField(dataRef *schema.DataRef, sch *schema.Schema) (*schema.Field, e
 	return bodyField, nil
 }
 
+// enumVariantMatches returns true if value, which must already have been
+// validated against the enum's type, is the value of the variant.
+func enumVariantMatches(variant *schema.EnumVariant, value any) bool {
+	switch variantValue := variant.Value.(type) {
+	case *schema.StringValue:
+		str, ok := value.(string)
+		return ok && str == variantValue.Value
+
+	case *schema.IntValue:
+		switch value := value.(type) {
+		case int64:
+			return value == int64(variantValue.Value)
+		case float64:
+			return value == float64(variantValue.Value)
+		case string:
+			i, err := strconv.ParseInt(value, 10, 64)
+			return err == nil && i == int64(variantValue.Value)
+		}
+	}
+	return false
+}
+
 func validateValue(fieldType schema.Type, path path, value any, sch *schema.Schema) error {
 	var typeMatches bool
 	switch fieldType := fieldType.(type) {
@@ -193,6 +232,21 @@ func validateValue(fieldType schema.Type, path path, value any, sch *schema.Sche
 			typeMatches = true
 		}
 
+	case *schema.EnumRef:
+		enum := sch.ResolveEnumRef(fieldType)
+		if enum == nil {
+			return fmt.Errorf("%s refers to unknown enum %s", path, fieldType)
+		}
+		if err := validateValue(enum.Type, path, value, sch); err != nil {
+			return err
+		}
+		for _, variant := range enum.Variants {
+			if enumVariantMatches(variant, value) {
+				return nil
+			}
+		}
+		return fmt.Errorf("%s is not a valid variant of enum %s: %v", path, fieldType, value)
+
 	case *schema.Bytes:
 		_, typeMatches = value.([]byte)
 		if bodyStr, ok := value.(string); ok {
@@ -28,6 +28,8 @@ func TestMatchAndExtractAllSegments(t *testing.T) {
 		{"/users/{id}", "/users/123", map[string]string{"id": "123"}, true},
 		{"/users/{id}", "/users/123", map[string]string{"id": "123"}, true},
 		{"/users/{id}/posts/{postId}", "/users/123/posts/456", map[string]string{"id": "123", "postId": "456"}, true},
+		{"/files/{path...}", "/files/a/b/c", map[string]string{"path": "a/b/c"}, true},
+		{"/files/{path...}", "/files", map[string]string{"path": ""}, true},
 
 		// invalid patterns
 		{"/", "/users", map[string]string{}, false},
@@ -108,6 +110,23 @@ func TestValidation(t *testing.T) {
 				}
 			}`,
 			request: obj{}},
+		{name: "Enum",
+			schema:  `module test { enum Color(String) { Red("Red") Blue("Blue") } data Test { color Color } }`,
+			request: obj{"color": "Red"}},
+		{name: "IntEnum",
+			schema:  `module test { enum Size(Int) { Small(1) Large(2) } data Test { size Size } }`,
+			request: obj{"size": 2.0}},
+		{name: "OtherModuleEnum",
+			schema:  `module other { enum Color(String) { Red("Red") } } module test { data Test { color other.Color } }`,
+			request: obj{"color": "Red"}},
+		{name: "InvalidEnumVariant",
+			schema:  `module test { enum Color(String) { Red("Red") Blue("Blue") } data Test { color Color } }`,
+			request: obj{"color": "Green"},
+			err:     "color is not a valid variant of enum test.Color: Green"},
+		{name: "InvalidEnumType",
+			schema:  `module test { enum Size(Int) { Small(1) Large(2) } data Test { size Size } }`,
+			request: obj{"size": "large"},
+			err:     "size has wrong type, expected Int found string"},
 		{name: "RequiredFields",
 			schema:  `module test { data Test { int Int } }`,
 			request: obj{},
@@ -234,6 +253,7 @@ func TestResponseBodyForVerb(t *testing.T) {
 		name            string
 		verb            *schema.Verb
 		headers         map[string][]string
+		accept          string
 		body            []byte
 		expectedBody    []byte
 		expectedHeaders http.Header
@@ -252,7 +272,16 @@ func TestResponseBodyForVerb(t *testing.T) {
 			headers:         map[string][]string{},
 			body:            []byte(`{"message": "Default to JSON"}`),
 			expectedBody:    []byte(`{"msg":"Default to JSON"}`),
-			expectedHeaders: http.Header{"Content-Type": []string{"application/json; charset=utf-8"}},
+			expectedHeaders: http.Header{"Content-Type": []string{"application/json; charset=utf-8"}, "Vary": []string{"Accept"}},
+		},
+		{
+			name:            "application/x-protobuf",
+			verb:            jsonVerb,
+			headers:         map[string][]string{},
+			accept:          "application/x-protobuf, application/json;q=0.5",
+			body:            []byte(`{"message": "Hi"}`),
+			expectedBody:    []byte{0x0a, 0x02, 'H', 'i'},
+			expectedHeaders: http.Header{"Content-Type": []string{"application/x-protobuf"}, "Vary": []string{"Accept"}},
 		},
 		{
 			name:         "text/html",
@@ -265,7 +294,7 @@ func TestResponseBodyForVerb(t *testing.T) {
 
 	for _, tc := range tests {
 		t.Run(tc.name, func(t *testing.T) {
-			result, headers, err := ResponseForVerb(sch, tc.verb, HTTPResponse{Body: tc.body, Headers: tc.headers})
+			result, headers, err := ResponseForVerb(sch, tc.verb, HTTPResponse{Body: tc.body, Headers: tc.headers}, tc.accept)
 			assert.NoError(t, err)
 			assert.Equal(t, tc.expectedBody, result)
 			if tc.expectedHeaders != nil {
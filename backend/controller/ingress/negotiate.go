@@ -0,0 +1,76 @@
+package ingress
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// parseQualityValues parses a header of comma separated values with optional
+// quality parameters, such as Accept or Accept-Encoding, into a map of
+// lowercase values to their quality.
+func parseQualityValues(header string) map[string]float64 {
+	out := map[string]float64{}
+	for _, part := range strings.Split(header, ",") {
+		value, params, _ := strings.Cut(part, ";")
+		value = strings.ToLower(strings.TrimSpace(value))
+		if value == "" {
+			continue
+		}
+		quality := 1.0
+		for _, param := range strings.Split(params, ";") {
+			key, q, ok := strings.Cut(strings.TrimSpace(param), "=")
+			if !ok || strings.TrimSpace(key) != "q" {
+				continue
+			}
+			if parsed, err := strconv.ParseFloat(strings.TrimSpace(q), 64); err == nil {
+				quality = parsed
+			}
+		}
+		out[value] = quality
+	}
+	return out
+}
+
+// NegotiateContentType returns the offered media type most preferred by an
+// Accept header, or the first offer if none are acceptable.
+//
+// Ties are broken by the order of the offers.
+func NegotiateContentType(accept string, offers ...string) string {
+	if len(offers) == 0 {
+		return ""
+	}
+	if accept == "" {
+		return offers[0]
+	}
+	accepted := parseQualityValues(accept)
+	type candidate struct {
+		offer   string
+		quality float64
+		index   int
+	}
+	candidates := []candidate{}
+	for i, offer := range offers {
+		mediaType, _, _ := strings.Cut(strings.ToLower(offer), ";")
+		mediaType = strings.TrimSpace(mediaType)
+		major, _, _ := strings.Cut(mediaType, "/")
+		for _, pattern := range []string{mediaType, major + "/*", "*/*"} {
+			if quality, ok := accepted[pattern]; ok {
+				if quality > 0 {
+					candidates = append(candidates, candidate{offer: offer, quality: quality, index: i})
+				}
+				break
+			}
+		}
+	}
+	if len(candidates) == 0 {
+		return offers[0]
+	}
+	sort.SliceStable(candidates, func(i, j int) bool {
+		if candidates[i].quality != candidates[j].quality {
+			return candidates[i].quality > candidates[j].quality
+		}
+		return candidates[i].index < candidates[j].index
+	})
+	return candidates[0].offer
+}
@@ -0,0 +1,28 @@
+package ingress
+
+import (
+	"testing"
+
+	"github.com/alecthomas/assert/v2"
+)
+
+func TestNegotiateContentType(t *testing.T) {
+	offers := []string{"application/json; charset=utf-8", ProtobufContentType}
+	tests := []struct {
+		accept   string
+		expected string
+	}{
+		{"", offers[0]},
+		{"*/*", offers[0]},
+		{"application/x-protobuf", ProtobufContentType},
+		{"application/json, application/x-protobuf", offers[0]},
+		{"application/json;q=0.5, application/x-protobuf", ProtobufContentType},
+		{"application/*;q=0.9, application/x-protobuf;q=0", offers[0]},
+		{"text/html", offers[0]},
+	}
+	for _, test := range tests {
+		t.Run(test.accept, func(t *testing.T) {
+			assert.Equal(t, test.expected, NegotiateContentType(test.accept, offers...))
+		})
+	}
+}
@@ -0,0 +1,286 @@
+package ingress
+
+import (
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+
+	"github.com/swaggest/jsonschema-go"
+
+	"github.com/TBD54566975/ftl/backend/schema"
+)
+
+// OpenAPIVersion is the version of the OpenAPI specification that generated
+// documents conform to.
+const OpenAPIVersion = "3.1.0"
+
+const openAPISchemaPrefix = "#/components/schemas/"
+
+// OpenAPIDocument is the subset of an OpenAPI document used to describe HTTP
+// ingress verbs.
+type OpenAPIDocument struct {
+	OpenAPI    string                     `json:"openapi"`
+	Info       OpenAPIInfo                `json:"info"`
+	Servers    []OpenAPIServer            `json:"servers,omitempty"`
+	Paths      map[string]OpenAPIPathItem `json:"paths"`
+	Components OpenAPIComponents          `json:"components"`
+}
+
+type OpenAPIInfo struct {
+	Title   string `json:"title"`
+	Version string `json:"version"`
+}
+
+type OpenAPIServer struct {
+	URL string `json:"url"`
+}
+
+// OpenAPIPathItem maps lower case HTTP methods to operations.
+type OpenAPIPathItem map[string]*OpenAPIOperation
+
+type OpenAPIOperation struct {
+	OperationID string                     `json:"operationId"`
+	Description string                     `json:"description,omitempty"`
+	Tags        []string                   `json:"tags,omitempty"`
+	Parameters  []OpenAPIParameter         `json:"parameters,omitempty"`
+	RequestBody *OpenAPIRequestBody        `json:"requestBody,omitempty"`
+	Responses   map[string]OpenAPIResponse `json:"responses"`
+}
+
+type OpenAPIParameter struct {
+	Name        string             `json:"name"`
+	In          string             `json:"in"`
+	Description string             `json:"description,omitempty"`
+	Required    bool               `json:"required"`
+	Schema      *jsonschema.Schema `json:"schema"`
+}
+
+type OpenAPIRequestBody struct {
+	Required bool                        `json:"required"`
+	Content  map[string]OpenAPIMediaType `json:"content"`
+}
+
+type OpenAPIResponse struct {
+	Description string                      `json:"description"`
+	Content     map[string]OpenAPIMediaType `json:"content,omitempty"`
+}
+
+type OpenAPIMediaType struct {
+	Schema *jsonschema.Schema `json:"schema"`
+}
+
+type OpenAPIComponents struct {
+	Schemas map[string]jsonschema.SchemaOrBool `json:"schemas,omitempty"`
+}
+
+// OpenAPI generates an OpenAPI document describing the HTTP ingress verbs in
+// the schema.
+//
+// serverURL is the base URL that ingress paths are relative to.
+func OpenAPI(sch *schema.Schema, serverURL string) (*OpenAPIDocument, error) {
+	doc := &OpenAPIDocument{
+		OpenAPI:    OpenAPIVersion,
+		Info:       OpenAPIInfo{Title: "FTL", Version: "1.0.0"},
+		Paths:      map[string]OpenAPIPathItem{},
+		Components: OpenAPIComponents{Schemas: map[string]jsonschema.SchemaOrBool{}},
+	}
+	if serverURL != "" {
+		doc.Servers = []OpenAPIServer{{URL: serverURL}}
+	}
+	modules := append([]*schema.Module{}, sch.Modules...)
+	sort.Slice(modules, func(i, j int) bool { return modules[i].Name < modules[j].Name })
+	for _, module := range modules {
+		for _, decl := range module.Decls {
+			verb, ok := decl.(*schema.Verb)
+			if !ok {
+				continue
+			}
+			ingress, ok := verb.GetMetadataIngress().Get()
+			if !ok {
+				continue
+			}
+			path, op, err := openAPIOperation(sch, module, verb, ingress, doc.Components.Schemas)
+			if err != nil {
+				return nil, fmt.Errorf("%s.%s: %w", module.Name, verb.Name, err)
+			}
+			if doc.Paths[path] == nil {
+				doc.Paths[path] = OpenAPIPathItem{}
+			}
+			doc.Paths[path][strings.ToLower(ingress.Method)] = op
+		}
+	}
+	return doc, nil
+}
+
+func openAPIOperation(sch *schema.Schema, module *schema.Module, verb *schema.Verb, ingress *schema.MetadataIngress, definitions map[string]jsonschema.SchemaOrBool) (string, *OpenAPIOperation, error) {
+	op := &OpenAPIOperation{
+		OperationID: module.Name + "." + verb.Name,
+		Description: strings.Join(verb.Comments, "\n"),
+		Tags:        []string{module.Name},
+		Responses:   map[string]OpenAPIResponse{},
+	}
+	toJSONSchema := func(typ schema.Type) (*jsonschema.Schema, error) {
+		return schema.TypeToJSONSchema(sch, typ, openAPISchemaPrefix, definitions)
+	}
+
+	// Request.
+	requestType := verb.Request
+	if ingress.Type == "http" {
+		field, err := builtinField(sch, verb.Request, "HttpRequest", "body")
+		if err != nil {
+			return "", nil, err
+		}
+		requestType = field.Type
+	}
+	var requestData *schema.Data
+	if dataRef, ok := requestType.(*schema.DataRef); ok {
+		data, err := sch.ResolveDataRefMonomorphised(dataRef)
+		if err != nil {
+			return "", nil, err
+		}
+		requestData = data
+	}
+
+	segments := make([]string, len(ingress.Path))
+	pathParameters := map[string]bool{}
+	for i, component := range ingress.Path {
+		switch component := component.(type) {
+		case *schema.IngressPathLiteral:
+			segments[i] = component.Text
+		case *schema.IngressPathParameter:
+			// OpenAPI has no catch-all parameters, so these are documented as
+			// ordinary path parameters.
+			segments[i] = "{" + component.Name + "}"
+			pathParameters[component.Name] = true
+			parameter := OpenAPIParameter{Name: component.Name, In: "path", Required: true}
+			var field *schema.Field
+			if requestData != nil {
+				field = requestData.FieldByName(component.Name)
+			}
+			if field != nil {
+				js, err := toJSONSchema(field.Type)
+				if err != nil {
+					return "", nil, err
+				}
+				parameter.Description = strings.Join(field.Comments, "\n")
+				parameter.Schema = js
+			} else {
+				st := jsonschema.String
+				parameter.Schema = &jsonschema.Schema{Type: &jsonschema.Type{SimpleTypes: &st}}
+			}
+			op.Parameters = append(op.Parameters, parameter)
+		}
+	}
+	path := "/" + strings.Join(segments, "/")
+
+	switch requestType := requestType.(type) {
+	case *schema.Unit:
+
+	case *schema.DataRef:
+		if ingress.Method == http.MethodPost || ingress.Method == http.MethodPut {
+			js, err := toJSONSchema(requestType)
+			if err != nil {
+				return "", nil, err
+			}
+			op.RequestBody = &OpenAPIRequestBody{
+				Required: true,
+				Content:  map[string]OpenAPIMediaType{"application/json": {Schema: js}},
+			}
+			break
+		}
+		for _, field := range requestData.Fields {
+			if pathParameters[field.Name] {
+				continue
+			}
+			typ, optional := field.Type, false
+			if opt, ok := typ.(*schema.Optional); ok {
+				typ, optional = opt.Type, true
+			}
+			js, err := toJSONSchema(typ)
+			if err != nil {
+				return "", nil, err
+			}
+			op.Parameters = append(op.Parameters, OpenAPIParameter{
+				Name:        field.Name,
+				In:          "query",
+				Description: strings.Join(field.Comments, "\n"),
+				Required:    !optional,
+				Schema:      js,
+			})
+		}
+
+	default:
+		content, err := openAPIContent(requestType, toJSONSchema)
+		if err != nil {
+			return "", nil, err
+		}
+		op.RequestBody = &OpenAPIRequestBody{Required: true, Content: content}
+	}
+
+	// Responses.
+	if ingress.Type != "http" {
+		content, err := openAPIContent(verb.Response, toJSONSchema)
+		if err != nil {
+			return "", nil, err
+		}
+		op.Responses["200"] = OpenAPIResponse{Description: "Success", Content: content}
+		return path, op, nil
+	}
+	for _, response := range []struct {
+		field, status, description string
+	}{
+		{"body", "200", "Success"},
+		{"error", "default", "Error"},
+	} {
+		field, err := builtinField(sch, verb.Response, "HttpResponse", response.field)
+		if err != nil {
+			return "", nil, err
+		}
+		typ := field.Type
+		if optional, ok := typ.(*schema.Optional); ok {
+			typ = optional.Type
+		}
+		content, err := openAPIContent(typ, toJSONSchema)
+		if err != nil {
+			return "", nil, err
+		}
+		op.Responses[response.status] = OpenAPIResponse{Description: response.description, Content: content}
+	}
+	return path, op, nil
+}
+
+// builtinField returns a field of a monomorphised builtin HttpRequest or
+// HttpResponse.
+func builtinField(sch *schema.Schema, typ schema.Type, name, field string) (*schema.Field, error) {
+	dataRef, ok := typ.(*schema.DataRef)
+	if !ok || dataRef.Module != "builtin" || dataRef.Name != name {
+		return nil, fmt.Errorf("HTTP ingress verbs must use builtin.%s, not %s", name, typ)
+	}
+	data, err := sch.ResolveDataRefMonomorphised(dataRef)
+	if err != nil {
+		return nil, err
+	}
+	out := data.FieldByName(field)
+	if out == nil {
+		return nil, fmt.Errorf("builtin.%s has no field %q", name, field)
+	}
+	return out, nil
+}
+
+// openAPIContent returns the media types of a request or response body of the
+// given type, which is empty for Unit.
+func openAPIContent(typ schema.Type, toJSONSchema func(schema.Type) (*jsonschema.Schema, error)) (map[string]OpenAPIMediaType, error) {
+	contentType, _, _ := strings.Cut(getDefaultContentType(typ), ";")
+	if contentType == "" {
+		if _, ok := typ.(*schema.Unit); ok {
+			return nil, nil
+		}
+		contentType = "application/json"
+	}
+	js, err := toJSONSchema(typ)
+	if err != nil {
+		return nil, err
+	}
+	return map[string]OpenAPIMediaType{contentType: {Schema: js}}, nil
+}
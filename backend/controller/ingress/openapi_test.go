@@ -0,0 +1,211 @@
+package ingress
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/alecthomas/assert/v2"
+
+	"github.com/TBD54566975/ftl/backend/schema"
+)
+
+func TestOpenAPI(t *testing.T) {
+	sch, err := schema.ParseString("", `
+		module users {
+			// A user.
+			data User {
+				// Unique name of the user.
+				name String
+				age Int?
+			}
+
+			data GetRequest {
+				// Name of the user.
+				name String
+				// Include deleted users.
+				deleted Bool?
+			}
+
+			data CreateRequest {
+				name String
+			}
+
+			data Error {
+				message String
+			}
+
+			// Get a user.
+			verb get(builtin.HttpRequest<users.GetRequest>) builtin.HttpResponse<users.User, users.Error>
+				ingress http GET /users/{name}
+
+			verb create(builtin.HttpRequest<users.CreateRequest>) builtin.HttpResponse<users.User, String>
+				ingress http POST /users
+
+			verb internal(Unit) Unit
+		}
+	`)
+	assert.NoError(t, err)
+	doc, err := OpenAPI(sch, "/ingress")
+	assert.NoError(t, err)
+	actual, err := json.MarshalIndent(doc, "", "  ")
+	assert.NoError(t, err)
+	assert.Equal(t, expectedOpenAPI, string(actual))
+}
+
+const expectedOpenAPI = `{
+  "openapi": "3.1.0",
+  "info": {
+    "title": "FTL",
+    "version": "1.0.0"
+  },
+  "servers": [
+    {
+      "url": "/ingress"
+    }
+  ],
+  "paths": {
+    "/users": {
+      "post": {
+        "operationId": "users.create",
+        "tags": [
+          "users"
+        ],
+        "requestBody": {
+          "required": true,
+          "content": {
+            "application/json": {
+              "schema": {
+                "$ref": "#/components/schemas/users.CreateRequest"
+              }
+            }
+          }
+        },
+        "responses": {
+          "200": {
+            "description": "Success",
+            "content": {
+              "application/json": {
+                "schema": {
+                  "$ref": "#/components/schemas/users.User"
+                }
+              }
+            }
+          },
+          "default": {
+            "description": "Error",
+            "content": {
+              "text/plain": {
+                "schema": {
+                  "type": "string"
+                }
+              }
+            }
+          }
+        }
+      }
+    },
+    "/users/{name}": {
+      "get": {
+        "operationId": "users.get",
+        "description": "Get a user.",
+        "tags": [
+          "users"
+        ],
+        "parameters": [
+          {
+            "name": "name",
+            "in": "path",
+            "description": "Name of the user.",
+            "required": true,
+            "schema": {
+              "type": "string"
+            }
+          },
+          {
+            "name": "deleted",
+            "in": "query",
+            "description": "Include deleted users.",
+            "required": false,
+            "schema": {
+              "type": "boolean"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success",
+            "content": {
+              "application/json": {
+                "schema": {
+                  "$ref": "#/components/schemas/users.User"
+                }
+              }
+            }
+          },
+          "default": {
+            "description": "Error",
+            "content": {
+              "application/json": {
+                "schema": {
+                  "$ref": "#/components/schemas/users.Error"
+                }
+              }
+            }
+          }
+        }
+      }
+    }
+  },
+  "components": {
+    "schemas": {
+      "users.CreateRequest": {
+        "required": [
+          "name"
+        ],
+        "additionalProperties": false,
+        "properties": {
+          "name": {
+            "type": "string"
+          }
+        },
+        "type": "object"
+      },
+      "users.Error": {
+        "required": [
+          "message"
+        ],
+        "additionalProperties": false,
+        "properties": {
+          "message": {
+            "type": "string"
+          }
+        },
+        "type": "object"
+      },
+      "users.User": {
+        "description": "A user.",
+        "required": [
+          "name"
+        ],
+        "additionalProperties": false,
+        "properties": {
+          "age": {
+            "anyOf": [
+              {
+                "type": "integer"
+              },
+              {
+                "type": "null"
+              }
+            ]
+          },
+          "name": {
+            "description": "Unique name of the user.",
+            "type": "string"
+          }
+        },
+        "type": "object"
+      }
+    }
+  }
+}`
@@ -0,0 +1,30 @@
+package ingress
+
+import (
+	"fmt"
+
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/dynamicpb"
+
+	"github.com/TBD54566975/ftl/backend/schema"
+)
+
+// ProtobufContentType is the media type of protobuf encoded ingress responses.
+const ProtobufContentType = "application/x-protobuf"
+
+// ProtobufBody encodes a JSON body of a data structure as protobuf.
+//
+// The message is the one generated by schema.DataProtobufMessage, as output by
+// "ftl schema protobuf <data>".
+func ProtobufBody(sch *schema.Schema, ref *schema.DataRef, jsonBody []byte) ([]byte, error) {
+	desc, err := schema.DataProtobufMessage(sch, ref)
+	if err != nil {
+		return nil, err
+	}
+	msg := dynamicpb.NewMessage(desc)
+	if err := (protojson.UnmarshalOptions{DiscardUnknown: true}).Unmarshal(jsonBody, msg); err != nil {
+		return nil, fmt.Errorf("failed to convert %s to protobuf: %w", ref, err)
+	}
+	return proto.Marshal(msg)
+}
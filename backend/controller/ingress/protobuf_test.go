@@ -0,0 +1,82 @@
+package ingress
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/alecthomas/assert/v2"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/dynamicpb"
+
+	"github.com/TBD54566975/ftl/backend/schema"
+)
+
+func TestProtobufBody(t *testing.T) {
+	sch, err := schema.ParseString("", `
+		module test {
+			enum Colour(String) {
+				Red("red")
+				Blue("blue")
+			}
+			data Item {
+				name String
+				colour test.Colour
+			}
+			data Response {
+				id Int alias json "ID"
+				score Float?
+				items [test.Item]
+				counts {String: Int}
+				created Time
+				payload Bytes
+				extra Any
+				page test.Page<String>
+			}
+			data Page<T> {
+				cursor T
+			}
+		}
+	`)
+	assert.NoError(t, err)
+	ref := &schema.DataRef{Module: "test", Name: "Response"}
+	body := `{
+		"ID": 42,
+		"score": 1.5,
+		"items": [{"name": "hat", "colour": "red"}],
+		"counts": {"a": 1},
+		"created": "2024-01-02T03:04:05Z",
+		"payload": "aGVsbG8=",
+		"extra": {"nested": [true]},
+		"page": {"cursor": "next"}
+	}`
+	encoded, err := ProtobufBody(sch, ref, []byte(body))
+	assert.NoError(t, err)
+
+	desc, err := schema.DataProtobufMessage(sch, ref)
+	assert.NoError(t, err)
+	msg := dynamicpb.NewMessage(desc)
+	assert.NoError(t, proto.Unmarshal(encoded, msg))
+	decoded, err := protojson.Marshal(msg)
+	assert.NoError(t, err)
+	var actual, expected any
+	assert.NoError(t, json.Unmarshal(decoded, &actual))
+	assert.NoError(t, json.Unmarshal([]byte(body), &expected))
+	// Int64 fields are encoded as strings by protojson.
+	expected.(map[string]any)["ID"] = "42"
+	expected.(map[string]any)["counts"] = map[string]any{"a": "1"}
+	assert.Equal(t, expected, actual)
+}
+
+func TestProtobufBodyUnsupported(t *testing.T) {
+	sch, err := schema.ParseString("", `
+		module test {
+			data Response {
+				nested [[String]]
+			}
+		}
+	`)
+	assert.NoError(t, err)
+	_, err = ProtobufBody(sch, &schema.DataRef{Module: "test", Name: "Response"}, []byte(`{}`))
+	assert.EqualError(t, err, `field "nested" of type [String] cannot be encoded as protobuf`)
+}
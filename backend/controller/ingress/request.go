@@ -5,17 +5,23 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"mime"
 	"net/http"
 	"net/url"
 	"strconv"
 	"strings"
 
+	"github.com/alecthomas/types/optional"
+
 	"github.com/TBD54566975/ftl/backend/controller/dal"
 	"github.com/TBD54566975/ftl/backend/schema"
 )
 
 // BuildRequestBody extracts the HttpRequest body from an HTTP request.
-func BuildRequestBody(route *dal.IngressRoute, r *http.Request, sch *schema.Schema) ([]byte, error) {
+//
+// principal is the identity of the caller returned by the route's authorizer,
+// if any.
+func BuildRequestBody(route *dal.IngressRoute, r *http.Request, sch *schema.Schema, principal optional.Option[string]) ([]byte, error) {
 	verb := sch.ResolveVerbRef(&schema.VerbRef{Name: route.Verb, Module: route.Module})
 	if verb == nil {
 		return nil, fmt.Errorf("unknown verb %q", route.Verb)
@@ -69,6 +75,9 @@ func BuildRequestBody(route *dal.IngressRoute, r *http.Request, sch *schema.Sche
 		requestMap["query"] = queryMap
 		requestMap["headers"] = headerMap
 		requestMap["body"] = httpRequestBody
+		if principal, ok := principal.Get(); ok {
+			requestMap["principal"] = principal
+		}
 	} else {
 		var err error
 		requestMap, err = buildRequestMap(route, r, request, sch)
@@ -105,12 +114,37 @@ func extractHTTPRequestBody(route *dal.IngressRoute, r *http.Request, dataRef *s
 		return buildRequestMap(route, r, dataRef, sch)
 	}
 
-	bodyData, err := readRequestBody(r)
-	if err != nil {
-		return nil, err
-	}
+	defer r.Body.Close()
+	return valueForBody(bodyField.Type, r.Body)
+}
 
-	return valueForData(bodyField.Type, bodyData)
+// valueForBody decodes a request body into a value of typ.
+//
+// JSON bodies are decoded directly from the request rather than being read
+// into a separate buffer first.
+func valueForBody(typ schema.Type, body io.Reader) (any, error) {
+	switch typ.(type) {
+	case *schema.DataRef, *schema.Map:
+		var bodyMap map[string]any
+		if err := json.NewDecoder(body).Decode(&bodyMap); err != nil {
+			return nil, fmt.Errorf("HTTP request body is not valid JSON: %w", err)
+		}
+		return bodyMap, nil
+
+	case *schema.Array:
+		var arrayData []any
+		if err := json.NewDecoder(body).Decode(&arrayData); err != nil {
+			return nil, fmt.Errorf("HTTP request body is not a valid JSON array: %w", err)
+		}
+		return arrayData, nil
+
+	default:
+		bodyData, err := readRequestBody(body)
+		if err != nil {
+			return nil, err
+		}
+		return valueForData(typ, bodyData)
+	}
 }
 
 func valueForData(typ schema.Type, data []byte) (any, error) {
@@ -185,9 +219,13 @@ func valueForData(typ schema.Type, data []byte) (any, error) {
 	}
 }
 
-func readRequestBody(r *http.Request) ([]byte, error) {
-	defer r.Body.Close()
-	bodyData, err := io.ReadAll(r.Body)
+// readRequestBody reads the whole request body into memory.
+//
+// Streaming large bodies to Verbs is not supported, as a call carries its
+// request as a single message. Bodies are instead bounded by the controller's
+// IngressMaxBodySize.
+func readRequestBody(body io.Reader) ([]byte, error) {
+	bodyData, err := io.ReadAll(body)
 	if err != nil {
 		return nil, fmt.Errorf("error reading request body: %w", err)
 	}
@@ -202,10 +240,9 @@ func buildRequestMap(route *dal.IngressRoute, r *http.Request, dataRef *schema.D
 
 	switch r.Method {
 	case http.MethodPost, http.MethodPut:
-		var bodyMap map[string]any
-		err := json.NewDecoder(r.Body).Decode(&bodyMap)
+		bodyMap, err := decodeRequestBody(r, dataRef, sch)
 		if err != nil {
-			return nil, fmt.Errorf("HTTP request body is not valid JSON: %w", err)
+			return nil, err
 		}
 
 		// Merge bodyMap into params
@@ -231,6 +268,195 @@ func buildRequestMap(route *dal.IngressRoute, r *http.Request, dataRef *schema.D
 	return requestMap, nil
 }
 
+// decodeRequestBody decodes a JSON, form-encoded or multipart request body
+// into a map of the fields of dataRef.
+func decodeRequestBody(r *http.Request, dataRef *schema.DataRef, sch *schema.Schema) (map[string]any, error) {
+	mediaType, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
+	if err != nil {
+		mediaType = ""
+	}
+	switch mediaType {
+	case "application/x-www-form-urlencoded":
+		data, err := sch.ResolveDataRefMonomorphised(dataRef)
+		if err != nil {
+			return nil, err
+		}
+		if err := r.ParseForm(); err != nil {
+			return nil, fmt.Errorf("HTTP request body is not a valid form: %w", err)
+		}
+		return parseFormValues(r.PostForm, nil, data)
+
+	case "multipart/form-data":
+		data, err := sch.ResolveDataRefMonomorphised(dataRef)
+		if err != nil {
+			return nil, err
+		}
+		return decodeMultipartBody(r, data)
+
+	default:
+		var bodyMap map[string]any
+		err := json.NewDecoder(r.Body).Decode(&bodyMap)
+		if err != nil {
+			return nil, fmt.Errorf("HTTP request body is not valid JSON: %w", err)
+		}
+		return bodyMap, nil
+	}
+}
+
+// MaxMultipartPartSize is the maximum size of each part of a
+// multipart/form-data request body.
+const MaxMultipartPartSize = 10 << 20
+
+// decodeMultipartBody reads the parts of a multipart/form-data request body
+// one at a time. File parts are mapped onto Bytes fields, so like other
+// bodies they are held in memory rather than streamed.
+//
+// Parts larger than MaxMultipartPartSize are rejected with an
+// *http.MaxBytesError.
+func decodeMultipartBody(r *http.Request, data *schema.Data) (map[string]any, error) {
+	reader, err := r.MultipartReader()
+	if err != nil {
+		return nil, fmt.Errorf("HTTP request body is not valid multipart form data: %w", err)
+	}
+	values := url.Values{}
+	files := map[string][][]byte{}
+	for {
+		part, err := reader.NextPart()
+		if errors.Is(err, io.EOF) {
+			break
+		} else if err != nil {
+			return nil, fmt.Errorf("HTTP request body is not valid multipart form data: %w", err)
+		}
+		content, err := io.ReadAll(io.LimitReader(part, MaxMultipartPartSize+1))
+		part.Close()
+		if err != nil {
+			return nil, fmt.Errorf("error reading multipart form field %q: %w", part.FormName(), err)
+		}
+		if len(content) > MaxMultipartPartSize {
+			return nil, fmt.Errorf("multipart form field %q is too large: %w", part.FormName(), &http.MaxBytesError{Limit: MaxMultipartPartSize})
+		}
+		if part.FileName() != "" {
+			files[part.FormName()] = append(files[part.FormName()], content)
+		} else {
+			values.Add(part.FormName(), string(content))
+		}
+	}
+	return parseFormValues(values, files, data)
+}
+
+// parseFormValues maps form values and uploaded files onto the fields of a
+// data structure.
+//
+// Unlike query parameters, form values are converted to the type of their
+// field, and may contain any characters.
+func parseFormValues(values url.Values, files map[string][][]byte, data *schema.Data) (map[string]any, error) {
+	formMap := make(map[string]any)
+	for key, value := range values {
+		field := formField(data, key)
+		if field == nil {
+			formMap[key] = value
+			continue
+		}
+		if array, ok := field.Type.(*schema.Array); ok {
+			elements := make([]any, len(value))
+			for i, v := range value {
+				element, err := formValue(array.Element, key, v)
+				if err != nil {
+					return nil, err
+				}
+				elements[i] = element
+			}
+			formMap[key] = elements
+			continue
+		}
+		if len(value) > 1 {
+			return nil, fmt.Errorf("multiple values for form field %q are not supported", key)
+		}
+		v, err := formValue(field.Type, key, value[0])
+		if err != nil {
+			return nil, err
+		}
+		formMap[key] = v
+	}
+	for key, contents := range files {
+		field := formField(data, key)
+		if field == nil {
+			return nil, fmt.Errorf("unknown file field %q", key)
+		}
+		typ := field.Type
+		if opt, ok := typ.(*schema.Optional); ok {
+			typ = opt.Type
+		}
+		switch typ := typ.(type) {
+		case *schema.Bytes:
+			if len(contents) > 1 {
+				return nil, fmt.Errorf("multiple files for form field %q are not supported", key)
+			}
+			formMap[key] = contents[0]
+
+		case *schema.Array:
+			if _, ok := typ.Element.(*schema.Bytes); !ok {
+				return nil, fmt.Errorf("file field %q must be of type Bytes or [Bytes], not %s", key, field.Type)
+			}
+			elements := make([]any, len(contents))
+			for i, content := range contents {
+				elements[i] = content
+			}
+			formMap[key] = elements
+
+		default:
+			return nil, fmt.Errorf("file field %q must be of type Bytes or [Bytes], not %s", key, field.Type)
+		}
+	}
+	return formMap, nil
+}
+
+func formField(data *schema.Data, key string) *schema.Field {
+	for _, f := range data.Fields {
+		if (f.JSONAlias != "" && f.JSONAlias == key) || f.Name == key {
+			return f
+		}
+	}
+	return nil
+}
+
+func formValue(typ schema.Type, key, value string) (any, error) {
+	switch typ := typ.(type) {
+	case *schema.Optional:
+		return formValue(typ.Type, key, value)
+
+	case *schema.String, *schema.Time, *schema.Any:
+		return value, nil
+
+	case *schema.Bytes:
+		return []byte(value), nil
+
+	case *schema.Int:
+		intVal, err := strconv.ParseInt(value, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("form field %q is not a valid integer: %w", key, err)
+		}
+		return intVal, nil
+
+	case *schema.Float:
+		floatVal, err := strconv.ParseFloat(value, 64)
+		if err != nil {
+			return nil, fmt.Errorf("form field %q is not a valid float: %w", key, err)
+		}
+		return floatVal, nil
+
+	case *schema.Bool:
+		boolVal, err := strconv.ParseBool(value)
+		if err != nil {
+			return nil, fmt.Errorf("form field %q is not a valid boolean: %w", key, err)
+		}
+		return boolVal, nil
+
+	default:
+		return nil, fmt.Errorf("form field %q of type %s is not supported", key, typ)
+	}
+}
+
 func validateRequestMap(dataRef *schema.DataRef, path path, request map[string]any, sch *schema.Schema) error {
 	data, err := sch.ResolveDataRefMonomorphised(dataRef)
 	if err != nil {
@@ -5,9 +5,11 @@ import (
 	"net/http"
 	"net/url"
 	"reflect"
+	"strings"
 	"testing"
 
 	"github.com/alecthomas/assert/v2"
+	"github.com/alecthomas/types/optional"
 
 	"github.com/TBD54566975/ftl/backend/controller/dal"
 	"github.com/TBD54566975/ftl/backend/schema"
@@ -35,6 +37,13 @@ type PostJSONPayload struct {
 	Foo string
 }
 
+type UploadForm struct {
+	Title string   `json:"title"`
+	Count int      `json:"count"`
+	Tags  []string `json:"tags,omitempty"`
+	File  []byte   `json:"file,omitempty"`
+}
+
 // HTTPRequest mirrors builtin.HttpRequest.
 type HTTPRequest[Body any] struct {
 	Body           Body
@@ -43,6 +52,7 @@ type HTTPRequest[Body any] struct {
 	Path           string
 	PathParameters map[string]string   `json:"pathParameters,omitempty"`
 	Query          map[string][]string `json:"query,omitempty"`
+	Principal      ftl.Option[string]  `json:"principal,omitempty"`
 }
 
 func TestBuildRequestBody(t *testing.T) {
@@ -68,6 +78,13 @@ func TestBuildRequestBody(t *testing.T) {
 				foo String
 			}
 
+			data UploadForm {
+				title String
+				count Int
+				tags [String]
+				file Bytes
+			}
+
 			verb getAlias(HttpRequest<AliasRequest>) HttpResponse<Empty, Empty>
 				ingress http GET /getAlias
 
@@ -79,6 +96,9 @@ func TestBuildRequestBody(t *testing.T) {
 
 			verb postJsonPayload(HttpRequest<JsonPayload>) HttpResponse<Empty, Empty>
 				ingress http POST /postJsonPayload
+
+			verb postForm(HttpRequest<UploadForm>) HttpResponse<Empty, Empty>
+				ingress http POST /postForm
 		}
 	`)
 	assert.NoError(t, err)
@@ -90,8 +110,12 @@ func TestBuildRequestBody(t *testing.T) {
 		routePath string
 		query     url.Values
 		body      obj
-		expected  any
-		err       string
+		// rawBody is sent with contentType instead of body if set.
+		rawBody     []byte
+		contentType string
+		principal   optional.Option[string]
+		expected    any
+		err         string
 	}{
 		{name: "UnknownVerb",
 			verb: "unknown",
@@ -157,6 +181,66 @@ func TestBuildRequestBody(t *testing.T) {
 				},
 			},
 		},
+		{name: "Principal",
+			verb:      "postJsonPayload",
+			method:    "POST",
+			path:      "/postJsonPayload",
+			routePath: "/postJsonPayload",
+			body:      obj{"foo": "bar"},
+			principal: optional.Some("alice"),
+			expected: HTTPRequest[PostJSONPayload]{
+				Method:    "POST",
+				Path:      "/postJsonPayload",
+				Body:      PostJSONPayload{Foo: "bar"},
+				Principal: ftl.Some("alice"),
+			},
+		},
+		{name: "FormPayload",
+			verb:        "postForm",
+			method:      "POST",
+			path:        "/postForm",
+			routePath:   "/postForm",
+			rawBody:     []byte("title=Hello+%7Bworld%7D&count=3&tags=a&tags=b"),
+			contentType: "application/x-www-form-urlencoded",
+			expected: HTTPRequest[UploadForm]{
+				Method:  "POST",
+				Path:    "/postForm",
+				Headers: map[string][]string{"Content-Type": {"application/x-www-form-urlencoded"}},
+				Body:    UploadForm{Title: "Hello {world}", Count: 3, Tags: []string{"a", "b"}},
+			},
+		},
+		{name: "InvalidFormPayload",
+			verb:        "postForm",
+			method:      "POST",
+			path:        "/postForm",
+			routePath:   "/postForm",
+			rawBody:     []byte("title=Hello&count=many"),
+			contentType: "application/x-www-form-urlencoded",
+			err:         `form field "count" is not a valid integer: strconv.ParseInt: parsing "many": invalid syntax`,
+		},
+		{name: "MultipartPayload",
+			verb:        "postForm",
+			method:      "POST",
+			path:        "/postForm",
+			routePath:   "/postForm",
+			rawBody:     []byte("--boundary\r\nContent-Disposition: form-data; name=\"title\"\r\n\r\nHello\r\n--boundary\r\nContent-Disposition: form-data; name=\"count\"\r\n\r\n1\r\n--boundary\r\nContent-Disposition: form-data; name=\"file\"; filename=\"hello.txt\"\r\n\r\nfile contents\r\n--boundary--\r\n"),
+			contentType: "multipart/form-data; boundary=boundary",
+			expected: HTTPRequest[UploadForm]{
+				Method:  "POST",
+				Path:    "/postForm",
+				Headers: map[string][]string{"Content-Type": {"multipart/form-data; boundary=boundary"}},
+				Body:    UploadForm{Title: "Hello", Count: 1, File: []byte("file contents")},
+			},
+		},
+		{name: "MultipartPartTooLarge",
+			verb:        "postForm",
+			method:      "POST",
+			path:        "/postForm",
+			routePath:   "/postForm",
+			rawBody:     []byte("--boundary\r\nContent-Disposition: form-data; name=\"file\"; filename=\"big.bin\"\r\n\r\n" + strings.Repeat("x", MaxMultipartPartSize+1) + "\r\n--boundary--\r\n"),
+			contentType: "multipart/form-data; boundary=boundary",
+			err:         `multipart form field "file" is too large: http: request body too large`,
+		},
 	} {
 		t.Run(test.name, func(t *testing.T) {
 			if test.body == nil {
@@ -164,17 +248,23 @@ func TestBuildRequestBody(t *testing.T) {
 			}
 			body, err := encoding.Marshal(test.body)
 			assert.NoError(t, err)
+			if test.rawBody != nil {
+				body = test.rawBody
+			}
 			requestURL := "http://127.0.0.1" + test.path
 			if test.query != nil {
 				requestURL += "?" + test.query.Encode()
 			}
 			r, err := http.NewRequest(test.method, requestURL, bytes.NewReader(body)) //nolint:noctx
 			assert.NoError(t, err)
+			if test.contentType != "" {
+				r.Header.Set("Content-Type", test.contentType)
+			}
 			requestBody, err := BuildRequestBody(&dal.IngressRoute{
 				Path:   test.routePath,
 				Module: "test",
 				Verb:   test.verb,
-			}, r, sch)
+			}, r, sch, test.principal)
 			if test.err != "" {
 				assert.EqualError(t, err, test.err)
 				return
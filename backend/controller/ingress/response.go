@@ -21,7 +21,10 @@ type HTTPResponse struct {
 }
 
 // ResponseForVerb returns the HTTP response for a given verb.
-func ResponseForVerb(sch *schema.Schema, verb *schema.Verb, response HTTPResponse) ([]byte, http.Header, error) {
+//
+// accept is the Accept header of the request. Data structures are encoded as
+// protobuf rather than JSON if it is preferred.
+func ResponseForVerb(sch *schema.Schema, verb *schema.Verb, response HTTPResponse, accept string) ([]byte, http.Header, error) {
 	responseRef, ok := verb.Response.(*schema.DataRef)
 	if !ok {
 		return nil, nil, nil
@@ -59,15 +62,30 @@ func ResponseForVerb(sch *schema.Schema, verb *schema.Verb, response HTTPRespons
 	for k, v := range response.Headers {
 		headers[http.CanonicalHeaderKey(k)] = v
 	}
+	_, haveContentType := headers["Content-Type"]
 	// If the Content-Type header is not set, set it to the default value for the response or error type.
-	if _, ok := headers["Content-Type"]; !ok {
+	if !haveContentType {
 		if contentType := getDefaultContentType(fieldType); contentType != "" {
 			headers.Set("Content-Type", getDefaultContentType(fieldType))
 		}
 	}
 
 	outBody, err := bodyForType(fieldType, sch, body)
-	return outBody, headers, err
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if dataRef, ok := fieldType.(*schema.DataRef); ok && !haveContentType {
+		headers.Add("Vary", "Accept")
+		if NegotiateContentType(accept, getDefaultContentType(fieldType), ProtobufContentType) == ProtobufContentType {
+			outBody, err = ProtobufBody(sch, dataRef, outBody)
+			if err != nil {
+				return nil, nil, err
+			}
+			headers.Set("Content-Type", ProtobufContentType)
+		}
+	}
+	return outBody, headers, nil
 }
 
 func bodyForType(typ schema.Type, sch *schema.Schema, data []byte) ([]byte, error) {
@@ -0,0 +1,136 @@
+package ingress
+
+import (
+	"strings"
+
+	"github.com/TBD54566975/ftl/backend/controller/dal"
+)
+
+// Router matches HTTP requests to ingress routes.
+//
+// Routes are compiled into a tree of path segments per HTTP method. When
+// matching, literal segments take precedence over "{param}" segments, which in
+// turn take precedence over "{param...}" catch-all segments.
+//
+// A Router is immutable once built and is safe for concurrent use.
+type Router struct {
+	methods map[string]*routeNode
+}
+
+type routeNode struct {
+	literals map[string]*routeNode
+	param    *routeNode
+	catchAll *routeNode
+	// routes terminating at this node, one per runner.
+	routes []dal.IngressRoute
+}
+
+// NewRouter compiles ingress routes into a Router.
+func NewRouter(routes []dal.IngressRoute) *Router {
+	router := &Router{methods: map[string]*routeNode{}}
+	for _, route := range routes {
+		node, ok := router.methods[route.Method]
+		if !ok {
+			node = &routeNode{}
+			router.methods[route.Method] = node
+		}
+		for _, segment := range splitPath(route.Path) {
+			node = node.child(segment)
+		}
+		node.routes = append(node.routes, route)
+	}
+	return router
+}
+
+// Match returns the routes for every runner serving the most specific pattern
+// matching the method and URL path, or dal.ErrNotFound.
+func (r *Router) Match(method, urlPath string) ([]dal.IngressRoute, error) {
+	root, ok := r.methods[method]
+	if !ok {
+		return nil, dal.ErrNotFound
+	}
+	node := root.match(splitPath(urlPath))
+	if node == nil {
+		return nil, dal.ErrNotFound
+	}
+	return node.routes, nil
+}
+
+// Route picks a route for the method and URL path, weighted by deployment.
+func (r *Router) Route(method, urlPath string) (*dal.IngressRoute, error) {
+	routes, err := r.Match(method, urlPath)
+	if err != nil {
+		return nil, err
+	}
+	route, ok := dal.PickRoute(routes)
+	if !ok {
+		return nil, dal.ErrNotFound
+	}
+	return &route, nil
+}
+
+func (n *routeNode) child(segment string) *routeNode {
+	var next **routeNode
+	switch {
+	case isCatchAllSegment(segment):
+		next = &n.catchAll
+	case isParamSegment(segment):
+		next = &n.param
+	default:
+		if n.literals == nil {
+			n.literals = map[string]*routeNode{}
+		}
+		child, ok := n.literals[segment]
+		if !ok {
+			child = &routeNode{}
+			n.literals[segment] = child
+		}
+		return child
+	}
+	if *next == nil {
+		*next = &routeNode{}
+	}
+	return *next
+}
+
+// match returns the node terminating the most specific route matching the
+// remaining URL segments, backtracking to less specific branches when a more
+// specific one fails to match.
+func (n *routeNode) match(segments []string) *routeNode {
+	if len(segments) == 0 {
+		if len(n.routes) > 0 {
+			return n
+		}
+		// Catch-alls also match an empty remainder.
+		if n.catchAll != nil && len(n.catchAll.routes) > 0 {
+			return n.catchAll
+		}
+		return nil
+	}
+	if child, ok := n.literals[segments[0]]; ok {
+		if match := child.match(segments[1:]); match != nil {
+			return match
+		}
+	}
+	if n.param != nil && segments[0] != "" {
+		if match := n.param.match(segments[1:]); match != nil {
+			return match
+		}
+	}
+	if n.catchAll != nil && len(n.catchAll.routes) > 0 {
+		return n.catchAll
+	}
+	return nil
+}
+
+func splitPath(path string) []string {
+	return strings.Split(strings.Trim(path, "/"), "/")
+}
+
+func isParamSegment(segment string) bool {
+	return strings.HasPrefix(segment, "{") && strings.HasSuffix(segment, "}")
+}
+
+func isCatchAllSegment(segment string) bool {
+	return isParamSegment(segment) && strings.HasSuffix(segment, "...}")
+}
@@ -0,0 +1,63 @@
+package ingress
+
+import (
+	"testing"
+
+	"github.com/alecthomas/assert/v2"
+
+	"github.com/TBD54566975/ftl/backend/controller/dal"
+)
+
+func TestRouter(t *testing.T) {
+	router := NewRouter([]dal.IngressRoute{
+		{Method: "GET", Path: "/users/{id}", Verb: "getUser"},
+		{Method: "GET", Path: "/users/me", Verb: "getMe"},
+		{Method: "GET", Path: "/users/{id}/posts", Verb: "getPosts"},
+		{Method: "GET", Path: "/users/me/settings", Verb: "getSettings"},
+		{Method: "GET", Path: "/files/{path...}", Verb: "getFile"},
+		{Method: "GET", Path: "/files/index", Verb: "getIndex"},
+		{Method: "POST", Path: "/users", Verb: "createUser"},
+		{Method: "GET", Path: "/", Verb: "root"},
+	})
+	tests := []struct {
+		method string
+		path   string
+		verb   string
+	}{
+		{"GET", "/users/123", "getUser"},
+		{"GET", "/users/me", "getMe"},
+		{"GET", "/users/me/settings", "getSettings"},
+		// Falls back from the literal "me" to the parameter.
+		{"GET", "/users/me/posts", "getPosts"},
+		{"GET", "/users/123/posts", "getPosts"},
+		{"GET", "/files/a/b/c", "getFile"},
+		{"GET", "/files/index", "getIndex"},
+		{"GET", "/files", "getFile"},
+		{"POST", "/users", "createUser"},
+		{"GET", "/", "root"},
+		{"GET", "/users", ""},
+		{"DELETE", "/users/123", ""},
+		{"GET", "/users/123/comments", ""},
+	}
+	for _, test := range tests {
+		t.Run(test.method+" "+test.path, func(t *testing.T) {
+			route, err := router.Route(test.method, test.path)
+			if test.verb == "" {
+				assert.IsError(t, err, dal.ErrNotFound)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, test.verb, route.Verb)
+		})
+	}
+}
+
+func TestRouterMatchesAllRunners(t *testing.T) {
+	router := NewRouter([]dal.IngressRoute{
+		{Method: "GET", Path: "/echo", Endpoint: "http://a", Weight: 100},
+		{Method: "GET", Path: "/echo", Endpoint: "http://b", Weight: 100},
+	})
+	routes, err := router.Match("GET", "/echo")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"http://a", "http://b"}, []string{routes[0].Endpoint, routes[1].Endpoint})
+}
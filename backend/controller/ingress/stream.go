@@ -0,0 +1,173 @@
+package ingress
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/coder/websocket"
+
+	"github.com/TBD54566975/ftl/backend/schema"
+)
+
+// Stream delivers the messages sent by a streaming Verb to an ingress client.
+type Stream interface {
+	// Send a message to the client, blocking until it has been written to the
+	// connection.
+	Send(msg []byte, binary bool) error
+	// Close the stream, reporting err to the client if it is not nil.
+	Close(err *StreamError) error
+}
+
+// StreamError is reported to the client when a stream ends with an error.
+type StreamError struct {
+	Code    string          `json:"code,omitempty"`
+	Message string          `json:"message"`
+	Details json.RawMessage `json:"details,omitempty"`
+}
+
+// StreamMessage converts a message sent by a streaming Verb into the message
+// delivered to the client.
+//
+// Messages are encoded as JSON, except for Strings which are sent as-is and
+// Bytes which are sent as binary messages.
+func StreamMessage(sch *schema.Schema, verb *schema.Verb, msg []byte) (data []byte, binary bool, err error) {
+	data, err = bodyForType(verb.Response, sch, msg)
+	if err != nil {
+		return nil, false, err
+	}
+	_, binary = verb.Response.(*schema.Bytes)
+	return data, binary, nil
+}
+
+// SSEStream is a Stream of Server-Sent Events.
+//
+// Each message is sent as a "message" event, and errors as a final "error"
+// event with a JSON encoded StreamError as its data.
+type SSEStream struct {
+	w       http.ResponseWriter
+	flusher http.Flusher
+	id      int
+}
+
+var _ Stream = (*SSEStream)(nil)
+
+// NewSSEStream starts a Server-Sent Events response.
+func NewSSEStream(w http.ResponseWriter) (*SSEStream, error) {
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		return nil, errors.New("streaming is not supported by the connection")
+	}
+	header := w.Header()
+	header.Set("Content-Type", "text/event-stream")
+	header.Set("Cache-Control", "no-cache")
+	// Stop proxies such as nginx from buffering events.
+	header.Set("X-Accel-Buffering", "no")
+	w.WriteHeader(http.StatusOK)
+	flusher.Flush()
+	return &SSEStream{w: w, flusher: flusher}, nil
+}
+
+func (s *SSEStream) Send(msg []byte, binary bool) error {
+	if binary {
+		msg = []byte(base64.StdEncoding.EncodeToString(msg))
+	}
+	s.id++
+	return s.writeEvent("", strconv.Itoa(s.id), msg)
+}
+
+func (s *SSEStream) Close(err *StreamError) error {
+	if err == nil {
+		return nil
+	}
+	data, merr := json.Marshal(err)
+	if merr != nil {
+		return merr
+	}
+	return s.writeEvent("error", "", data)
+}
+
+func (s *SSEStream) writeEvent(event, id string, data []byte) error {
+	out := &bytes.Buffer{}
+	if event != "" {
+		fmt.Fprintf(out, "event: %s\n", event)
+	}
+	if id != "" {
+		fmt.Fprintf(out, "id: %s\n", id)
+	}
+	// Multi-line data is sent as one data field per line, which clients
+	// join back together.
+	for _, line := range bytes.Split(data, []byte("\n")) {
+		fmt.Fprintf(out, "data: %s\n", bytes.TrimSuffix(line, []byte("\r")))
+	}
+	out.WriteString("\n")
+	if _, err := s.w.Write(out.Bytes()); err != nil {
+		return fmt.Errorf("failed to write event: %w", err)
+	}
+	s.flusher.Flush()
+	return nil
+}
+
+// WebSocketStream is a Stream of WebSocket messages.
+//
+// Streams are one way, so messages received from the client are discarded.
+type WebSocketStream struct {
+	ctx  context.Context
+	conn *websocket.Conn
+}
+
+var _ Stream = (*WebSocketStream)(nil)
+
+// AcceptWebSocket upgrades a request to a WebSocket connection.
+//
+// Cross-origin connections are accepted from the origins allowed by the
+// route's CORS policy, if any. The returned context is cancelled when the
+// client closes the connection.
+func AcceptWebSocket(ctx context.Context, w http.ResponseWriter, r *http.Request, cors *schema.IngressCORS) (context.Context, *WebSocketStream, error) {
+	opts := &websocket.AcceptOptions{}
+	if cors != nil {
+		for _, origin := range cors.Origins {
+			if origin == "*" {
+				opts.OriginPatterns = append(opts.OriginPatterns, "*")
+			} else if u, err := url.Parse(origin); err == nil {
+				opts.OriginPatterns = append(opts.OriginPatterns, u.Host)
+			}
+		}
+	}
+	conn, err := websocket.Accept(w, r, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+	ctx = conn.CloseRead(ctx)
+	return ctx, &WebSocketStream{ctx: ctx, conn: conn}, nil
+}
+
+func (s *WebSocketStream) Send(msg []byte, binary bool) error {
+	typ := websocket.MessageText
+	if binary {
+		typ = websocket.MessageBinary
+	}
+	return s.conn.Write(s.ctx, typ, msg)
+}
+
+func (s *WebSocketStream) Close(err *StreamError) error {
+	if err == nil {
+		return s.conn.Close(websocket.StatusNormalClosure, "")
+	}
+	reason := err.Message
+	if err.Code != "" {
+		reason = err.Code + ": " + reason
+	}
+	// Close reasons are limited to 123 bytes.
+	if len(reason) > 123 {
+		reason = strings.ToValidUTF8(reason[:120], "") + "..."
+	}
+	return s.conn.Close(websocket.StatusInternalError, reason)
+}
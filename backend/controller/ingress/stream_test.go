@@ -0,0 +1,71 @@
+package ingress
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/alecthomas/assert/v2"
+	"github.com/coder/websocket"
+
+	"github.com/TBD54566975/ftl/backend/schema"
+)
+
+func TestSSEStream(t *testing.T) {
+	w := httptest.NewRecorder()
+	stream, err := NewSSEStream(w)
+	assert.NoError(t, err)
+	assert.NoError(t, stream.Send([]byte(`{"price":1}`), false))
+	assert.NoError(t, stream.Send([]byte("two\nlines"), false))
+	assert.NoError(t, stream.Send([]byte{0xff}, true))
+	assert.NoError(t, stream.Close(&StreamError{Code: "not_found", Message: "gone"}))
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "text/event-stream", w.Header().Get("Content-Type"))
+	assert.Equal(t, "id: 1\ndata: {\"price\":1}\n\n"+
+		"id: 2\ndata: two\ndata: lines\n\n"+
+		"id: 3\ndata: /w==\n\n"+
+		"event: error\ndata: {\"code\":\"not_found\",\"message\":\"gone\"}\n\n", w.Body.String())
+}
+
+func TestWebSocketStream(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, stream, err := AcceptWebSocket(r.Context(), w, r, nil)
+		assert.NoError(t, err)
+		assert.NoError(t, stream.Send([]byte(`{"price":1}`), false))
+		assert.NoError(t, stream.Send([]byte{1, 2}, true))
+		assert.NoError(t, stream.Close(&StreamError{Code: "internal", Message: "boom"}))
+	}))
+	t.Cleanup(server.Close)
+
+	ctx := context.Background()
+	conn, _, err := websocket.Dial(ctx, server.URL, nil) //nolint:bodyclose
+	assert.NoError(t, err)
+	typ, data, err := conn.Read(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, websocket.MessageText, typ)
+	assert.Equal(t, `{"price":1}`, string(data))
+	typ, data, err = conn.Read(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, websocket.MessageBinary, typ)
+	assert.Equal(t, []byte{1, 2}, data)
+	_, _, err = conn.Read(ctx)
+	assert.Equal(t, websocket.StatusInternalError, websocket.CloseStatus(err))
+	var closeErr websocket.CloseError
+	assert.True(t, errors.As(err, &closeErr))
+	assert.Equal(t, "internal: boom", closeErr.Reason)
+}
+
+func TestStreamMessage(t *testing.T) {
+	sch := &schema.Schema{}
+	data, binary, err := StreamMessage(sch, &schema.Verb{Response: &schema.String{}}, []byte(`"hello"`))
+	assert.NoError(t, err)
+	assert.False(t, binary)
+	assert.Equal(t, "hello", string(data))
+
+	data, binary, err = StreamMessage(sch, &schema.Verb{Response: &schema.Bytes{}}, []byte(`"AQI="`))
+	assert.NoError(t, err)
+	assert.True(t, binary)
+	assert.Equal(t, []byte{1, 2}, data)
+}
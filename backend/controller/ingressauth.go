@@ -0,0 +1,82 @@
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"connectrpc.com/connect"
+	"github.com/alecthomas/types/optional"
+
+	"github.com/TBD54566975/ftl/backend/controller/ingress"
+	ftlv1 "github.com/TBD54566975/ftl/backend/protos/xyz/block/ftl/v1"
+	schemapb "github.com/TBD54566975/ftl/backend/protos/xyz/block/ftl/v1/schema"
+	"github.com/TBD54566975/ftl/backend/schema"
+	"github.com/TBD54566975/ftl/internal/model"
+	"github.com/TBD54566975/ftl/internal/rpc/headers"
+)
+
+// authorizeIngress enforces the authentication scheme and authorizer of an
+// ingress route before the request reaches the ingress Verb, returning the
+// principal of the caller if the authorizer identified one.
+//
+// Requests without the required credentials fail with CodeUnauthenticated, and
+// requests the authorizer does not allow fail with CodePermissionDenied.
+// Routes that require credentials but have no authorizer to verify them deny
+// every request.
+func (s *Service) authorizeIngress(ctx context.Context, r *http.Request, metadata *schema.MetadataIngress, requestName model.RequestName, client rateLimitClient) (optional.Option[string], error) {
+	authorizer, hasAuthorizer := metadata.GetAuthorizer().Get()
+	var credentials optional.Option[string]
+	if metadata.Auth != "" {
+		if !hasAuthorizer {
+			return optional.None[string](), connect.NewError(connect.CodePermissionDenied, fmt.Errorf("%s %s requires %s credentials but has no authorizer to verify them", r.Method, r.URL.Path, metadata.Auth))
+		}
+		value, ok := ingress.Credentials(r, metadata.Auth)
+		if !ok {
+			return optional.None[string](), connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("%s credentials are required", metadata.Auth))
+		}
+		credentials = optional.Some(value)
+	}
+	if !hasAuthorizer {
+		return optional.None[string](), nil
+	}
+
+	body, err := ingress.BuildAuthRequestBody(r, credentials)
+	if err != nil {
+		return optional.None[string](), err
+	}
+	creq := connect.NewRequest(&ftlv1.CallRequest{
+		Metadata: &ftlv1.Metadata{},
+		Verb:     authorizer.ToProto().(*schemapb.VerbRef), //nolint:forcetypeassert
+		Body:     body,
+	})
+	headers.SetRequestName(creq.Header(), requestName)
+	resp, err := s.call(ctx, creq, client)
+	if err != nil {
+		return optional.None[string](), fmt.Errorf("authorizer %s failed: %w", authorizer, err)
+	}
+	switch msg := resp.Msg.Response.(type) {
+	case *ftlv1.CallResponse_Body:
+		var response ingress.AuthResponse
+		if err := json.Unmarshal(msg.Body, &response); err != nil {
+			return optional.None[string](), fmt.Errorf("authorizer %s returned an invalid response: %w", authorizer, err)
+		}
+		if !response.Allowed {
+			return optional.None[string](), connect.NewError(connect.CodePermissionDenied, fmt.Errorf("%s %s is forbidden", r.Method, r.URL.Path))
+		}
+		return optional.Ptr(response.Principal), nil
+
+	case *ftlv1.CallResponse_Error_:
+		err := fmt.Errorf("authorizer %s failed: %s", authorizer, msg.Error.Message)
+		// Authorizers can reject requests with typed errors, eg. ftl.Unauthenticated().
+		var code connect.Code
+		if msg.Error.Code != nil && code.UnmarshalText([]byte(*msg.Error.Code)) == nil {
+			return optional.None[string](), connect.NewError(code, err)
+		}
+		return optional.None[string](), err
+
+	default:
+		return optional.None[string](), fmt.Errorf("authorizer %s returned an unexpected response", authorizer)
+	}
+}
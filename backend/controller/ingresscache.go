@@ -0,0 +1,75 @@
+package controller
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/alecthomas/types/optional"
+
+	"github.com/TBD54566975/ftl/backend/controller/dal"
+	"github.com/TBD54566975/ftl/backend/controller/ingress"
+	"github.com/TBD54566975/ftl/backend/schema"
+	"github.com/TBD54566975/ftl/internal/log"
+	"github.com/TBD54566975/ftl/internal/model"
+)
+
+type ingressHandler func(w http.ResponseWriter, r *http.Request, requestName model.RequestName)
+
+// serveCachedIngress serves a request for an ingress route with a cache
+// policy, from the response cache if possible.
+//
+// Stale responses are served while a copy of the request refreshes them in
+// the background.
+func (s *Service) serveCachedIngress(w http.ResponseWriter, r *http.Request, route *dal.IngressRoute, cache *schema.IngressCache, principal optional.Option[string], requestName model.RequestName, serve ingressHandler) {
+	logger := log.FromContext(r.Context())
+	key := ingress.CacheKey(route, r, cache, principal)
+	resp, revalidate, ok := s.ingressCache.Get(key, time.Now())
+	if ok {
+		if revalidate {
+			go s.revalidateIngress(r.Clone(context.WithoutCancel(r.Context())), route, cache, principal, key, serve)
+		}
+		w.Header().Set("X-Cache", "HIT")
+	} else {
+		recorder := ingress.NewResponseRecorder()
+		serve(recorder, r, requestName)
+		var err error
+		resp, err = s.ingressCache.Put(key, route, cache, principal.Ok(), recorder.Response(), time.Now())
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		w.Header().Set("X-Cache", "MISS")
+	}
+	if err := resp.Write(w, r); err != nil {
+		logger.Errorf(err, "Could not write response body")
+	}
+}
+
+// revalidateIngress refreshes a stale cached response in the background.
+func (s *Service) revalidateIngress(r *http.Request, route *dal.IngressRoute, cache *schema.IngressCache, principal optional.Option[string], key string, serve ingressHandler) {
+	ctx := r.Context()
+	logger := log.FromContext(ctx)
+	// Conditional headers apply to the original response, not the refresh.
+	r.Header.Del("If-None-Match")
+	r.Header.Del("If-Modified-Since")
+	requestName, err := s.dal.CreateIngressRequest(ctx, fmt.Sprintf("%s %s", r.Method, r.URL.Path), r.RemoteAddr)
+	if err != nil {
+		s.ingressCache.Revalidated(key)
+		logger.Warnf("Failed to revalidate cached response for %s %s: %s", r.Method, r.URL.Path, err)
+		return
+	}
+	recorder := ingress.NewResponseRecorder()
+	serve(recorder, r, requestName)
+	resp := recorder.Response()
+	if resp.Status != http.StatusOK {
+		s.ingressCache.Revalidated(key)
+		logger.Warnf("Failed to revalidate cached response for %s %s: status %d", r.Method, r.URL.Path, resp.Status)
+		return
+	}
+	if _, err := s.ingressCache.Put(key, route, cache, principal.Ok(), resp, time.Now()); err != nil {
+		s.ingressCache.Revalidated(key)
+		logger.Warnf("Failed to revalidate cached response for %s %s: %s", r.Method, r.URL.Path, err)
+	}
+}
@@ -0,0 +1,69 @@
+package controller
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/TBD54566975/ftl/backend/controller/dal"
+	"github.com/TBD54566975/ftl/backend/controller/ingress"
+	"github.com/TBD54566975/ftl/backend/schema"
+	"github.com/TBD54566975/ftl/internal/log"
+)
+
+// Periodically rebuild the ingress router from the DB, to pick up runner
+// assignments that are not signalled by deployment notifications.
+func (s *Service) syncIngress(ctx context.Context) (time.Duration, error) {
+	if _, _, err := s.rebuildIngressRouter(ctx); err != nil {
+		return 0, err
+	}
+	return time.Second, nil
+}
+
+// watchIngressChanges rebuilds the ingress router whenever deployments change.
+func (s *Service) watchIngressChanges(ctx context.Context) {
+	logger := log.FromContext(ctx)
+	deploymentChanges := make(chan dal.DeploymentNotification, 64)
+	s.dal.DeploymentChanges.Subscribe(deploymentChanges)
+	defer s.dal.DeploymentChanges.Unsubscribe(deploymentChanges)
+	for {
+		select {
+		case <-ctx.Done():
+			return
+
+		case <-deploymentChanges:
+			if _, _, err := s.rebuildIngressRouter(ctx); err != nil {
+				logger.Warnf("Failed to rebuild ingress router: %s", err)
+			}
+		}
+	}
+}
+
+// getIngressRouter returns the current ingress router and the schema it was
+// built against, building them if they have not been yet.
+func (s *Service) getIngressRouter(ctx context.Context) (*ingress.Router, *schema.Schema, error) {
+	s.routesMu.RLock()
+	router, sch := s.ingressRouter, s.ingressSchema
+	s.routesMu.RUnlock()
+	if router != nil {
+		return router, sch, nil
+	}
+	return s.rebuildIngressRouter(ctx)
+}
+
+func (s *Service) rebuildIngressRouter(ctx context.Context) (*ingress.Router, *schema.Schema, error) {
+	routes, err := s.dal.GetIngressRoutes(ctx)
+	if err != nil && !errors.Is(err, dal.ErrNotFound) {
+		return nil, nil, err
+	}
+	sch, err := s.getActiveSchema(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	router := ingress.NewRouter(routes)
+	s.routesMu.Lock()
+	s.ingressRouter, s.ingressSchema = router, sch
+	s.routesMu.Unlock()
+	s.ingressCache.Prune(routes, time.Now())
+	return router, sch, nil
+}
@@ -0,0 +1,103 @@
+package controller
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"connectrpc.com/connect"
+	"github.com/alecthomas/types/optional"
+
+	"github.com/TBD54566975/ftl/backend/controller/dal"
+	"github.com/TBD54566975/ftl/backend/controller/ingress"
+	ftlv1 "github.com/TBD54566975/ftl/backend/protos/xyz/block/ftl/v1"
+	schemapb "github.com/TBD54566975/ftl/backend/protos/xyz/block/ftl/v1/schema"
+	"github.com/TBD54566975/ftl/backend/schema"
+	"github.com/TBD54566975/ftl/internal/log"
+	"github.com/TBD54566975/ftl/internal/model"
+	"github.com/TBD54566975/ftl/internal/rpc/headers"
+)
+
+// serveIngressStream streams the messages sent by the Verb of an sse or
+// websocket ingress route to the client, until either the Verb returns or the
+// client disconnects.
+func (s *Service) serveIngressStream(w http.ResponseWriter, r *http.Request, route *dal.IngressRoute, sch *schema.Schema, metadata *schema.MetadataIngress, principal optional.Option[string], requestName model.RequestName, client rateLimitClient) {
+	logger := log.FromContext(r.Context())
+	verb := sch.ResolveVerbRef(&schema.VerbRef{Name: route.Verb, Module: route.Module})
+	if verb == nil {
+		http.Error(w, fmt.Sprintf("unknown verb %s.%s", route.Module, route.Verb), http.StatusInternalServerError)
+		return
+	}
+	body, err := ingress.BuildRequestBody(route, r, sch, principal)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	creq := connect.NewRequest(&ftlv1.CallRequest{
+		Metadata: &ftlv1.Metadata{},
+		Verb:     &schemapb.VerbRef{Module: route.Module, Name: route.Verb},
+		Body:     body,
+	})
+	headers.SetRequestName(creq.Header(), requestName)
+	// Calls are checked before the stream is opened, so that rejections are
+	// reported with an HTTP status.
+	ctx, call, err := s.prepareCall(r.Context(), creq, client)
+	if err != nil {
+		writeCallError(w, err)
+		return
+	}
+
+	var stream ingress.Stream
+	switch metadata.Type {
+	case "sse":
+		stream, err = ingress.NewSSEStream(w)
+	case "websocket":
+		var cors *schema.IngressCORS
+		if policy, ok := metadata.GetCORS().Get(); ok {
+			cors = policy
+		}
+		ctx, stream, err = ingress.AcceptWebSocket(ctx, w, r, cors)
+	default:
+		err = fmt.Errorf("unsupported ingress type %q", metadata.Type)
+	}
+	if err != nil {
+		// WebSocket handshake failures have already been written.
+		if metadata.Type != "websocket" {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		}
+		logger.Warnf("Could not open %s stream: %s", metadata.Type, err)
+		return
+	}
+
+	// Client disconnects cancel ctx, which in turn cancels the Verb.
+	var streamErr *ingress.StreamError
+	err = s.streamCall(ctx, call, creq, func(resp *ftlv1.CallResponse) error {
+		switch msg := resp.Response.(type) {
+		case *ftlv1.CallResponse_Body:
+			data, binary, err := ingress.StreamMessage(sch, verb, msg.Body)
+			if err != nil {
+				return err
+			}
+			return stream.Send(data, binary)
+
+		case *ftlv1.CallResponse_Error_:
+			streamErr = &ingress.StreamError{Message: msg.Error.Message, Details: msg.Error.Details}
+			if msg.Error.Code != nil {
+				streamErr.Code = *msg.Error.Code
+			}
+		}
+		return nil
+	})
+	if err != nil && streamErr == nil {
+		if ctx.Err() != nil || errors.Is(err, context.Canceled) {
+			logger.Debugf("Client disconnected from %s stream %s %s", metadata.Type, r.Method, r.URL.Path)
+			return
+		}
+		streamErr = &ingress.StreamError{Message: err.Error()}
+	}
+	if err := stream.Close(streamErr); err != nil {
+		logger.Debugf("Could not close %s stream: %s", metadata.Type, err)
+	}
+}
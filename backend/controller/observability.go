@@ -22,6 +22,9 @@ type Call struct {
 	request        *ftlv1.CallRequest
 	response       optional.Option[*ftlv1.CallResponse]
 	callError      optional.Option[error]
+	// rejected is true if the controller rejected the call before routing it
+	// to the deployment.
+	rejected bool
 }
 
 func (s *Service) recordCall(ctx context.Context, call *Call) {
@@ -56,6 +59,7 @@ func (s *Service) recordCall(ctx context.Context, call *Call) {
 		Response:       responseBody,
 		Error:          errorStr,
 		Stack:          stack,
+		Rejected:       call.rejected,
 	})
 	if err != nil {
 		logger.Errorf(err, "failed to record call")
@@ -0,0 +1,156 @@
+package controller
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/TBD54566975/ftl/backend/controller/dal"
+	"github.com/TBD54566975/ftl/backend/schema"
+	"github.com/TBD54566975/ftl/internal/rpc/headers"
+	"github.com/TBD54566975/ftl/internal/sha256"
+)
+
+// rateLimitClient identifies the client of a call for rate limits keyed by IP
+// address or header.
+type rateLimitClient struct {
+	// addr is the client's address, with or without a port.
+	addr   string
+	header http.Header
+}
+
+// newRateLimitClient identifies the client of a call to the controller from
+// peer.
+//
+// Calls made by Verbs arrive from Runners, so the client is the one that
+// originated the request, if known, rather than the peer. The originating
+// client is only trusted if the peer is a Runner in the routing table, as other
+// callers could otherwise choose the client their calls are limited as.
+func (s *Service) newRateLimitClient(peer string, header http.Header) rateLimitClient {
+	addr := peer
+	if clientAddr, ok := headers.GetClientAddr(header); ok && s.isRunnerAddr(peer) {
+		addr = clientAddr
+	}
+	return rateLimitClient{addr: addr, header: header}
+}
+
+// isRunnerAddr returns true if addr is the address of a Runner in the routing
+// table.
+func (s *Service) isRunnerAddr(addr string) bool {
+	host, _, err := net.SplitHostPort(addr)
+	if err != nil {
+		host = addr
+	}
+	s.routesMu.RLock()
+	defer s.routesMu.RUnlock()
+	for _, routes := range s.routes {
+		for _, route := range routes {
+			if endpoint, err := url.Parse(route.Endpoint); err == nil && endpoint.Hostname() == host {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// key returns the rate limit bucket key of the client under a limit.
+func (c rateLimitClient) key(limit *schema.MetadataRateLimit) string {
+	switch limit.Key {
+	case "ip":
+		if host, _, err := net.SplitHostPort(c.addr); err == nil {
+			return host
+		}
+		return c.addr
+	case "header":
+		return c.header.Get(limit.Header)
+	default:
+		return ""
+	}
+}
+
+// rateLimitError is returned when a call is rejected by a rate limit.
+type rateLimitError struct {
+	// target is the Verb or ingress route that is limited.
+	target     string
+	retryAfter time.Duration
+}
+
+func (e *rateLimitError) Error() string {
+	return fmt.Sprintf("rate limit exceeded for %s, retry after %s", e.target, e.retryAfter)
+}
+
+// rateLimitBuckets holds rate limit token buckets.
+type rateLimitBuckets interface {
+	TakeRateLimitToken(ctx context.Context, key string, capacity, refill float64, now time.Time) (retryAfter time.Duration, ok bool, err error)
+}
+
+var _ rateLimitBuckets = (*dal.DAL)(nil)
+
+// rateLimiter enforces the MetadataRateLimit of Verbs and the IngressRateLimit
+// of ingress routes with token buckets.
+//
+// Buckets are held in the database by the DAL, so each limit is shared
+// exactly by all controllers.
+type rateLimiter struct {
+	buckets rateLimitBuckets
+}
+
+func newRateLimiter(buckets rateLimitBuckets) *rateLimiter {
+	return &rateLimiter{buckets: buckets}
+}
+
+// allow takes a token from the client's bucket for the Verb's rate limit, if
+// it has one, returning a *rateLimitError if the bucket is empty.
+func (r *rateLimiter) allow(ctx context.Context, sch *schema.Schema, ref *schema.VerbRef, client rateLimitClient, now time.Time) error {
+	verb := sch.ResolveVerbRef(ref)
+	if verb == nil {
+		return nil
+	}
+	for _, md := range verb.Metadata {
+		limit, ok := md.(*schema.MetadataRateLimit)
+		if !ok {
+			continue
+		}
+		if err := r.take(ctx, ref.String(), limit, client, now); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// allowRoute takes a token from the client's bucket for the rate limit of an
+// ingress route, returning a *rateLimitError if the bucket is empty.
+func (r *rateLimiter) allowRoute(ctx context.Context, route *dal.IngressRoute, limit *schema.IngressRateLimit, client rateLimitClient, now time.Time) error {
+	return r.take(ctx, route.Method+" "+route.Path, limit.MetadataRateLimit(), client, now)
+}
+
+func (r *rateLimiter) take(ctx context.Context, target string, limit *schema.MetadataRateLimit, client rateLimitClient, now time.Time) error {
+	// The schema has been validated, so errors are not possible here.
+	rate, interval, burst, err := limit.Limit()
+	if err != nil {
+		return nil
+	}
+	// Keys are hashed as clients choose their own header values.
+	key := sha256.Sum([]byte(strings.Join([]string{target, strings.TrimSpace(limit.String()), client.key(limit)}, "\x00")))
+	retryAfter, ok, err := r.buckets.TakeRateLimitToken(ctx, key.String(), float64(burst), float64(rate)/interval.Seconds(), now)
+	if err != nil {
+		return fmt.Errorf("%s: %w", "could not take rate limit token", err)
+	}
+	if !ok {
+		return &rateLimitError{target: target, retryAfter: retryAfter}
+	}
+	return nil
+}
+
+// Periodically delete rate limit token buckets that have refilled, as they are
+// equivalent to new buckets.
+func (s *Service) pruneRateLimitBuckets(ctx context.Context) (time.Duration, error) {
+	if _, err := s.dal.DeleteFullRateLimitBuckets(ctx, time.Now()); err != nil {
+		return 0, err
+	}
+	return time.Minute, nil
+}
@@ -0,0 +1,110 @@
+package controller
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/alecthomas/assert/v2"
+
+	"github.com/TBD54566975/ftl/backend/controller/dal"
+	"github.com/TBD54566975/ftl/backend/schema"
+	"github.com/TBD54566975/ftl/internal/rpc/headers"
+)
+
+// memoryBuckets holds rate limit token buckets in memory.
+type memoryBuckets map[string]*dal.TokenBucket
+
+func (m memoryBuckets) TakeRateLimitToken(_ context.Context, key string, capacity, refill float64, now time.Time) (time.Duration, bool, error) {
+	bucket, ok := m[key]
+	if !ok {
+		bucket = &dal.TokenBucket{Tokens: capacity, Updated: now}
+		m[key] = bucket
+	}
+	retryAfter, ok := bucket.Take(capacity, refill, now)
+	return retryAfter, ok, nil
+}
+
+func TestRateLimiter(t *testing.T) {
+	sch, err := schema.ParseString("", `
+		module api {
+			verb search(Unit) Unit
+				ratelimit 2/1s
+			verb login(Unit) Unit
+				ratelimit 1/1m by ip
+			verb lookup(Unit) Unit
+				ratelimit 1/1m by header "X-Api-Key"
+			verb status(Unit) Unit
+		}
+	`)
+	assert.NoError(t, err)
+	ctx := context.Background()
+	now := time.Now()
+	alice := rateLimitClient{addr: "10.0.0.1:1234", header: http.Header{"X-Api-Key": []string{"alice"}}}
+	bob := rateLimitClient{addr: "10.0.0.2:1234", header: http.Header{"X-Api-Key": []string{"bob"}}}
+
+	t.Run("Aggregate", func(t *testing.T) {
+		limiter := newRateLimiter(memoryBuckets{})
+		search := &schema.VerbRef{Module: "api", Name: "search"}
+		assert.NoError(t, limiter.allow(ctx, sch, search, alice, now))
+		assert.NoError(t, limiter.allow(ctx, sch, search, bob, now))
+		var rateLimitErr *rateLimitError
+		assert.True(t, errors.As(limiter.allow(ctx, sch, search, alice, now), &rateLimitErr))
+		assert.Equal(t, 500*time.Millisecond, rateLimitErr.retryAfter)
+		// Refills at 2 tokens per second.
+		assert.NoError(t, limiter.allow(ctx, sch, search, alice, now.Add(500*time.Millisecond)))
+	})
+
+	t.Run("ByIP", func(t *testing.T) {
+		limiter := newRateLimiter(memoryBuckets{})
+		login := &schema.VerbRef{Module: "api", Name: "login"}
+		assert.NoError(t, limiter.allow(ctx, sch, login, alice, now))
+		assert.Error(t, limiter.allow(ctx, sch, login, rateLimitClient{addr: "10.0.0.1:5678"}, now))
+		assert.NoError(t, limiter.allow(ctx, sch, login, bob, now))
+	})
+
+	t.Run("ByHeader", func(t *testing.T) {
+		limiter := newRateLimiter(memoryBuckets{})
+		lookup := &schema.VerbRef{Module: "api", Name: "lookup"}
+		assert.NoError(t, limiter.allow(ctx, sch, lookup, alice, now))
+		assert.Error(t, limiter.allow(ctx, sch, lookup, alice, now))
+		assert.NoError(t, limiter.allow(ctx, sch, lookup, bob, now))
+	})
+
+	t.Run("Unlimited", func(t *testing.T) {
+		limiter := newRateLimiter(memoryBuckets{})
+		for range 100 {
+			assert.NoError(t, limiter.allow(ctx, sch, &schema.VerbRef{Module: "api", Name: "status"}, alice, now))
+		}
+	})
+}
+
+func TestRateLimiterRoute(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now()
+	limiter := newRateLimiter(memoryBuckets{})
+	limit := &schema.IngressRateLimit{Rate: 1, Interval: "1m", Key: "ip"}
+	login := &dal.IngressRoute{Method: "POST", Path: "/login"}
+	alice := rateLimitClient{addr: "10.0.0.1:1234"}
+	assert.NoError(t, limiter.allowRoute(ctx, login, limit, alice, now))
+	var rateLimitErr *rateLimitError
+	assert.True(t, errors.As(limiter.allowRoute(ctx, login, limit, alice, now), &rateLimitErr))
+	assert.Equal(t, "rate limit exceeded for POST /login, retry after 1m0s", rateLimitErr.Error())
+	assert.NoError(t, limiter.allowRoute(ctx, login, limit, rateLimitClient{addr: "10.0.0.2:1234"}, now))
+	// Routes are limited separately.
+	assert.NoError(t, limiter.allowRoute(ctx, &dal.IngressRoute{Method: "POST", Path: "/signup"}, limit, alice, now))
+}
+
+func TestNewRateLimitClient(t *testing.T) {
+	s := &Service{routes: map[string][]dal.Route{
+		"echo": {{Endpoint: "http://10.0.0.5:8893"}},
+	}}
+	header := http.Header{}
+	headers.SetClientAddr(header, "10.0.0.1:1234")
+	// The client address is trusted from Runners, but not from other callers.
+	assert.Equal(t, "10.0.0.1:1234", s.newRateLimitClient("10.0.0.5:51234", header).addr)
+	assert.Equal(t, "10.0.0.9:51234", s.newRateLimitClient("10.0.0.9:51234", header).addr)
+	assert.Equal(t, "10.0.0.5:51234", s.newRateLimitClient("10.0.0.5:51234", http.Header{}).addr)
+}
@@ -0,0 +1,121 @@
+package controller
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"connectrpc.com/connect"
+	"github.com/alecthomas/types/optional"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/TBD54566975/ftl/backend/controller/dal"
+	ftlv1 "github.com/TBD54566975/ftl/backend/protos/xyz/block/ftl/v1"
+	schemapb "github.com/TBD54566975/ftl/backend/protos/xyz/block/ftl/v1/schema"
+	"github.com/TBD54566975/ftl/internal/model"
+	"github.com/TBD54566975/ftl/internal/slices"
+)
+
+func (s *Service) Rollback(ctx context.Context, req *connect.Request[ftlv1.RollbackRequest]) (*connect.Response[ftlv1.RollbackResponse], error) {
+	var name optional.Option[model.DeploymentName]
+	if req.Msg.DeploymentName != nil {
+		parsed, err := model.ParseDeploymentName(req.Msg.GetDeploymentName())
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		}
+		name = optional.Some(parsed)
+	}
+	history, err := s.dal.GetDeploymentHistory(ctx, req.Msg.Module)
+	if errors.Is(err, dal.ErrNotFound) {
+		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("module %q has no deployments", req.Msg.Module))
+	} else if err != nil {
+		return nil, fmt.Errorf("%s: %w", "could not get deployment history", err)
+	}
+	target, replicas, err := rollbackTarget(history, name)
+	if err != nil {
+		return nil, err
+	}
+
+	// Other modules may have changed since the deployment was active.
+	if _, err := s.validateWholeSchema(ctx, target.Schema); err != nil {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, err)
+	}
+	if !req.Msg.Force {
+		existingModules, err := s.dal.GetActiveDeploymentSchemas(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", "could not get existing schemas", err)
+		}
+		if err := checkCompatibility(existingModules, target.Schema); err != nil {
+			return nil, connect.NewError(connect.CodeFailedPrecondition, err)
+		}
+	}
+
+	logger := s.getDeploymentLogger(ctx, target.Name)
+	logger.Infof("Rolling back %s to %s", req.Msg.Module, target.Name)
+	err = s.dal.ReplaceDeployment(ctx, target.Name, replicas)
+	if err != nil {
+		logger.Errorf(err, "Could not roll back to deployment: %s", target.Name)
+		return nil, fmt.Errorf("%s: %w", "could not roll back deployment", err)
+	}
+	return connect.NewResponse(&ftlv1.RollbackResponse{DeploymentName: target.Name.String()}), nil
+}
+
+func (s *Service) GetDeploymentHistory(ctx context.Context, req *connect.Request[ftlv1.GetDeploymentHistoryRequest]) (*connect.Response[ftlv1.GetDeploymentHistoryResponse], error) {
+	history, err := s.dal.GetDeploymentHistory(ctx, req.Msg.Module)
+	if errors.Is(err, dal.ErrNotFound) {
+		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("module %q has no deployments", req.Msg.Module))
+	} else if err != nil {
+		return nil, fmt.Errorf("%s: %w", "could not get deployment history", err)
+	}
+	return connect.NewResponse(&ftlv1.GetDeploymentHistoryResponse{
+		Deployments: slices.Map(history, func(d dal.Deployment) *ftlv1.GetDeploymentHistoryResponse_Deployment {
+			return &ftlv1.GetDeploymentHistoryResponse_Deployment{
+				DeploymentName: d.Name.String(),
+				CreatedAt:      timestamppb.New(d.CreatedAt),
+				MinReplicas:    int32(d.MinReplicas),
+				Canary:         d.Canary,
+				Schema:         d.Schema.ToProto().(*schemapb.Module), //nolint:forcetypeassert
+			}
+		}),
+	}), nil
+}
+
+// rollbackTarget selects the deployment to roll back to from a module's
+// deployment history, most recent first, along with the number of replicas it
+// should be deployed with.
+//
+// If name is not provided, the deployment preceding the module's active
+// deployment is selected. Canaries that were never promoted are skipped.
+func rollbackTarget(history []dal.Deployment, name optional.Option[model.DeploymentName]) (dal.Deployment, int, error) {
+	active := -1
+	for i, d := range history {
+		if d.MinReplicas > 0 && !d.Canary {
+			active = i
+			break
+		}
+	}
+	replicas := 1
+	if active >= 0 {
+		replicas = history[active].MinReplicas
+	}
+
+	if name, ok := name.Get(); ok {
+		for i, d := range history {
+			if d.Name != name {
+				continue
+			}
+			if i == active {
+				return dal.Deployment{}, 0, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("deployment %s is already active", name))
+			}
+			return d, replicas, nil
+		}
+		return dal.Deployment{}, 0, connect.NewError(connect.CodeNotFound, fmt.Errorf("deployment %s not found in module history", name))
+	}
+
+	for _, d := range history[active+1:] {
+		if !d.Canary {
+			return d, replicas, nil
+		}
+	}
+	return dal.Deployment{}, 0, connect.NewError(connect.CodeFailedPrecondition, errors.New("no previous deployment to roll back to"))
+}
@@ -0,0 +1,47 @@
+package controller
+
+import (
+	"testing"
+
+	"connectrpc.com/connect"
+	"github.com/alecthomas/assert/v2"
+	"github.com/alecthomas/types/optional"
+
+	"github.com/TBD54566975/ftl/backend/controller/dal"
+	"github.com/TBD54566975/ftl/internal/model"
+)
+
+func TestRollbackTarget(t *testing.T) {
+	canary := dal.Deployment{Name: model.NewDeploymentName("echo"), Canary: true}
+	active := dal.Deployment{Name: model.NewDeploymentName("echo"), MinReplicas: 2}
+	previous := dal.Deployment{Name: model.NewDeploymentName("echo")}
+	oldest := dal.Deployment{Name: model.NewDeploymentName("echo")}
+	history := []dal.Deployment{canary, active, previous, oldest}
+
+	target, replicas, err := rollbackTarget(history, optional.None[model.DeploymentName]())
+	assert.NoError(t, err)
+	assert.Equal(t, previous.Name, target.Name)
+	assert.Equal(t, 2, replicas)
+
+	target, _, err = rollbackTarget(history, optional.Some(oldest.Name))
+	assert.NoError(t, err)
+	assert.Equal(t, oldest.Name, target.Name)
+
+	_, _, err = rollbackTarget(history, optional.Some(active.Name))
+	assert.Equal(t, connect.CodeFailedPrecondition, connect.CodeOf(err))
+
+	_, _, err = rollbackTarget(history, optional.Some(model.NewDeploymentName("other")))
+	assert.Equal(t, connect.CodeNotFound, connect.CodeOf(err))
+
+	_, _, err = rollbackTarget(history[:3], optional.Some(previous.Name))
+	assert.NoError(t, err)
+	_, _, err = rollbackTarget(history[:2], optional.None[model.DeploymentName]())
+	assert.Equal(t, connect.CodeFailedPrecondition, connect.CodeOf(err))
+
+	// With no active deployment the most recent deployment is restored.
+	previous.MinReplicas = 0
+	target, replicas, err = rollbackTarget([]dal.Deployment{previous, oldest}, optional.None[model.DeploymentName]())
+	assert.NoError(t, err)
+	assert.Equal(t, previous.Name, target.Name)
+	assert.Equal(t, 1, replicas)
+}
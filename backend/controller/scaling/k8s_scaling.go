@@ -2,19 +2,234 @@ package scaling
 
 import (
 	"context"
+	"fmt"
+	"strconv"
 
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/util/retry"
+
+	"github.com/TBD54566975/ftl/internal/log"
 	"github.com/TBD54566975/ftl/internal/model"
 )
 
+// PodDeletionCostAnnotation is used to tell the ReplicaSet controller which
+// runner pods to remove first when scaling down.
+//
+// See https://kubernetes.io/docs/reference/labels-annotations-taints/#pod-deletion-cost
+const PodDeletionCostAnnotation = "controller.kubernetes.io/pod-deletion-cost"
+
+// idleRunnerDeletionCost is applied to pods backing idle runners that are
+// being scaled down. It must be lower than the default cost of 0.
+const idleRunnerDeletionCost = -1000
+
 var _ RunnerScaling = (*K8sScaling)(nil)
 
+// K8sConfig configures Kubernetes runner scaling.
+type K8sConfig struct {
+	Namespace    string            `help:"Kubernetes namespace containing the runner pool." env:"FTL_K8S_NAMESPACE" default:"default"`
+	RunnerLabels map[string]string `help:"Labels selecting the Deployment or ReplicaSet of runners to scale." env:"FTL_K8S_RUNNER_LABELS" default:"app=ftl-runner"`
+}
+
+// K8sScaling scales a pool of runner pods managed by a Kubernetes Deployment
+// or ReplicaSet.
+//
+// Runner pods are expected to use their pod UID as their runner key, which
+// allows idle runners to be mapped back to the pods that should be removed.
 type K8sScaling struct {
+	client    kubernetes.Interface
+	namespace string
+	pool      model.Labels
+}
+
+// NewK8sScaling creates a new K8sScaling for the runner pool in namespace
+// whose Deployment or ReplicaSet matches the given labels.
+func NewK8sScaling(client kubernetes.Interface, namespace string, pool model.Labels) *K8sScaling {
+	return &K8sScaling{client: client, namespace: namespace, pool: pool}
 }
 
-func NewK8sScaling() *K8sScaling {
-	return &K8sScaling{}
+// NewK8sScalingInCluster creates a new K8sScaling using the in-cluster
+// Kubernetes service account credentials.
+func NewK8sScalingInCluster(config K8sConfig) (*K8sScaling, error) {
+	restConfig, err := rest.InClusterConfig()
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", "failed to load in-cluster Kubernetes config", err)
+	}
+	client, err := kubernetes.NewForConfig(restConfig)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", "failed to create Kubernetes client", err)
+	}
+	pool := model.Labels{}
+	for k, v := range config.RunnerLabels {
+		pool[k] = v
+	}
+	return NewK8sScaling(client, config.Namespace, pool), nil
 }
 
 func (k *K8sScaling) SetReplicas(ctx context.Context, replicas int, idleRunners []model.RunnerKey) error {
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		return k.setReplicas(ctx, replicas, idleRunners)
+	})
+}
+
+func (k *K8sScaling) setReplicas(ctx context.Context, replicas int, idleRunners []model.RunnerKey) error {
+	logger := log.FromContext(ctx)
+	pool, err := k.findPool(ctx)
+	if err != nil {
+		return err
+	}
+	current := pool.replicas()
+	if replicas == current {
+		return nil
+	}
+
+	if replicas > current {
+		logger.Debugf("Scaling %s up from %d to %d replicas", pool, current, replicas)
+		return pool.update(ctx, replicas)
+	}
+
+	// Only remove pods that back idle runners, otherwise the ReplicaSet
+	// controller is free to pick a busy runner.
+	pods, err := k.client.CoreV1().Pods(k.namespace).List(ctx, metav1.ListOptions{LabelSelector: pool.selector.String()})
+	if err != nil {
+		return fmt.Errorf("failed to list runner pods for %s: %w", pool, err)
+	}
+	podsByRunner := map[model.RunnerKey]corev1.Pod{}
+	for _, pod := range pods.Items {
+		key, err := model.ParseRunnerKey(string(pod.UID))
+		if err != nil {
+			logger.Warnf("Ignoring runner pod %s with invalid UID: %s", pod.Name, err)
+			continue
+		}
+		podsByRunner[key] = pod
+	}
+	toRemove := current - replicas
+	removed := 0
+	for _, key := range idleRunners {
+		if removed == toRemove {
+			break
+		}
+		pod, ok := podsByRunner[key]
+		if !ok {
+			continue
+		}
+		if err := k.markForDeletion(ctx, pod); err != nil {
+			return err
+		}
+		removed++
+	}
+	if removed == 0 {
+		return nil
+	}
+	logger.Debugf("Scaling %s down from %d to %d replicas", pool, current, current-removed)
+	return pool.update(ctx, current-removed)
+}
+
+func (k *K8sScaling) markForDeletion(ctx context.Context, pod corev1.Pod) error {
+	if pod.Annotations[PodDeletionCostAnnotation] == strconv.Itoa(idleRunnerDeletionCost) {
+		return nil
+	}
+	patch := fmt.Sprintf(`{"metadata":{"annotations":{%q:%q}}}`, PodDeletionCostAnnotation, strconv.Itoa(idleRunnerDeletionCost))
+	_, err := k.client.CoreV1().Pods(k.namespace).Patch(ctx, pod.Name, types.MergePatchType, []byte(patch), metav1.PatchOptions{})
+	if err != nil {
+		return fmt.Errorf("failed to mark runner pod %s for deletion: %w", pod.Name, err)
+	}
+	return nil
+}
+
+// findPool finds the single Deployment, or failing that ReplicaSet, matching
+// the pool labels.
+func (k *K8sScaling) findPool(ctx context.Context) (*runnerPool, error) {
+	poolSelector := labels.SelectorFromSet(k.poolLabels()).String()
+	deployments, err := k.client.AppsV1().Deployments(k.namespace).List(ctx, metav1.ListOptions{LabelSelector: poolSelector})
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", "failed to list runner deployments", err)
+	}
+	switch len(deployments.Items) {
+	case 0:
+	case 1:
+		deployment := deployments.Items[0]
+		return k.newRunnerPool("deployment", deployment.Name, deployment.Spec.Replicas, deployment.Spec.Selector,
+			func(ctx context.Context, replicas int32) error {
+				deployment.Spec.Replicas = &replicas
+				_, err := k.client.AppsV1().Deployments(k.namespace).Update(ctx, &deployment, metav1.UpdateOptions{})
+				return err
+			})
+	default:
+		return nil, fmt.Errorf("expected one runner deployment matching %q but found %d", poolSelector, len(deployments.Items))
+	}
+
+	replicaSets, err := k.client.AppsV1().ReplicaSets(k.namespace).List(ctx, metav1.ListOptions{LabelSelector: poolSelector})
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", "failed to list runner replica sets", err)
+	}
+	// ReplicaSets owned by a Deployment are scaled through the Deployment.
+	unowned := []appsv1.ReplicaSet{}
+	for _, rs := range replicaSets.Items {
+		if metav1.GetControllerOf(&rs) == nil {
+			unowned = append(unowned, rs)
+		}
+	}
+	switch len(unowned) {
+	case 0:
+		return nil, fmt.Errorf("no runner deployment or replica set matching %q in namespace %q", poolSelector, k.namespace)
+	case 1:
+		rs := unowned[0]
+		return k.newRunnerPool("replicaset", rs.Name, rs.Spec.Replicas, rs.Spec.Selector,
+			func(ctx context.Context, replicas int32) error {
+				rs.Spec.Replicas = &replicas
+				_, err := k.client.AppsV1().ReplicaSets(k.namespace).Update(ctx, &rs, metav1.UpdateOptions{})
+				return err
+			})
+	default:
+		return nil, fmt.Errorf("expected one runner replica set matching %q but found %d", poolSelector, len(unowned))
+	}
+}
+
+func (k *K8sScaling) newRunnerPool(kind, name string, replicas *int32, selector *metav1.LabelSelector, update func(ctx context.Context, replicas int32) error) (*runnerPool, error) {
+	podSelector, err := metav1.LabelSelectorAsSelector(selector)
+	if err != nil {
+		return nil, fmt.Errorf("invalid pod selector for %s/%s: %w", kind, name, err)
+	}
+	return &runnerPool{kind: kind, name: name, current: replicas, selector: podSelector, updateFn: update}, nil
+}
+
+// poolLabels converts the pool's model.Labels to Kubernetes labels.
+func (k *K8sScaling) poolLabels() labels.Set {
+	set := labels.Set{}
+	for key, value := range k.pool {
+		set[key] = fmt.Sprintf("%v", value)
+	}
+	return set
+}
+
+// runnerPool is the Deployment or ReplicaSet managing a pool of runners.
+type runnerPool struct {
+	kind     string
+	name     string
+	current  *int32
+	selector labels.Selector
+	updateFn func(ctx context.Context, replicas int32) error
+}
+
+func (r *runnerPool) String() string { return r.kind + "/" + r.name }
+
+func (r *runnerPool) replicas() int {
+	// Kubernetes defaults to a single replica if unset.
+	if r.current == nil {
+		return 1
+	}
+	return int(*r.current)
+}
+
+func (r *runnerPool) update(ctx context.Context, replicas int) error {
+	if err := r.updateFn(ctx, int32(replicas)); err != nil { //nolint:gosec
+		return fmt.Errorf("failed to scale %s to %d replicas: %w", r, replicas, err)
+	}
 	return nil
 }
@@ -0,0 +1,107 @@
+package scaling
+
+import (
+	"context"
+	"testing"
+
+	"github.com/alecthomas/assert/v2"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/TBD54566975/ftl/internal/log"
+	"github.com/TBD54566975/ftl/internal/model"
+)
+
+const (
+	busyPodUID = "00000000-0000-0000-0000-000000000001"
+	idlePodUID = "00000000-0000-0000-0000-000000000002"
+)
+
+func TestK8sScalingDeployment(t *testing.T) {
+	ctx := log.ContextWithNewDefaultLogger(context.Background())
+	client := fake.NewSimpleClientset(
+		runnerDeployment(2),
+		runnerPod("busy", busyPodUID),
+		runnerPod("idle", idlePodUID),
+	)
+	scaling := NewK8sScaling(client, "ftl", model.Labels{"app": "ftl-runner"})
+
+	err := scaling.SetReplicas(ctx, 4, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, 4, deploymentReplicas(ctx, t, client))
+
+	// Scaling down is limited to runners we know are idle.
+	err = scaling.SetReplicas(ctx, 2, []model.RunnerKey{runnerKey(t, idlePodUID)})
+	assert.NoError(t, err)
+	assert.Equal(t, 3, deploymentReplicas(ctx, t, client))
+
+	idle, err := client.CoreV1().Pods("ftl").Get(ctx, "idle", metav1.GetOptions{})
+	assert.NoError(t, err)
+	assert.Equal(t, "-1000", idle.Annotations[PodDeletionCostAnnotation])
+	busy, err := client.CoreV1().Pods("ftl").Get(ctx, "busy", metav1.GetOptions{})
+	assert.NoError(t, err)
+	assert.Equal(t, "", busy.Annotations[PodDeletionCostAnnotation])
+
+	// Unknown runners are ignored.
+	err = scaling.SetReplicas(ctx, 1, []model.RunnerKey{model.NewRunnerKey()})
+	assert.NoError(t, err)
+	assert.Equal(t, 3, deploymentReplicas(ctx, t, client))
+}
+
+func TestK8sScalingReplicaSet(t *testing.T) {
+	ctx := log.ContextWithNewDefaultLogger(context.Background())
+	replicas := int32(1)
+	client := fake.NewSimpleClientset(&appsv1.ReplicaSet{
+		ObjectMeta: metav1.ObjectMeta{Name: "ftl-runner-go", Namespace: "ftl", Labels: map[string]string{"app": "ftl-runner", "language": "go"}},
+		Spec: appsv1.ReplicaSetSpec{
+			Replicas: &replicas,
+			Selector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": "ftl-runner"}},
+		},
+	})
+	scaling := NewK8sScaling(client, "ftl", model.Labels{"language": "go"})
+
+	err := scaling.SetReplicas(ctx, 3, nil)
+	assert.NoError(t, err)
+	rs, err := client.AppsV1().ReplicaSets("ftl").Get(ctx, "ftl-runner-go", metav1.GetOptions{})
+	assert.NoError(t, err)
+	assert.Equal(t, int32(3), *rs.Spec.Replicas)
+
+	err = NewK8sScaling(client, "ftl", model.Labels{"language": "kotlin"}).SetReplicas(ctx, 3, nil)
+	assert.EqualError(t, err, `no runner deployment or replica set matching "language=kotlin" in namespace "ftl"`)
+}
+
+func runnerDeployment(replicas int32) *appsv1.Deployment {
+	return &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{Name: "ftl-runner", Namespace: "ftl", Labels: map[string]string{"app": "ftl-runner"}},
+		Spec: appsv1.DeploymentSpec{
+			Replicas: &replicas,
+			Selector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": "ftl-runner"}},
+		},
+	}
+}
+
+func runnerPod(name string, uid types.UID) *corev1.Pod {
+	return &corev1.Pod{ObjectMeta: metav1.ObjectMeta{
+		Name:      name,
+		Namespace: "ftl",
+		UID:       uid,
+		Labels:    map[string]string{"app": "ftl-runner"},
+	}}
+}
+
+func runnerKey(t *testing.T, uid string) model.RunnerKey {
+	t.Helper()
+	key, err := model.ParseRunnerKey(uid)
+	assert.NoError(t, err)
+	return key
+}
+
+func deploymentReplicas(ctx context.Context, t *testing.T, client *fake.Clientset) int {
+	t.Helper()
+	deployment, err := client.AppsV1().Deployments("ftl").Get(ctx, "ftl-runner", metav1.GetOptions{})
+	assert.NoError(t, err)
+	return int(*deployment.Spec.Replicas)
+}
@@ -28,9 +28,10 @@ type LocalScaling struct {
 
 	portAllocator       *bind.BindAllocator
 	controllerAddresses []*url.URL
+	runnerCapacity      int
 }
 
-func NewLocalScaling(portAllocator *bind.BindAllocator, controllerAddresses []*url.URL) (*LocalScaling, error) {
+func NewLocalScaling(portAllocator *bind.BindAllocator, controllerAddresses []*url.URL, runnerCapacity int) (*LocalScaling, error) {
 	cacheDir, err := os.UserCacheDir()
 	if err != nil {
 		return nil, err
@@ -41,6 +42,7 @@ func NewLocalScaling(portAllocator *bind.BindAllocator, controllerAddresses []*u
 		runners:             map[model.RunnerKey]context.CancelFunc{},
 		portAllocator:       portAllocator,
 		controllerAddresses: controllerAddresses,
+		runnerCapacity:      runnerCapacity,
 	}, nil
 }
 
@@ -80,12 +82,14 @@ func (l *LocalScaling) SetReplicas(ctx context.Context, replicas int, idleRunner
 			Bind:               l.portAllocator.Next(),
 			ControllerEndpoint: controllerEndpoint,
 			TemplateDir:        templateDir(ctx),
+			Capacity:           l.runnerCapacity,
 		}
 
 		name := fmt.Sprintf("runner%d", i)
 		if err := kong.ApplyDefaults(&config, kong.Vars{
-			"deploymentdir": filepath.Join(l.cacheDir, "ftl-runner", name, "deployments"),
-			"language":      "go,kotlin",
+			"deploymentdir":    filepath.Join(l.cacheDir, "ftl-runner", name, "deployments"),
+			"artefactcachedir": filepath.Join(l.cacheDir, "ftl-runner", "artefacts"),
+			"language":         "go,kotlin",
 		}); err != nil {
 			return err
 		}
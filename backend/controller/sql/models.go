@@ -144,6 +144,49 @@ func (ns NullOrigin) Value() (driver.Value, error) {
 	return string(ns.Origin), nil
 }
 
+type RunnerDeploymentState string
+
+const (
+	RunnerDeploymentStateReserved RunnerDeploymentState = "reserved"
+	RunnerDeploymentStateAssigned RunnerDeploymentState = "assigned"
+	RunnerDeploymentStateDraining RunnerDeploymentState = "draining"
+)
+
+func (e *RunnerDeploymentState) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = RunnerDeploymentState(s)
+	case string:
+		*e = RunnerDeploymentState(s)
+	default:
+		return fmt.Errorf("unsupported scan type for RunnerDeploymentState: %T", src)
+	}
+	return nil
+}
+
+type NullRunnerDeploymentState struct {
+	RunnerDeploymentState RunnerDeploymentState
+	Valid                 bool // Valid is true if RunnerDeploymentState is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullRunnerDeploymentState) Scan(value interface{}) error {
+	if value == nil {
+		ns.RunnerDeploymentState, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.RunnerDeploymentState.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullRunnerDeploymentState) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.RunnerDeploymentState), nil
+}
+
 type RunnerState string
 
 const (
@@ -188,6 +231,48 @@ func (ns NullRunnerState) Value() (driver.Value, error) {
 	return string(ns.RunnerState), nil
 }
 
+type SubscriptionEventState string
+
+const (
+	SubscriptionEventStatePending    SubscriptionEventState = "pending"
+	SubscriptionEventStateDeadLetter SubscriptionEventState = "dead_letter"
+)
+
+func (e *SubscriptionEventState) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = SubscriptionEventState(s)
+	case string:
+		*e = SubscriptionEventState(s)
+	default:
+		return fmt.Errorf("unsupported scan type for SubscriptionEventState: %T", src)
+	}
+	return nil
+}
+
+type NullSubscriptionEventState struct {
+	SubscriptionEventState SubscriptionEventState
+	Valid                  bool // Valid is true if SubscriptionEventState is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullSubscriptionEventState) Scan(value interface{}) error {
+	if value == nil {
+		ns.SubscriptionEventState, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.SubscriptionEventState.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullSubscriptionEventState) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.SubscriptionEventState), nil
+}
+
 type Artefact struct {
 	ID        int64
 	CreatedAt time.Time
@@ -204,14 +289,26 @@ type Controller struct {
 	Endpoint string
 }
 
+type CronJob struct {
+	ID            int64
+	DeploymentID  int64
+	ModuleName    string
+	Verb          string
+	Schedule      string
+	NextExecution time.Time
+}
+
 type Deployment struct {
-	ID          int64
-	CreatedAt   time.Time
-	ModuleID    int64
-	Name        model.DeploymentName
-	Schema      *schema.Module
-	Labels      []byte
-	MinReplicas int32
+	ID           int64
+	CreatedAt    time.Time
+	ModuleID     int64
+	Name         model.DeploymentName
+	Schema       *schema.Module
+	Labels       []byte
+	MinReplicas  int32
+	Canary       bool
+	CanaryWeight int32
+	Limits       []byte
 }
 
 type DeploymentArtefact struct {
@@ -249,6 +346,13 @@ type Module struct {
 	Name     string
 }
 
+type RateLimitBucket struct {
+	Key       string
+	Tokens    float64
+	UpdatedAt time.Time
+	FullAt    time.Time
+}
+
 type Request struct {
 	ID         int64
 	Origin     Origin
@@ -257,14 +361,46 @@ type Request struct {
 }
 
 type Runner struct {
-	ID                 int64
-	Key                Key
-	Created            time.Time
-	LastSeen           time.Time
+	ID        int64
+	Key       Key
+	Created   time.Time
+	LastSeen  time.Time
+	State     RunnerState
+	Endpoint  string
+	Capacity  int32
+	Labels    []byte
+	Unhealthy bool
+}
+
+type RunnerDeployment struct {
+	RunnerID           int64
+	DeploymentID       int64
+	State              RunnerDeploymentState
 	ReservationTimeout NullTime
-	State              RunnerState
-	Endpoint           string
-	ModuleName         optional.Option[string]
-	DeploymentID       optional.Option[int64]
-	Labels             []byte
+}
+
+type SubscriptionEvent struct {
+	ID             int64
+	CreatedAt      time.Time
+	SubscriptionID int64
+	State          SubscriptionEventState
+	Attempts       int32
+	NextAttempt    time.Time
+	LastError      optional.Option[string]
+	Payload        []byte
+}
+
+type Topic struct {
+	ID         int64
+	CreatedAt  time.Time
+	ModuleName string
+	Name       string
+}
+
+type TopicSubscription struct {
+	ID         int64
+	CreatedAt  time.Time
+	TopicID    int64
+	ModuleName string
+	Name       string
 }
@@ -9,18 +9,34 @@ import (
 	"time"
 
 	"github.com/TBD54566975/ftl/internal/model"
-	"github.com/alecthomas/types/optional"
 )
 
 type Querier interface {
 	AssociateArtefactWithDeployment(ctx context.Context, arg AssociateArtefactWithDeploymentParams) error
+	// Advance the next execution time of a cron job. If another controller has
+	// already advanced it no rows will be updated.
+	ClaimCronJobExecution(ctx context.Context, nextExecution time.Time, iD int64, prevExecution time.Time) (int64, error)
+	// Claim up to "max" pending events that are due for delivery, preventing
+	// other controllers from delivering them until the lease expires.
+	ClaimSubscriptionEvents(ctx context.Context, max int32, lease time.Duration) ([]ClaimSubscriptionEventsRow, error)
+	CompleteSubscriptionEvent(ctx context.Context, iD int64) error
 	// Create a new artefact and return the artefact ID.
 	CreateArtefact(ctx context.Context, digest []byte, content []byte) (int64, error)
-	CreateDeployment(ctx context.Context, name model.DeploymentName, moduleName string, schema []byte) error
+	CreateCronJob(ctx context.Context, arg CreateCronJobParams) error
+	CreateDeployment(ctx context.Context, arg CreateDeploymentParams) error
 	CreateIngressRequest(ctx context.Context, origin Origin, name string, sourceAddr string) error
 	CreateIngressRoute(ctx context.Context, arg CreateIngressRouteParams) error
+	// Delete rate limit token buckets that have refilled, as they are equivalent
+	// to new buckets.
+	DeleteFullRateLimitBuckets(ctx context.Context, now time.Time) (int64, error)
 	DeregisterRunner(ctx context.Context, key Key) (int64, error)
+	// Mark a deployment on a runner as draining, removing it from the routing
+	// table while its in-flight calls complete.
+	DrainRunnerDeployment(ctx context.Context, key Key, name model.DeploymentName) (int64, error)
 	ExpireRunnerReservations(ctx context.Context) (int64, error)
+	// Record a failed delivery attempt, dead-lettering the event if it has
+	// exhausted its attempts.
+	FailSubscriptionEvent(ctx context.Context, arg FailSubscriptionEventParams) error
 	GetActiveDeploymentSchemas(ctx context.Context) ([]GetActiveDeploymentSchemasRow, error)
 	GetActiveDeployments(ctx context.Context, all bool) ([]GetActiveDeploymentsRow, error)
 	GetActiveRunners(ctx context.Context, all bool) ([]GetActiveRunnersRow, error)
@@ -28,25 +44,38 @@ type Querier interface {
 	GetArtefactContentRange(ctx context.Context, start int32, count int32, iD int64) ([]byte, error)
 	// Return the digests that exist in the database.
 	GetArtefactDigests(ctx context.Context, digests [][]byte) ([]GetArtefactDigestsRow, error)
+	GetCanaryDeploymentForModule(ctx context.Context, name string) (GetCanaryDeploymentForModuleRow, error)
+	GetCanaryDeployments(ctx context.Context) ([]GetCanaryDeploymentsRow, error)
 	GetControllers(ctx context.Context, all bool) ([]Controller, error)
+	// Get the cron jobs of all active deployments.
+	GetCronJobs(ctx context.Context) ([]GetCronJobsRow, error)
+	GetDeadLetterEvents(ctx context.Context, moduleName string, name string) ([]GetDeadLetterEventsRow, error)
 	GetDeployment(ctx context.Context, name model.DeploymentName) (GetDeploymentRow, error)
 	// Get all artefacts matching the given digests.
 	GetDeploymentArtefacts(ctx context.Context, deploymentID int64) ([]GetDeploymentArtefactsRow, error)
+	// Count the calls to a deployment since the given time, and how many failed.
+	// Calls rejected before they reached the deployment are not counted.
+	GetDeploymentCallStats(ctx context.Context, name model.DeploymentName, since time.Time) (GetDeploymentCallStatsRow, error)
+	// Get all deployments of a module, most recent first.
+	GetDeploymentHistory(ctx context.Context, name string) ([]GetDeploymentHistoryRow, error)
 	GetDeploymentsByID(ctx context.Context, ids []int64) ([]Deployment, error)
 	// Get deployments that have a mismatch between the number of assigned and required replicas.
+	//
+	// Replicas that are draining are not counted.
 	GetDeploymentsNeedingReconciliation(ctx context.Context) ([]GetDeploymentsNeedingReconciliationRow, error)
 	// Get all deployments that have artefacts matching the given digests.
-	GetDeploymentsWithArtefacts(ctx context.Context, digests [][]byte, schema []byte, count int64) ([]GetDeploymentsWithArtefactsRow, error)
+	GetDeploymentsWithArtefacts(ctx context.Context, arg GetDeploymentsWithArtefactsParams) ([]GetDeploymentsWithArtefactsRow, error)
 	GetExistingDeploymentForModule(ctx context.Context, name string) (GetExistingDeploymentForModuleRow, error)
 	GetIdleRunners(ctx context.Context, labels []byte, limit int64) ([]Runner, error)
-	// Get the runner endpoints corresponding to the given ingress route.
-	GetIngressRoutes(ctx context.Context, method string) ([]GetIngressRoutesRow, error)
+	// Get the runner endpoints corresponding to every ingress route.
+	GetIngressRoutes(ctx context.Context) ([]GetIngressRoutesRow, error)
 	GetModulesByID(ctx context.Context, ids []int64) ([]Module, error)
 	GetProcessList(ctx context.Context) ([]GetProcessListRow, error)
 	// Retrieve routing information for a runner.
-	GetRouteForRunner(ctx context.Context, key Key) (GetRouteForRunnerRow, error)
 	GetRoutingTable(ctx context.Context, modules []string) ([]GetRoutingTableRow, error)
 	GetRunner(ctx context.Context, key Key) (GetRunnerRow, error)
+	// Count the deployments reserved by, assigned to or draining from a runner.
+	GetRunnerDeploymentCount(ctx context.Context, runnerID int64) (int64, error)
 	GetRunnerState(ctx context.Context, key Key) (RunnerState, error)
 	GetRunnersForDeployment(ctx context.Context, name model.DeploymentName) ([]GetRunnersForDeploymentRow, error)
 	InsertCallEvent(ctx context.Context, arg InsertCallEventParams) error
@@ -57,18 +86,42 @@ type Querier interface {
 	// Mark any controller entries that haven't been updated recently as dead.
 	KillStaleControllers(ctx context.Context, timeout time.Duration) (int64, error)
 	KillStaleRunners(ctx context.Context, timeout time.Duration) (int64, error)
+	// Lock a rate limit token bucket for update, creating it with the given number
+	// of tokens if it does not exist.
+	LockRateLimitBucket(ctx context.Context, key string, tokens float64, now time.Time) (LockRateLimitBucketRow, error)
+	PromoteCanaryDeployment(ctx context.Context, name model.DeploymentName) error
+	// Enqueue an event for every subscription to a topic.
+	PublishEventForTopic(ctx context.Context, payload []byte, moduleName string, name string) (int64, error)
 	ReplaceDeployment(ctx context.Context, oldDeployment string, newDeployment string, minReplicas int32) (int64, error)
-	// Find an idle runner and reserve it for the given deployment.
-	ReserveRunner(ctx context.Context, reservationTimeout time.Time, deploymentName model.DeploymentName, labels []byte) (Runner, error)
+	// Find a runner with spare capacity and reserve it for the given deployment.
+	//
+	// Runners with the least spare capacity are preferred so that deployments are
+	// packed onto as few runners as possible. A runner is never reserved for a
+	// deployment it already hosts.
+	//
+	// The capacity check is made against the snapshot taken before the runner is
+	// locked, so it must be repeated with GetRunnerDeploymentCount once it is.
+	ReserveRunner(ctx context.Context, deploymentName model.DeploymentName, labels []byte, reservationTimeout time.Time) (Runner, error)
+	SetCanaryWeight(ctx context.Context, weight int32, name model.DeploymentName) (int64, error)
 	SetDeploymentDesiredReplicas(ctx context.Context, name model.DeploymentName, minReplicas int32) error
+	// Replace the deployments assigned to a runner with the given deployments,
+	// returning the names of those that exist.
+	//
+	// Reservations for deployments that are not yet assigned are left in place,
+	// and deployments that are draining remain so until the runner drops them.
+	SetRunnerDeployments(ctx context.Context, deploymentNames []string, runnerID int64) ([]model.DeploymentName, error)
+	StartCanaryDeployment(ctx context.Context, minReplicas int32, weight int32, name model.DeploymentName) error
+	// Return a draining deployment on a runner to the routing table, after it
+	// could not be terminated.
+	UndrainRunnerDeployment(ctx context.Context, key Key, name model.DeploymentName) (int64, error)
+	UpdateRateLimitBucket(ctx context.Context, arg UpdateRateLimitBucketParams) error
 	UpsertController(ctx context.Context, key model.ControllerKey, endpoint string) (int64, error)
 	UpsertModule(ctx context.Context, language string, name string) (int64, error)
-	// Upsert a runner and return the deployment ID that it is assigned to, if any.
-	// If the deployment name is null, then deployment_rel.id will be null,
-	// otherwise we try to retrieve the deployments.id using the key. If
-	// there is no corresponding deployment, then the deployment ID is -1
-	// and the parent statement will fail due to a foreign key constraint.
-	UpsertRunner(ctx context.Context, arg UpsertRunnerParams) (optional.Option[int64], error)
+	// Upsert a runner and return its ID.
+	UpsertRunner(ctx context.Context, arg UpsertRunnerParams) (int64, error)
+	// Create a subscription, or point an existing subscription at a new topic.
+	UpsertSubscription(ctx context.Context, arg UpsertSubscriptionParams) error
+	UpsertTopic(ctx context.Context, moduleName string, name string) error
 }
 
 var _ Querier = (*Queries)(nil)
@@ -15,8 +15,8 @@ FROM modules
 WHERE id = ANY (@ids::BIGINT[]);
 
 -- name: CreateDeployment :exec
-INSERT INTO deployments (module_id, "schema", name)
-VALUES ((SELECT id FROM modules WHERE name = @module_name::TEXT LIMIT 1), @schema::BYTEA, $1);
+INSERT INTO deployments (module_id, "schema", name, limits)
+VALUES ((SELECT id FROM modules WHERE name = @module_name::TEXT LIMIT 1), @schema::BYTEA, $1, @limits::jsonb);
 
 -- name: GetArtefactDigests :many
 -- Return the digests that exist in the database.
@@ -70,6 +70,7 @@ WHERE EXISTS (SELECT 1
               WHERE a.digest = ANY (@digests::bytea[])
                 AND da.deployment_id = d.id
                 AND d.schema = @schema::BYTEA
+                AND d.limits = @limits::jsonb
               HAVING COUNT(*) = @count::BIGINT -- Number of unique digests provided
 );
 
@@ -79,33 +80,53 @@ FROM artefacts a
 WHERE a.id = @id;
 
 -- name: UpsertRunner :one
--- Upsert a runner and return the deployment ID that it is assigned to, if any.
-WITH deployment_rel AS (
--- If the deployment name is null, then deployment_rel.id will be null,
--- otherwise we try to retrieve the deployments.id using the key. If
--- there is no corresponding deployment, then the deployment ID is -1
--- and the parent statement will fail due to a foreign key constraint.
-    SELECT CASE
-               WHEN sqlc.narg('deployment_name')::TEXT IS NULL
-                   THEN NULL
-               ELSE COALESCE((SELECT id
-                              FROM deployments d
-                              WHERE d.name = sqlc.narg('deployment_name')
-                              LIMIT 1), -1) END AS id)
+-- Upsert a runner and return its ID.
 INSERT
-INTO runners (key, endpoint, state, labels, deployment_id, last_seen)
+INTO runners (key, endpoint, state, labels, capacity, unhealthy, last_seen)
 VALUES ($1,
         $2,
         $3,
         $4,
-        (SELECT id FROM deployment_rel),
+        $5,
+        $6,
         NOW() AT TIME ZONE 'utc')
-ON CONFLICT (key) DO UPDATE SET endpoint      = $2,
-                                state         = $3,
-                                labels        = $4,
-                                deployment_id = (SELECT id FROM deployment_rel),
-                                last_seen     = NOW() AT TIME ZONE 'utc'
-RETURNING deployment_id;
+ON CONFLICT (key) DO UPDATE SET endpoint  = $2,
+                                state     = $3,
+                                labels    = $4,
+                                capacity  = $5,
+                                unhealthy = $6,
+                                last_seen = NOW() AT TIME ZONE 'utc'
+RETURNING id;
+
+-- name: SetRunnerDeployments :many
+-- Replace the deployments assigned to a runner with the given deployments,
+-- returning the names of those that exist.
+--
+-- Reservations for deployments that are not yet assigned are left in place,
+-- and deployments that are draining remain so until the runner drops them.
+WITH assigned AS (SELECT id, name
+                  FROM deployments d
+                  WHERE d.name = ANY (sqlc.arg('deployment_names')::TEXT[])),
+     released AS (
+         DELETE FROM runner_deployments rd
+             WHERE rd.runner_id = sqlc.arg('runner_id')::BIGINT
+                 AND rd.state IN ('assigned', 'draining')
+                 AND rd.deployment_id NOT IN (SELECT id FROM assigned)
+             RETURNING 1),
+     upserted AS (
+         INSERT
+             INTO runner_deployments (runner_id, deployment_id, state)
+                 SELECT sqlc.arg('runner_id')::BIGINT, id, 'assigned'
+                 FROM assigned
+             ON CONFLICT (runner_id, deployment_id) DO UPDATE SET state               = CASE
+                                                                                            WHEN runner_deployments.state = 'draining'
+                                                                                                THEN 'draining'::runner_deployment_state
+                                                                                            ELSE 'assigned' END,
+                                                                  reservation_timeout = NULL
+             RETURNING deployment_id)
+SELECT a.name
+FROM assigned a
+         INNER JOIN upserted u ON u.deployment_id = a.id;
 
 -- name: KillStaleRunners :one
 WITH matches AS (
@@ -116,6 +137,38 @@ WITH matches AS (
 SELECT COUNT(*)
 FROM matches;
 
+-- name: DrainRunnerDeployment :one
+-- Mark a deployment on a runner as draining, removing it from the routing
+-- table while its in-flight calls complete.
+WITH matches AS (
+    UPDATE runner_deployments rd
+        SET state = 'draining'
+        FROM runners r, deployments d
+        WHERE rd.runner_id = r.id
+            AND rd.deployment_id = d.id
+            AND rd.state = 'assigned'
+            AND r.key = $1
+            AND d.name = $2
+        RETURNING 1)
+SELECT COUNT(*)
+FROM matches;
+
+-- name: UndrainRunnerDeployment :one
+-- Return a draining deployment on a runner to the routing table, after it
+-- could not be terminated.
+WITH matches AS (
+    UPDATE runner_deployments rd
+        SET state = 'assigned'
+        FROM runners r, deployments d
+        WHERE rd.runner_id = r.id
+            AND rd.deployment_id = d.id
+            AND rd.state = 'draining'
+            AND r.key = $1
+            AND d.name = $2
+        RETURNING 1)
+SELECT COUNT(*)
+FROM matches;
+
 -- name: DeregisterRunner :one
 WITH matches AS (
     UPDATE runners
@@ -126,19 +179,21 @@ SELECT COUNT(*)
 FROM matches;
 
 -- name: GetActiveRunners :many
-SELECT DISTINCT ON (r.key) r.key                                   AS runner_key,
-                           r.endpoint,
-                           r.state,
-                           r.labels,
-                           r.last_seen,
-                           r.module_name,
-                           COALESCE(CASE
-                                        WHEN r.deployment_id IS NOT NULL
-                                            THEN d.name END, NULL) AS deployment_name
+SELECT r.key                                                 AS runner_key,
+       r.endpoint,
+       r.state,
+       r.labels,
+       r.last_seen,
+       r.capacity,
+       r.unhealthy,
+       COALESCE(ARRAY_AGG(d.name ORDER BY d.name) FILTER (WHERE d.name IS NOT NULL),
+                '{}')::TEXT[]                                AS deployment_names
 FROM runners r
-         LEFT JOIN deployments d on d.id = r.deployment_id
+         LEFT JOIN runner_deployments rd on rd.runner_id = r.id AND rd.state = 'assigned'
+         LEFT JOIN deployments d on d.id = rd.deployment_id
 WHERE sqlc.arg('all')::bool = true
    OR r.state <> 'dead'
+GROUP BY r.id
 ORDER BY r.key;
 
 -- name: GetActiveDeployments :many
@@ -150,7 +205,7 @@ WHERE sqlc.arg('all')::bool = true
 ORDER BY d.name;
 
 -- name: GetActiveDeploymentSchemas :many
-SELECT name, schema FROM deployments WHERE min_replicas > 0;
+SELECT name, schema FROM deployments WHERE min_replicas > 0 AND NOT canary;
 
 -- name: GetProcessList :many
 SELECT d.min_replicas,
@@ -160,7 +215,8 @@ SELECT d.min_replicas,
        r.endpoint,
        r.labels AS runner_labels
 FROM deployments d
-         LEFT JOIN runners r on d.id = r.deployment_id
+         LEFT JOIN runner_deployments rd on d.id = rd.deployment_id
+         LEFT JOIN runners r on rd.runner_id = r.id
 WHERE d.min_replicas > 0
 ORDER BY d.name;
 
@@ -183,90 +239,187 @@ FROM deployments d
          INNER JOIN modules m on d.module_id = m.id
 WHERE m.name = $1
   AND min_replicas > 0
+  AND NOT canary
+LIMIT 1;
+
+-- name: GetCanaryDeploymentForModule :one
+SELECT d.name, d.canary_weight
+FROM deployments d
+         INNER JOIN modules m on d.module_id = m.id
+WHERE m.name = $1
+  AND min_replicas > 0
+  AND canary
 LIMIT 1;
 
+-- name: GetCanaryDeployments :many
+SELECT d.name AS deployment_name, m.name AS module_name, d.canary_weight, d.min_replicas
+FROM deployments d
+         INNER JOIN modules m on d.module_id = m.id
+WHERE min_replicas > 0
+  AND canary
+ORDER BY d.name;
+
+-- name: StartCanaryDeployment :exec
+UPDATE deployments
+SET min_replicas  = sqlc.arg('min_replicas')::INT,
+    canary        = TRUE,
+    canary_weight = sqlc.arg('weight')::INT
+WHERE name = sqlc.arg('name');
+
+-- name: SetCanaryWeight :execrows
+UPDATE deployments
+SET canary_weight = sqlc.arg('weight')::INT
+WHERE name = sqlc.arg('name')
+  AND canary
+  AND min_replicas > 0;
+
+-- name: PromoteCanaryDeployment :exec
+UPDATE deployments
+SET canary        = FALSE,
+    canary_weight = 0
+WHERE name = $1;
+
+-- name: GetDeploymentCallStats :one
+-- Count the calls to a deployment since the given time, and how many failed.
+-- Calls rejected before they reached the deployment are not counted.
+SELECT COUNT(*)                                                AS calls,
+       COUNT(*) FILTER (WHERE e.payload ->> 'error' IS NOT NULL) AS errors
+FROM events e
+         INNER JOIN deployments d ON e.deployment_id = d.id
+WHERE d.name = sqlc.arg('name')
+  AND e.type = 'call'
+  AND e.time_stamp >= sqlc.arg('since')::TIMESTAMPTZ
+  AND NOT COALESCE((e.payload ->> 'rejected')::BOOLEAN, FALSE);
+
+-- name: GetDeploymentHistory :many
+-- Get all deployments of a module, most recent first.
+SELECT sqlc.embed(d), m.language, m.name AS module_name
+FROM deployments d
+         INNER JOIN modules m ON m.id = d.module_id
+WHERE m.name = $1
+ORDER BY d.created_at DESC, d.id DESC;
+
 -- name: GetDeploymentsNeedingReconciliation :many
 -- Get deployments that have a mismatch between the number of assigned and required replicas.
+--
+-- Replicas that are draining are not counted.
 SELECT d.name                 AS deployment_name,
        m.name                 AS module_name,
        m.language             AS language,
        COUNT(r.id)            AS assigned_runners_count,
        d.min_replicas::BIGINT AS required_runners_count
 FROM deployments d
-         LEFT JOIN runners r ON d.id = r.deployment_id AND r.state <> 'dead'
+         LEFT JOIN runner_deployments rd ON d.id = rd.deployment_id AND rd.state <> 'draining'
+         LEFT JOIN runners r ON rd.runner_id = r.id AND r.state <> 'dead'
          JOIN modules m ON d.module_id = m.id
 GROUP BY d.name, d.min_replicas, m.name, m.language
 HAVING COUNT(r.id) <> d.min_replicas;
 
 
 -- name: ReserveRunner :one
--- Find an idle runner and reserve it for the given deployment.
-UPDATE runners
-SET state               = 'reserved',
-    reservation_timeout = sqlc.arg('reservation_timeout')::timestamptz,
+-- Find a runner with spare capacity and reserve it for the given deployment.
+--
+-- Runners with the least spare capacity are preferred so that deployments are
+-- packed onto as few runners as possible. A runner is never reserved for a
+-- deployment it already hosts.
+--
+-- The capacity check is made against the snapshot taken before the runner is
+-- locked, so it must be repeated with GetRunnerDeploymentCount once it is.
+WITH deployment AS (
     -- If a deployment is not found, then the deployment ID is -1
-    -- and the update will fail due to a FK constraint.
-    deployment_id       = COALESCE((SELECT id
-                                    FROM deployments d
-                                    WHERE d.name = sqlc.arg('deployment_name')
-                                    LIMIT 1), -1)
-WHERE id = (SELECT id
-            FROM runners r
-            WHERE r.state = 'idle'
-              AND r.labels @> sqlc.arg('labels')::jsonb
-            LIMIT 1 FOR UPDATE SKIP LOCKED)
+    -- and the reservation will fail due to a FK constraint.
+    SELECT COALESCE((SELECT id
+                     FROM deployments d
+                     WHERE d.name = sqlc.arg('deployment_name')
+                     LIMIT 1), -1) AS id),
+     runner AS (
+         SELECT r.id
+         FROM runners r
+         WHERE r.state <> 'dead'
+           AND r.labels @> sqlc.arg('labels')::jsonb
+           AND NOT EXISTS (SELECT 1
+                           FROM runner_deployments rd
+                           WHERE rd.runner_id = r.id
+                             AND rd.deployment_id = (SELECT id FROM deployment))
+           AND (SELECT COUNT(*) FROM runner_deployments rd WHERE rd.runner_id = r.id) < r.capacity
+         ORDER BY r.capacity - (SELECT COUNT(*) FROM runner_deployments rd WHERE rd.runner_id = r.id)
+         LIMIT 1 FOR UPDATE SKIP LOCKED),
+     reservation AS (
+         INSERT INTO runner_deployments (runner_id, deployment_id, state, reservation_timeout)
+             SELECT id, (SELECT id FROM deployment), 'reserved', sqlc.arg('reservation_timeout')::timestamptz
+             FROM runner
+             RETURNING runner_id)
+UPDATE runners
+SET state = CASE WHEN state = 'idle' THEN 'reserved'::runner_state ELSE state END
+WHERE id = (SELECT runner_id FROM reservation)
 RETURNING runners.*;
 
+-- name: GetRunnerDeploymentCount :one
+-- Count the deployments reserved by, assigned to or draining from a runner.
+SELECT COUNT(*)
+FROM runner_deployments
+WHERE runner_id = $1;
+
 -- name: GetRunnerState :one
 SELECT state
 FROM runners
 WHERE key = $1;
 
 -- name: GetRunner :one
-SELECT DISTINCT ON (r.key) r.key                                   AS runner_key,
-                           r.endpoint,
-                           r.state,
-                           r.labels,
-                           r.last_seen,
-                           r.module_name,
-                           COALESCE(CASE
-                                        WHEN r.deployment_id IS NOT NULL
-                                            THEN d.name END, NULL) AS deployment_name
+SELECT r.key                                                 AS runner_key,
+       r.endpoint,
+       r.state,
+       r.labels,
+       r.last_seen,
+       r.capacity,
+       r.unhealthy,
+       COALESCE(ARRAY_AGG(d.name ORDER BY d.name) FILTER (WHERE d.name IS NOT NULL),
+                '{}')::TEXT[]                                AS deployment_names
 FROM runners r
-         LEFT JOIN deployments d on d.id = r.deployment_id OR r.deployment_id IS NULL
-WHERE r.key = $1;
+         LEFT JOIN runner_deployments rd on rd.runner_id = r.id AND rd.state = 'assigned'
+         LEFT JOIN deployments d on d.id = rd.deployment_id
+WHERE r.key = $1
+GROUP BY r.id;
 
 -- name: GetRoutingTable :many
-SELECT endpoint, r.key AS runner_key, r.module_name, d.name deployment_name
+SELECT endpoint, r.key AS runner_key, m.name AS module_name, d.name deployment_name, d.canary, d.canary_weight
 FROM runners r
-         LEFT JOIN deployments d on r.deployment_id = d.id
-WHERE state = 'assigned'
+         INNER JOIN runner_deployments rd on rd.runner_id = r.id
+         INNER JOIN deployments d on rd.deployment_id = d.id
+         INNER JOIN modules m on d.module_id = m.id
+WHERE r.state = 'assigned'
+  AND NOT r.unhealthy
+  AND rd.state = 'assigned'
   AND (COALESCE(cardinality(sqlc.arg('modules')::TEXT[]), 0) = 0
-    OR module_name = ANY (sqlc.arg('modules')::TEXT[]));
-
--- name: GetRouteForRunner :one
--- Retrieve routing information for a runner.
-SELECT endpoint, r.key AS runner_key, r.module_name, d.name deployment_name, r.state
-FROM runners r
-         LEFT JOIN deployments d on r.deployment_id = d.id
-WHERE r.key = $1;
+    OR m.name = ANY (sqlc.arg('modules')::TEXT[]));
 
 -- name: GetRunnersForDeployment :many
-SELECT *
+SELECT sqlc.embed(r)
 FROM runners r
-         INNER JOIN deployments d on r.deployment_id = d.id
-WHERE state = 'assigned'
+         INNER JOIN runner_deployments rd on rd.runner_id = r.id
+         INNER JOIN deployments d on rd.deployment_id = d.id
+WHERE r.state = 'assigned'
+  AND rd.state = 'assigned'
   AND d.name = $1;
 
 -- name: ExpireRunnerReservations :one
 WITH rows AS (
-    UPDATE runners
-        SET state = 'idle',
-            deployment_id = NULL,
-            reservation_timeout = NULL
+    DELETE FROM runner_deployments
         WHERE state = 'reserved'
             AND reservation_timeout < (NOW() AT TIME ZONE 'utc')
-        RETURNING 1)
+        RETURNING runner_id),
+     released AS (
+         -- Release runners that have no other deployments.
+         UPDATE runners r
+             SET state = 'idle'
+             WHERE r.state = 'reserved'
+                 AND r.id IN (SELECT runner_id FROM rows)
+                 AND NOT EXISTS (SELECT 1
+                                 FROM runner_deployments rd
+                                 WHERE rd.runner_id = r.id
+                                   AND NOT (rd.state = 'reserved' AND
+                                            rd.reservation_timeout < (NOW() AT TIME ZONE 'utc')))
+             RETURNING 1)
 SELECT COUNT(*)
 FROM rows;
 
@@ -284,7 +437,8 @@ VALUES ((SELECT id FROM deployments d WHERE d.name = sqlc.arg('deployment_name')
                 'message', sqlc.arg('message')::TEXT,
                 'attributes', sqlc.arg('attributes')::JSONB,
                 'error', sqlc.narg('error')::TEXT,
-                'stack', sqlc.narg('stack')::TEXT
+                'stack', sqlc.narg('stack')::TEXT,
+                'rejected', sqlc.arg('rejected')::BOOLEAN
             ));
 
 -- name: InsertDeploymentCreatedEvent :exec
@@ -357,6 +511,27 @@ WITH matches AS (
 SELECT COUNT(*)
 FROM matches;
 
+-- name: LockRateLimitBucket :one
+-- Lock a rate limit token bucket for update, creating it with the given number
+-- of tokens if it does not exist.
+INSERT INTO rate_limit_buckets (key, tokens, updated_at, full_at)
+VALUES (sqlc.arg('key')::VARCHAR, sqlc.arg('tokens')::FLOAT8, sqlc.arg('now')::TIMESTAMPTZ, sqlc.arg('now')::TIMESTAMPTZ)
+ON CONFLICT (key) DO UPDATE SET key = EXCLUDED.key
+RETURNING tokens, updated_at;
+
+-- name: UpdateRateLimitBucket :exec
+UPDATE rate_limit_buckets
+SET tokens     = sqlc.arg('tokens')::FLOAT8,
+    updated_at = sqlc.arg('updated_at')::TIMESTAMPTZ,
+    full_at    = sqlc.arg('full_at')::TIMESTAMPTZ
+WHERE key = sqlc.arg('key')::VARCHAR;
+
+-- name: DeleteFullRateLimitBuckets :execrows
+-- Delete rate limit token buckets that have refilled, as they are equivalent
+-- to new buckets.
+DELETE FROM rate_limit_buckets
+WHERE full_at <= sqlc.arg('now')::TIMESTAMPTZ;
+
 -- name: GetControllers :many
 SELECT *
 FROM controller c
@@ -369,13 +544,14 @@ INSERT INTO ingress_routes (deployment_id, module, verb, method, path)
 VALUES ((SELECT id FROM deployments WHERE name = $1 LIMIT 1), $2, $3, $4, $5);
 
 -- name: GetIngressRoutes :many
--- Get the runner endpoints corresponding to the given ingress route.
-SELECT r.key AS runner_key, d.name AS deployment_name, endpoint, ir.path, ir.module, ir.verb
+-- Get the runner endpoints corresponding to every ingress route.
+SELECT r.key AS runner_key, d.name AS deployment_name, endpoint, ir.method, ir.path, ir.module, ir.verb, d.canary, d.canary_weight
 FROM ingress_routes ir
-         INNER JOIN runners r ON ir.deployment_id = r.deployment_id
+         INNER JOIN runner_deployments rd ON ir.deployment_id = rd.deployment_id
+         INNER JOIN runners r ON rd.runner_id = r.id
          INNER JOIN deployments d ON ir.deployment_id = d.id
 WHERE r.state = 'assigned'
-  AND ir.method = $1;
+  AND rd.state = 'assigned';
 
 -- name: GetAllIngressRoutes :many
 SELECT d.name AS deployment_name, ir.module, ir.verb, ir.method, ir.path
@@ -384,6 +560,96 @@ FROM ingress_routes ir
 WHERE sqlc.arg('all')::bool = true
    OR d.min_replicas > 0;
 
+-- name: CreateCronJob :exec
+INSERT INTO cron_jobs (deployment_id, module_name, verb, schedule, next_execution)
+VALUES ((SELECT id FROM deployments WHERE name = sqlc.arg('deployment_name')::TEXT LIMIT 1),
+        sqlc.arg('module_name')::TEXT,
+        sqlc.arg('verb')::TEXT,
+        sqlc.arg('schedule')::TEXT,
+        sqlc.arg('next_execution')::TIMESTAMPTZ);
+
+-- name: GetCronJobs :many
+-- Get the cron jobs of all active deployments.
+SELECT j.id, d.name AS deployment_name, j.module_name, j.verb, j.schedule, j.next_execution
+FROM cron_jobs j
+         INNER JOIN deployments d ON j.deployment_id = d.id
+WHERE d.min_replicas > 0
+  AND NOT d.canary
+ORDER BY j.next_execution;
+
+-- name: ClaimCronJobExecution :execrows
+-- Advance the next execution time of a cron job. If another controller has
+-- already advanced it no rows will be updated.
+UPDATE cron_jobs
+SET next_execution = sqlc.arg('next_execution')::TIMESTAMPTZ
+WHERE id = sqlc.arg('id')
+  AND next_execution = sqlc.arg('prev_execution')::TIMESTAMPTZ;
+
+-- name: UpsertTopic :exec
+INSERT INTO topics (module_name, name)
+VALUES (sqlc.arg('module_name')::TEXT, sqlc.arg('name')::TEXT)
+ON CONFLICT (module_name, name) DO NOTHING;
+
+-- name: UpsertSubscription :exec
+-- Create a subscription, or point an existing subscription at a new topic.
+INSERT INTO topic_subscriptions (topic_id, module_name, name)
+VALUES ((SELECT id FROM topics WHERE topics.module_name = sqlc.arg('topic_module')::TEXT AND topics.name = sqlc.arg('topic')::TEXT),
+        sqlc.arg('module_name')::TEXT,
+        sqlc.arg('name')::TEXT)
+ON CONFLICT (module_name, name) DO UPDATE SET topic_id = excluded.topic_id;
+
+-- name: PublishEventForTopic :execrows
+-- Enqueue an event for every subscription to a topic.
+INSERT INTO subscription_events (subscription_id, payload)
+SELECT s.id, sqlc.arg('payload')::JSONB
+FROM topic_subscriptions s
+         INNER JOIN topics t ON s.topic_id = t.id
+WHERE t.module_name = sqlc.arg('module_name')::TEXT
+  AND t.name = sqlc.arg('name')::TEXT;
+
+-- name: ClaimSubscriptionEvents :many
+-- Claim up to "max" pending events that are due for delivery, preventing
+-- other controllers from delivering them until the lease expires.
+WITH claimed AS (
+    SELECT id
+    FROM subscription_events
+    WHERE state = 'pending'
+      AND next_attempt <= (NOW() AT TIME ZONE 'utc')
+    ORDER BY next_attempt
+    LIMIT sqlc.arg('max')::INT FOR UPDATE SKIP LOCKED)
+UPDATE subscription_events e
+SET attempts     = e.attempts + 1,
+    next_attempt = (NOW() AT TIME ZONE 'utc') + sqlc.arg('lease')::INTERVAL
+FROM claimed,
+     topic_subscriptions s
+WHERE e.id = claimed.id
+  AND e.subscription_id = s.id
+RETURNING e.id, s.module_name, s.name AS subscription, e.attempts, e.payload;
+
+-- name: CompleteSubscriptionEvent :exec
+DELETE
+FROM subscription_events
+WHERE id = sqlc.arg('id');
+
+-- name: FailSubscriptionEvent :exec
+-- Record a failed delivery attempt, dead-lettering the event if it has
+-- exhausted its attempts.
+UPDATE subscription_events
+SET last_error   = sqlc.arg('error')::TEXT,
+    next_attempt = sqlc.arg('next_attempt')::TIMESTAMPTZ,
+    state        = CASE
+                       WHEN attempts >= sqlc.arg('max_attempts')::INT THEN 'dead_letter'::subscription_event_state
+                       ELSE 'pending'::subscription_event_state END
+WHERE id = sqlc.arg('id');
+
+-- name: GetDeadLetterEvents :many
+SELECT e.id, e.created_at, e.attempts, e.last_error, e.payload
+FROM subscription_events e
+         INNER JOIN topic_subscriptions s ON e.subscription_id = s.id
+WHERE s.module_name = sqlc.arg('module_name')::TEXT
+  AND s.name = sqlc.arg('name')::TEXT
+  AND e.state = 'dead_letter'
+ORDER BY e.id;
 
 -- name: InsertEvent :exec
 INSERT INTO events (deployment_id, request_id, type,
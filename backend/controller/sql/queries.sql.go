@@ -37,6 +37,88 @@ func (q *Queries) AssociateArtefactWithDeployment(ctx context.Context, arg Assoc
 	return err
 }
 
+const claimCronJobExecution = `-- name: ClaimCronJobExecution :execrows
+UPDATE cron_jobs
+SET next_execution = $1::TIMESTAMPTZ
+WHERE id = $2
+  AND next_execution = $3::TIMESTAMPTZ
+`
+
+// Advance the next execution time of a cron job. If another controller has
+// already advanced it no rows will be updated.
+func (q *Queries) ClaimCronJobExecution(ctx context.Context, nextExecution time.Time, iD int64, prevExecution time.Time) (int64, error) {
+	result, err := q.db.Exec(ctx, claimCronJobExecution, nextExecution, iD, prevExecution)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const claimSubscriptionEvents = `-- name: ClaimSubscriptionEvents :many
+WITH claimed AS (
+    SELECT id
+    FROM subscription_events
+    WHERE state = 'pending'
+      AND next_attempt <= (NOW() AT TIME ZONE 'utc')
+    ORDER BY next_attempt
+    LIMIT $1::INT FOR UPDATE SKIP LOCKED)
+UPDATE subscription_events e
+SET attempts     = e.attempts + 1,
+    next_attempt = (NOW() AT TIME ZONE 'utc') + $2::INTERVAL
+FROM claimed,
+     topic_subscriptions s
+WHERE e.id = claimed.id
+  AND e.subscription_id = s.id
+RETURNING e.id, s.module_name, s.name AS subscription, e.attempts, e.payload
+`
+
+type ClaimSubscriptionEventsRow struct {
+	ID           int64
+	ModuleName   string
+	Subscription string
+	Attempts     int32
+	Payload      []byte
+}
+
+// Claim up to "max" pending events that are due for delivery, preventing
+// other controllers from delivering them until the lease expires.
+func (q *Queries) ClaimSubscriptionEvents(ctx context.Context, max int32, lease time.Duration) ([]ClaimSubscriptionEventsRow, error) {
+	rows, err := q.db.Query(ctx, claimSubscriptionEvents, max, lease)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ClaimSubscriptionEventsRow
+	for rows.Next() {
+		var i ClaimSubscriptionEventsRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.ModuleName,
+			&i.Subscription,
+			&i.Attempts,
+			&i.Payload,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const completeSubscriptionEvent = `-- name: CompleteSubscriptionEvent :exec
+DELETE
+FROM subscription_events
+WHERE id = $1
+`
+
+func (q *Queries) CompleteSubscriptionEvent(ctx context.Context, iD int64) error {
+	_, err := q.db.Exec(ctx, completeSubscriptionEvent, iD)
+	return err
+}
+
 const createArtefact = `-- name: CreateArtefact :one
 INSERT INTO artefacts (digest, content)
 VALUES ($1, $2)
@@ -51,13 +133,53 @@ func (q *Queries) CreateArtefact(ctx context.Context, digest []byte, content []b
 	return id, err
 }
 
+const createCronJob = `-- name: CreateCronJob :exec
+INSERT INTO cron_jobs (deployment_id, module_name, verb, schedule, next_execution)
+VALUES ((SELECT id FROM deployments WHERE name = $1::TEXT LIMIT 1),
+        $2::TEXT,
+        $3::TEXT,
+        $4::TEXT,
+        $5::TIMESTAMPTZ)
+`
+
+type CreateCronJobParams struct {
+	DeploymentName string
+	ModuleName     string
+	Verb           string
+	Schedule       string
+	NextExecution  time.Time
+}
+
+func (q *Queries) CreateCronJob(ctx context.Context, arg CreateCronJobParams) error {
+	_, err := q.db.Exec(ctx, createCronJob,
+		arg.DeploymentName,
+		arg.ModuleName,
+		arg.Verb,
+		arg.Schedule,
+		arg.NextExecution,
+	)
+	return err
+}
+
 const createDeployment = `-- name: CreateDeployment :exec
-INSERT INTO deployments (module_id, "schema", name)
-VALUES ((SELECT id FROM modules WHERE name = $2::TEXT LIMIT 1), $3::BYTEA, $1)
+INSERT INTO deployments (module_id, "schema", name, limits)
+VALUES ((SELECT id FROM modules WHERE name = $2::TEXT LIMIT 1), $3::BYTEA, $1, $4::jsonb)
 `
 
-func (q *Queries) CreateDeployment(ctx context.Context, name model.DeploymentName, moduleName string, schema []byte) error {
-	_, err := q.db.Exec(ctx, createDeployment, name, moduleName, schema)
+type CreateDeploymentParams struct {
+	Name       model.DeploymentName
+	ModuleName string
+	Schema     []byte
+	Limits     []byte
+}
+
+func (q *Queries) CreateDeployment(ctx context.Context, arg CreateDeploymentParams) error {
+	_, err := q.db.Exec(ctx, createDeployment,
+		arg.Name,
+		arg.ModuleName,
+		arg.Schema,
+		arg.Limits,
+	)
 	return err
 }
 
@@ -95,6 +217,21 @@ func (q *Queries) CreateIngressRoute(ctx context.Context, arg CreateIngressRoute
 	return err
 }
 
+const deleteFullRateLimitBuckets = `-- name: DeleteFullRateLimitBuckets :execrows
+DELETE FROM rate_limit_buckets
+WHERE full_at <= $1::TIMESTAMPTZ
+`
+
+// Delete rate limit token buckets that have refilled, as they are equivalent
+// to new buckets.
+func (q *Queries) DeleteFullRateLimitBuckets(ctx context.Context, now time.Time) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteFullRateLimitBuckets, now)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
 const deregisterRunner = `-- name: DeregisterRunner :one
 WITH matches AS (
     UPDATE runners
@@ -112,15 +249,48 @@ func (q *Queries) DeregisterRunner(ctx context.Context, key Key) (int64, error)
 	return count, err
 }
 
+const drainRunnerDeployment = `-- name: DrainRunnerDeployment :one
+WITH matches AS (
+    UPDATE runner_deployments rd
+        SET state = 'draining'
+        FROM runners r, deployments d
+        WHERE rd.runner_id = r.id
+            AND rd.deployment_id = d.id
+            AND rd.state = 'assigned'
+            AND r.key = $1
+            AND d.name = $2
+        RETURNING 1)
+SELECT COUNT(*)
+FROM matches
+`
+
+// Mark a deployment on a runner as draining, removing it from the routing
+// table while its in-flight calls complete.
+func (q *Queries) DrainRunnerDeployment(ctx context.Context, key Key, name model.DeploymentName) (int64, error) {
+	row := q.db.QueryRow(ctx, drainRunnerDeployment, key, name)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
 const expireRunnerReservations = `-- name: ExpireRunnerReservations :one
 WITH rows AS (
-    UPDATE runners
-        SET state = 'idle',
-            deployment_id = NULL,
-            reservation_timeout = NULL
+    DELETE FROM runner_deployments
         WHERE state = 'reserved'
             AND reservation_timeout < (NOW() AT TIME ZONE 'utc')
-        RETURNING 1)
+        RETURNING runner_id),
+     released AS (
+         -- Release runners that have no other deployments.
+         UPDATE runners r
+             SET state = 'idle'
+             WHERE r.state = 'reserved'
+                 AND r.id IN (SELECT runner_id FROM rows)
+                 AND NOT EXISTS (SELECT 1
+                                 FROM runner_deployments rd
+                                 WHERE rd.runner_id = r.id
+                                   AND NOT (rd.state = 'reserved' AND
+                                            rd.reservation_timeout < (NOW() AT TIME ZONE 'utc')))
+             RETURNING 1)
 SELECT COUNT(*)
 FROM rows
 `
@@ -132,8 +302,37 @@ func (q *Queries) ExpireRunnerReservations(ctx context.Context) (int64, error) {
 	return count, err
 }
 
+const failSubscriptionEvent = `-- name: FailSubscriptionEvent :exec
+UPDATE subscription_events
+SET last_error   = $1::TEXT,
+    next_attempt = $2::TIMESTAMPTZ,
+    state        = CASE
+                       WHEN attempts >= $3::INT THEN 'dead_letter'::subscription_event_state
+                       ELSE 'pending'::subscription_event_state END
+WHERE id = $4
+`
+
+type FailSubscriptionEventParams struct {
+	Error       string
+	NextAttempt time.Time
+	MaxAttempts int32
+	ID          int64
+}
+
+// Record a failed delivery attempt, dead-lettering the event if it has
+// exhausted its attempts.
+func (q *Queries) FailSubscriptionEvent(ctx context.Context, arg FailSubscriptionEventParams) error {
+	_, err := q.db.Exec(ctx, failSubscriptionEvent,
+		arg.Error,
+		arg.NextAttempt,
+		arg.MaxAttempts,
+		arg.ID,
+	)
+	return err
+}
+
 const getActiveDeploymentSchemas = `-- name: GetActiveDeploymentSchemas :many
-SELECT name, schema FROM deployments WHERE min_replicas > 0
+SELECT name, schema FROM deployments WHERE min_replicas > 0 AND NOT canary
 `
 
 type GetActiveDeploymentSchemasRow struct {
@@ -162,7 +361,7 @@ func (q *Queries) GetActiveDeploymentSchemas(ctx context.Context) ([]GetActiveDe
 }
 
 const getActiveDeployments = `-- name: GetActiveDeployments :many
-SELECT d.id, d.created_at, d.module_id, d.name, d.schema, d.labels, d.min_replicas, m.name AS module_name, m.language
+SELECT d.id, d.created_at, d.module_id, d.name, d.schema, d.labels, d.min_replicas, d.canary, d.canary_weight, d.limits, m.name AS module_name, m.language
 FROM deployments d
          INNER JOIN modules m on d.module_id = m.id
 WHERE $1::bool = true
@@ -193,6 +392,9 @@ func (q *Queries) GetActiveDeployments(ctx context.Context, all bool) ([]GetActi
 			&i.Deployment.Schema,
 			&i.Deployment.Labels,
 			&i.Deployment.MinReplicas,
+			&i.Deployment.Canary,
+			&i.Deployment.CanaryWeight,
+			&i.Deployment.Limits,
 			&i.ModuleName,
 			&i.Language,
 		); err != nil {
@@ -207,30 +409,33 @@ func (q *Queries) GetActiveDeployments(ctx context.Context, all bool) ([]GetActi
 }
 
 const getActiveRunners = `-- name: GetActiveRunners :many
-SELECT DISTINCT ON (r.key) r.key                                   AS runner_key,
-                           r.endpoint,
-                           r.state,
-                           r.labels,
-                           r.last_seen,
-                           r.module_name,
-                           COALESCE(CASE
-                                        WHEN r.deployment_id IS NOT NULL
-                                            THEN d.name END, NULL) AS deployment_name
+SELECT r.key                                                 AS runner_key,
+       r.endpoint,
+       r.state,
+       r.labels,
+       r.last_seen,
+       r.capacity,
+       r.unhealthy,
+       COALESCE(ARRAY_AGG(d.name ORDER BY d.name) FILTER (WHERE d.name IS NOT NULL),
+                '{}')::TEXT[]                                AS deployment_names
 FROM runners r
-         LEFT JOIN deployments d on d.id = r.deployment_id
+         LEFT JOIN runner_deployments rd on rd.runner_id = r.id AND rd.state = 'assigned'
+         LEFT JOIN deployments d on d.id = rd.deployment_id
 WHERE $1::bool = true
    OR r.state <> 'dead'
+GROUP BY r.id
 ORDER BY r.key
 `
 
 type GetActiveRunnersRow struct {
-	RunnerKey      Key
-	Endpoint       string
-	State          RunnerState
-	Labels         []byte
-	LastSeen       time.Time
-	ModuleName     optional.Option[string]
-	DeploymentName optional.Option[string]
+	RunnerKey       Key
+	Endpoint        string
+	State           RunnerState
+	Labels          []byte
+	LastSeen        time.Time
+	Capacity        int32
+	Unhealthy       bool
+	DeploymentNames []string
 }
 
 func (q *Queries) GetActiveRunners(ctx context.Context, all bool) ([]GetActiveRunnersRow, error) {
@@ -248,8 +453,9 @@ func (q *Queries) GetActiveRunners(ctx context.Context, all bool) ([]GetActiveRu
 			&i.State,
 			&i.Labels,
 			&i.LastSeen,
-			&i.ModuleName,
-			&i.DeploymentName,
+			&i.Capacity,
+			&i.Unhealthy,
+			&i.DeploymentNames,
 		); err != nil {
 			return nil, err
 		}
@@ -348,6 +554,69 @@ func (q *Queries) GetArtefactDigests(ctx context.Context, digests [][]byte) ([]G
 	return items, nil
 }
 
+const getCanaryDeploymentForModule = `-- name: GetCanaryDeploymentForModule :one
+SELECT d.name, d.canary_weight
+FROM deployments d
+         INNER JOIN modules m on d.module_id = m.id
+WHERE m.name = $1
+  AND min_replicas > 0
+  AND canary
+LIMIT 1
+`
+
+type GetCanaryDeploymentForModuleRow struct {
+	Name         model.DeploymentName
+	CanaryWeight int32
+}
+
+func (q *Queries) GetCanaryDeploymentForModule(ctx context.Context, name string) (GetCanaryDeploymentForModuleRow, error) {
+	row := q.db.QueryRow(ctx, getCanaryDeploymentForModule, name)
+	var i GetCanaryDeploymentForModuleRow
+	err := row.Scan(&i.Name, &i.CanaryWeight)
+	return i, err
+}
+
+const getCanaryDeployments = `-- name: GetCanaryDeployments :many
+SELECT d.name AS deployment_name, m.name AS module_name, d.canary_weight, d.min_replicas
+FROM deployments d
+         INNER JOIN modules m on d.module_id = m.id
+WHERE min_replicas > 0
+  AND canary
+ORDER BY d.name
+`
+
+type GetCanaryDeploymentsRow struct {
+	DeploymentName model.DeploymentName
+	ModuleName     string
+	CanaryWeight   int32
+	MinReplicas    int32
+}
+
+func (q *Queries) GetCanaryDeployments(ctx context.Context) ([]GetCanaryDeploymentsRow, error) {
+	rows, err := q.db.Query(ctx, getCanaryDeployments)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetCanaryDeploymentsRow
+	for rows.Next() {
+		var i GetCanaryDeploymentsRow
+		if err := rows.Scan(
+			&i.DeploymentName,
+			&i.ModuleName,
+			&i.CanaryWeight,
+			&i.MinReplicas,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const getControllers = `-- name: GetControllers :many
 SELECT id, key, created, last_seen, state, endpoint
 FROM controller c
@@ -383,8 +652,98 @@ func (q *Queries) GetControllers(ctx context.Context, all bool) ([]Controller, e
 	return items, nil
 }
 
+const getCronJobs = `-- name: GetCronJobs :many
+SELECT j.id, d.name AS deployment_name, j.module_name, j.verb, j.schedule, j.next_execution
+FROM cron_jobs j
+         INNER JOIN deployments d ON j.deployment_id = d.id
+WHERE d.min_replicas > 0
+  AND NOT d.canary
+ORDER BY j.next_execution
+`
+
+type GetCronJobsRow struct {
+	ID             int64
+	DeploymentName model.DeploymentName
+	ModuleName     string
+	Verb           string
+	Schedule       string
+	NextExecution  time.Time
+}
+
+// Get the cron jobs of all active deployments.
+func (q *Queries) GetCronJobs(ctx context.Context) ([]GetCronJobsRow, error) {
+	rows, err := q.db.Query(ctx, getCronJobs)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetCronJobsRow
+	for rows.Next() {
+		var i GetCronJobsRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.DeploymentName,
+			&i.ModuleName,
+			&i.Verb,
+			&i.Schedule,
+			&i.NextExecution,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getDeadLetterEvents = `-- name: GetDeadLetterEvents :many
+SELECT e.id, e.created_at, e.attempts, e.last_error, e.payload
+FROM subscription_events e
+         INNER JOIN topic_subscriptions s ON e.subscription_id = s.id
+WHERE s.module_name = $1::TEXT
+  AND s.name = $2::TEXT
+  AND e.state = 'dead_letter'
+ORDER BY e.id
+`
+
+type GetDeadLetterEventsRow struct {
+	ID        int64
+	CreatedAt time.Time
+	Attempts  int32
+	LastError optional.Option[string]
+	Payload   []byte
+}
+
+func (q *Queries) GetDeadLetterEvents(ctx context.Context, moduleName string, name string) ([]GetDeadLetterEventsRow, error) {
+	rows, err := q.db.Query(ctx, getDeadLetterEvents, moduleName, name)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetDeadLetterEventsRow
+	for rows.Next() {
+		var i GetDeadLetterEventsRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.CreatedAt,
+			&i.Attempts,
+			&i.LastError,
+			&i.Payload,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const getDeployment = `-- name: GetDeployment :one
-SELECT d.id, d.created_at, d.module_id, d.name, d.schema, d.labels, d.min_replicas, m.language, m.name AS module_name, d.min_replicas
+SELECT d.id, d.created_at, d.module_id, d.name, d.schema, d.labels, d.min_replicas, d.canary, d.canary_weight, d.limits, m.language, m.name AS module_name, d.min_replicas
 FROM deployments d
          INNER JOIN modules m ON m.id = d.module_id
 WHERE d.name = $1
@@ -408,6 +767,9 @@ func (q *Queries) GetDeployment(ctx context.Context, name model.DeploymentName)
 		&i.Deployment.Schema,
 		&i.Deployment.Labels,
 		&i.Deployment.MinReplicas,
+		&i.Deployment.Canary,
+		&i.Deployment.CanaryWeight,
+		&i.Deployment.Limits,
 		&i.Language,
 		&i.ModuleName,
 		&i.MinReplicas,
@@ -459,8 +821,81 @@ func (q *Queries) GetDeploymentArtefacts(ctx context.Context, deploymentID int64
 	return items, nil
 }
 
+const getDeploymentCallStats = `-- name: GetDeploymentCallStats :one
+SELECT COUNT(*)                                                AS calls,
+       COUNT(*) FILTER (WHERE e.payload ->> 'error' IS NOT NULL) AS errors
+FROM events e
+         INNER JOIN deployments d ON e.deployment_id = d.id
+WHERE d.name = $1
+  AND e.type = 'call'
+  AND e.time_stamp >= $2::TIMESTAMPTZ
+  AND NOT COALESCE((e.payload ->> 'rejected')::BOOLEAN, FALSE)
+`
+
+type GetDeploymentCallStatsRow struct {
+	Calls  int64
+	Errors int64
+}
+
+// Count the calls to a deployment since the given time, and how many failed.
+// Calls rejected before they reached the deployment are not counted.
+func (q *Queries) GetDeploymentCallStats(ctx context.Context, name model.DeploymentName, since time.Time) (GetDeploymentCallStatsRow, error) {
+	row := q.db.QueryRow(ctx, getDeploymentCallStats, name, since)
+	var i GetDeploymentCallStatsRow
+	err := row.Scan(&i.Calls, &i.Errors)
+	return i, err
+}
+
+const getDeploymentHistory = `-- name: GetDeploymentHistory :many
+SELECT d.id, d.created_at, d.module_id, d.name, d.schema, d.labels, d.min_replicas, d.canary, d.canary_weight, d.limits, m.language, m.name AS module_name
+FROM deployments d
+         INNER JOIN modules m ON m.id = d.module_id
+WHERE m.name = $1
+ORDER BY d.created_at DESC, d.id DESC
+`
+
+type GetDeploymentHistoryRow struct {
+	Deployment Deployment
+	Language   string
+	ModuleName string
+}
+
+// Get all deployments of a module, most recent first.
+func (q *Queries) GetDeploymentHistory(ctx context.Context, name string) ([]GetDeploymentHistoryRow, error) {
+	rows, err := q.db.Query(ctx, getDeploymentHistory, name)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetDeploymentHistoryRow
+	for rows.Next() {
+		var i GetDeploymentHistoryRow
+		if err := rows.Scan(
+			&i.Deployment.ID,
+			&i.Deployment.CreatedAt,
+			&i.Deployment.ModuleID,
+			&i.Deployment.Name,
+			&i.Deployment.Schema,
+			&i.Deployment.Labels,
+			&i.Deployment.MinReplicas,
+			&i.Deployment.Canary,
+			&i.Deployment.CanaryWeight,
+			&i.Deployment.Limits,
+			&i.Language,
+			&i.ModuleName,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const getDeploymentsByID = `-- name: GetDeploymentsByID :many
-SELECT id, created_at, module_id, name, schema, labels, min_replicas
+SELECT id, created_at, module_id, name, schema, labels, min_replicas, canary, canary_weight, limits
 FROM deployments
 WHERE id = ANY ($1::BIGINT[])
 `
@@ -482,6 +917,9 @@ func (q *Queries) GetDeploymentsByID(ctx context.Context, ids []int64) ([]Deploy
 			&i.Schema,
 			&i.Labels,
 			&i.MinReplicas,
+			&i.Canary,
+			&i.CanaryWeight,
+			&i.Limits,
 		); err != nil {
 			return nil, err
 		}
@@ -500,7 +938,8 @@ SELECT d.name                 AS deployment_name,
        COUNT(r.id)            AS assigned_runners_count,
        d.min_replicas::BIGINT AS required_runners_count
 FROM deployments d
-         LEFT JOIN runners r ON d.id = r.deployment_id AND r.state <> 'dead'
+         LEFT JOIN runner_deployments rd ON d.id = rd.deployment_id AND rd.state <> 'draining'
+         LEFT JOIN runners r ON rd.runner_id = r.id AND r.state <> 'dead'
          JOIN modules m ON d.module_id = m.id
 GROUP BY d.name, d.min_replicas, m.name, m.language
 HAVING COUNT(r.id) <> d.min_replicas
@@ -515,6 +954,8 @@ type GetDeploymentsNeedingReconciliationRow struct {
 }
 
 // Get deployments that have a mismatch between the number of assigned and required replicas.
+//
+// Replicas that are draining are not counted.
 func (q *Queries) GetDeploymentsNeedingReconciliation(ctx context.Context) ([]GetDeploymentsNeedingReconciliationRow, error) {
 	rows, err := q.db.Query(ctx, getDeploymentsNeedingReconciliation)
 	if err != nil {
@@ -551,10 +992,18 @@ WHERE EXISTS (SELECT 1
               WHERE a.digest = ANY ($1::bytea[])
                 AND da.deployment_id = d.id
                 AND d.schema = $2::BYTEA
-              HAVING COUNT(*) = $3::BIGINT -- Number of unique digests provided
+                AND d.limits = $3::jsonb
+              HAVING COUNT(*) = $4::BIGINT -- Number of unique digests provided
 )
 `
 
+type GetDeploymentsWithArtefactsParams struct {
+	Digests [][]byte
+	Schema  []byte
+	Limits  []byte
+	Count   int64
+}
+
 type GetDeploymentsWithArtefactsRow struct {
 	ID             int64
 	CreatedAt      time.Time
@@ -564,8 +1013,13 @@ type GetDeploymentsWithArtefactsRow struct {
 }
 
 // Get all deployments that have artefacts matching the given digests.
-func (q *Queries) GetDeploymentsWithArtefacts(ctx context.Context, digests [][]byte, schema []byte, count int64) ([]GetDeploymentsWithArtefactsRow, error) {
-	rows, err := q.db.Query(ctx, getDeploymentsWithArtefacts, digests, schema, count)
+func (q *Queries) GetDeploymentsWithArtefacts(ctx context.Context, arg GetDeploymentsWithArtefactsParams) ([]GetDeploymentsWithArtefactsRow, error) {
+	rows, err := q.db.Query(ctx, getDeploymentsWithArtefacts,
+		arg.Digests,
+		arg.Schema,
+		arg.Limits,
+		arg.Count,
+	)
 	if err != nil {
 		return nil, err
 	}
@@ -591,25 +1045,29 @@ func (q *Queries) GetDeploymentsWithArtefacts(ctx context.Context, digests [][]b
 }
 
 const getExistingDeploymentForModule = `-- name: GetExistingDeploymentForModule :one
-SELECT d.id, created_at, module_id, d.name, schema, labels, min_replicas, m.id, language, m.name
+SELECT d.id, created_at, module_id, d.name, schema, labels, min_replicas, canary, canary_weight, limits, m.id, language, m.name
 FROM deployments d
          INNER JOIN modules m on d.module_id = m.id
 WHERE m.name = $1
   AND min_replicas > 0
+  AND NOT canary
 LIMIT 1
 `
 
 type GetExistingDeploymentForModuleRow struct {
-	ID          int64
-	CreatedAt   time.Time
-	ModuleID    int64
-	Name        model.DeploymentName
-	Schema      *schema.Module
-	Labels      []byte
-	MinReplicas int32
-	ID_2        int64
-	Language    string
-	Name_2      string
+	ID           int64
+	CreatedAt    time.Time
+	ModuleID     int64
+	Name         model.DeploymentName
+	Schema       *schema.Module
+	Labels       []byte
+	MinReplicas  int32
+	Canary       bool
+	CanaryWeight int32
+	Limits       []byte
+	ID_2         int64
+	Language     string
+	Name_2       string
 }
 
 func (q *Queries) GetExistingDeploymentForModule(ctx context.Context, name string) (GetExistingDeploymentForModuleRow, error) {
@@ -623,6 +1081,9 @@ func (q *Queries) GetExistingDeploymentForModule(ctx context.Context, name strin
 		&i.Schema,
 		&i.Labels,
 		&i.MinReplicas,
+		&i.Canary,
+		&i.CanaryWeight,
+		&i.Limits,
 		&i.ID_2,
 		&i.Language,
 		&i.Name_2,
@@ -631,7 +1092,7 @@ func (q *Queries) GetExistingDeploymentForModule(ctx context.Context, name strin
 }
 
 const getIdleRunners = `-- name: GetIdleRunners :many
-SELECT id, key, created, last_seen, reservation_timeout, state, endpoint, module_name, deployment_id, labels
+SELECT id, key, created, last_seen, state, endpoint, capacity, labels, unhealthy
 FROM runners
 WHERE labels @> $1::jsonb
   AND state = 'idle'
@@ -652,12 +1113,11 @@ func (q *Queries) GetIdleRunners(ctx context.Context, labels []byte, limit int64
 			&i.Key,
 			&i.Created,
 			&i.LastSeen,
-			&i.ReservationTimeout,
 			&i.State,
 			&i.Endpoint,
-			&i.ModuleName,
-			&i.DeploymentID,
+			&i.Capacity,
 			&i.Labels,
+			&i.Unhealthy,
 		); err != nil {
 			return nil, err
 		}
@@ -670,26 +1130,30 @@ func (q *Queries) GetIdleRunners(ctx context.Context, labels []byte, limit int64
 }
 
 const getIngressRoutes = `-- name: GetIngressRoutes :many
-SELECT r.key AS runner_key, d.name AS deployment_name, endpoint, ir.path, ir.module, ir.verb
+SELECT r.key AS runner_key, d.name AS deployment_name, endpoint, ir.method, ir.path, ir.module, ir.verb, d.canary, d.canary_weight
 FROM ingress_routes ir
-         INNER JOIN runners r ON ir.deployment_id = r.deployment_id
+         INNER JOIN runner_deployments rd ON ir.deployment_id = rd.deployment_id
+         INNER JOIN runners r ON rd.runner_id = r.id
          INNER JOIN deployments d ON ir.deployment_id = d.id
 WHERE r.state = 'assigned'
-  AND ir.method = $1
+  AND rd.state = 'assigned'
 `
 
 type GetIngressRoutesRow struct {
 	RunnerKey      Key
 	DeploymentName model.DeploymentName
 	Endpoint       string
+	Method         string
 	Path           string
 	Module         string
 	Verb           string
+	Canary         bool
+	CanaryWeight   int32
 }
 
-// Get the runner endpoints corresponding to the given ingress route.
-func (q *Queries) GetIngressRoutes(ctx context.Context, method string) ([]GetIngressRoutesRow, error) {
-	rows, err := q.db.Query(ctx, getIngressRoutes, method)
+// Get the runner endpoints corresponding to every ingress route.
+func (q *Queries) GetIngressRoutes(ctx context.Context) ([]GetIngressRoutesRow, error) {
+	rows, err := q.db.Query(ctx, getIngressRoutes)
 	if err != nil {
 		return nil, err
 	}
@@ -701,9 +1165,12 @@ func (q *Queries) GetIngressRoutes(ctx context.Context, method string) ([]GetIng
 			&i.RunnerKey,
 			&i.DeploymentName,
 			&i.Endpoint,
+			&i.Method,
 			&i.Path,
 			&i.Module,
 			&i.Verb,
+			&i.Canary,
+			&i.CanaryWeight,
 		); err != nil {
 			return nil, err
 		}
@@ -749,7 +1216,8 @@ SELECT d.min_replicas,
        r.endpoint,
        r.labels AS runner_labels
 FROM deployments d
-         LEFT JOIN runners r on d.id = r.deployment_id
+         LEFT JOIN runner_deployments rd on d.id = rd.deployment_id
+         LEFT JOIN runners r on rd.runner_id = r.id
 WHERE d.min_replicas > 0
 ORDER BY d.name
 `
@@ -790,49 +1258,26 @@ func (q *Queries) GetProcessList(ctx context.Context) ([]GetProcessListRow, erro
 	return items, nil
 }
 
-const getRouteForRunner = `-- name: GetRouteForRunner :one
-SELECT endpoint, r.key AS runner_key, r.module_name, d.name deployment_name, r.state
-FROM runners r
-         LEFT JOIN deployments d on r.deployment_id = d.id
-WHERE r.key = $1
-`
-
-type GetRouteForRunnerRow struct {
-	Endpoint       string
-	RunnerKey      Key
-	ModuleName     optional.Option[string]
-	DeploymentName model.DeploymentName
-	State          RunnerState
-}
-
-// Retrieve routing information for a runner.
-func (q *Queries) GetRouteForRunner(ctx context.Context, key Key) (GetRouteForRunnerRow, error) {
-	row := q.db.QueryRow(ctx, getRouteForRunner, key)
-	var i GetRouteForRunnerRow
-	err := row.Scan(
-		&i.Endpoint,
-		&i.RunnerKey,
-		&i.ModuleName,
-		&i.DeploymentName,
-		&i.State,
-	)
-	return i, err
-}
-
 const getRoutingTable = `-- name: GetRoutingTable :many
-SELECT endpoint, r.key AS runner_key, r.module_name, d.name deployment_name
+SELECT endpoint, r.key AS runner_key, m.name AS module_name, d.name deployment_name, d.canary, d.canary_weight
 FROM runners r
-         LEFT JOIN deployments d on r.deployment_id = d.id
-WHERE state = 'assigned'
+         INNER JOIN runner_deployments rd on rd.runner_id = r.id
+         INNER JOIN deployments d on rd.deployment_id = d.id
+         INNER JOIN modules m on d.module_id = m.id
+WHERE r.state = 'assigned'
+  AND NOT r.unhealthy
+  AND rd.state = 'assigned'
   AND (COALESCE(cardinality($1::TEXT[]), 0) = 0
-    OR module_name = ANY ($1::TEXT[]))
+    OR m.name = ANY ($1::TEXT[]))
 `
 
 type GetRoutingTableRow struct {
 	Endpoint       string
 	RunnerKey      Key
-	ModuleName     optional.Option[string]
+	ModuleName     string
 	DeploymentName model.DeploymentName
+	Canary         bool
+	CanaryWeight   int32
 }
 
 func (q *Queries) GetRoutingTable(ctx context.Context, modules []string) ([]GetRoutingTableRow, error) {
@@ -849,6 +1294,8 @@ func (q *Queries) GetRoutingTable(ctx context.Context, modules []string) ([]GetR
 			&i.RunnerKey,
 			&i.ModuleName,
 			&i.DeploymentName,
+			&i.Canary,
+			&i.CanaryWeight,
 		); err != nil {
 			return nil, err
 		}
@@ -861,28 +1308,31 @@ func (q *Queries) GetRoutingTable(ctx context.Context, modules []string) ([]GetR
 }
 
 const getRunner = `-- name: GetRunner :one
-SELECT DISTINCT ON (r.key) r.key                                   AS runner_key,
-                           r.endpoint,
-                           r.state,
-                           r.labels,
-                           r.last_seen,
-                           r.module_name,
-                           COALESCE(CASE
-                                        WHEN r.deployment_id IS NOT NULL
-                                            THEN d.name END, NULL) AS deployment_name
+SELECT r.key                                                 AS runner_key,
+       r.endpoint,
+       r.state,
+       r.labels,
+       r.last_seen,
+       r.capacity,
+       r.unhealthy,
+       COALESCE(ARRAY_AGG(d.name ORDER BY d.name) FILTER (WHERE d.name IS NOT NULL),
+                '{}')::TEXT[]                                AS deployment_names
 FROM runners r
-         LEFT JOIN deployments d on d.id = r.deployment_id OR r.deployment_id IS NULL
+         LEFT JOIN runner_deployments rd on rd.runner_id = r.id AND rd.state = 'assigned'
+         LEFT JOIN deployments d on d.id = rd.deployment_id
 WHERE r.key = $1
+GROUP BY r.id
 `
 
 type GetRunnerRow struct {
-	RunnerKey      Key
-	Endpoint       string
-	State          RunnerState
-	Labels         []byte
-	LastSeen       time.Time
-	ModuleName     optional.Option[string]
-	DeploymentName optional.Option[string]
+	RunnerKey       Key
+	Endpoint        string
+	State           RunnerState
+	Labels          []byte
+	LastSeen        time.Time
+	Capacity        int32
+	Unhealthy       bool
+	DeploymentNames []string
 }
 
 func (q *Queries) GetRunner(ctx context.Context, key Key) (GetRunnerRow, error) {
@@ -894,12 +1344,27 @@ func (q *Queries) GetRunner(ctx context.Context, key Key) (GetRunnerRow, error)
 		&i.State,
 		&i.Labels,
 		&i.LastSeen,
-		&i.ModuleName,
-		&i.DeploymentName,
+		&i.Capacity,
+		&i.Unhealthy,
+		&i.DeploymentNames,
 	)
 	return i, err
 }
 
+const getRunnerDeploymentCount = `-- name: GetRunnerDeploymentCount :one
+SELECT COUNT(*)
+FROM runner_deployments
+WHERE runner_id = $1
+`
+
+// Count the deployments reserved by, assigned to or draining from a runner.
+func (q *Queries) GetRunnerDeploymentCount(ctx context.Context, runnerID int64) (int64, error) {
+	row := q.db.QueryRow(ctx, getRunnerDeploymentCount, runnerID)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
 const getRunnerState = `-- name: GetRunnerState :one
 SELECT state
 FROM runners
@@ -914,31 +1379,17 @@ func (q *Queries) GetRunnerState(ctx context.Context, key Key) (RunnerState, err
 }
 
 const getRunnersForDeployment = `-- name: GetRunnersForDeployment :many
-SELECT r.id, key, created, last_seen, reservation_timeout, state, endpoint, module_name, deployment_id, r.labels, d.id, created_at, module_id, name, schema, d.labels, min_replicas
+SELECT r.id, r.key, r.created, r.last_seen, r.state, r.endpoint, r.capacity, r.labels, r.unhealthy
 FROM runners r
-         INNER JOIN deployments d on r.deployment_id = d.id
-WHERE state = 'assigned'
+         INNER JOIN runner_deployments rd on rd.runner_id = r.id
+         INNER JOIN deployments d on rd.deployment_id = d.id
+WHERE r.state = 'assigned'
+  AND rd.state = 'assigned'
   AND d.name = $1
 `
 
 type GetRunnersForDeploymentRow struct {
-	ID                 int64
-	Key                Key
-	Created            time.Time
-	LastSeen           time.Time
-	ReservationTimeout NullTime
-	State              RunnerState
-	Endpoint           string
-	ModuleName         optional.Option[string]
-	DeploymentID       optional.Option[int64]
-	Labels             []byte
-	ID_2               int64
-	CreatedAt          time.Time
-	ModuleID           int64
-	Name               model.DeploymentName
-	Schema             *schema.Module
-	Labels_2           []byte
-	MinReplicas        int32
+	Runner Runner
 }
 
 func (q *Queries) GetRunnersForDeployment(ctx context.Context, name model.DeploymentName) ([]GetRunnersForDeploymentRow, error) {
@@ -951,23 +1402,15 @@ func (q *Queries) GetRunnersForDeployment(ctx context.Context, name model.Deploy
 	for rows.Next() {
 		var i GetRunnersForDeploymentRow
 		if err := rows.Scan(
-			&i.ID,
-			&i.Key,
-			&i.Created,
-			&i.LastSeen,
-			&i.ReservationTimeout,
-			&i.State,
-			&i.Endpoint,
-			&i.ModuleName,
-			&i.DeploymentID,
-			&i.Labels,
-			&i.ID_2,
-			&i.CreatedAt,
-			&i.ModuleID,
-			&i.Name,
-			&i.Schema,
-			&i.Labels_2,
-			&i.MinReplicas,
+			&i.Runner.ID,
+			&i.Runner.Key,
+			&i.Runner.Created,
+			&i.Runner.LastSeen,
+			&i.Runner.State,
+			&i.Runner.Endpoint,
+			&i.Runner.Capacity,
+			&i.Runner.Labels,
+			&i.Runner.Unhealthy,
 		); err != nil {
 			return nil, err
 		}
@@ -998,7 +1441,8 @@ VALUES ((SELECT id FROM deployments WHERE deployments.name = $1::TEXT),
                 'request', $9::JSONB,
                 'response', $10::JSONB,
                 'error', $11::TEXT,
-                'stack', $12::TEXT
+                'stack', $12::TEXT,
+                'rejected', $13::BOOLEAN
             ))
 `
 
@@ -1015,6 +1459,7 @@ type InsertCallEventParams struct {
 	Response       []byte
 	Error          optional.Option[string]
 	Stack          optional.Option[string]
+	Rejected       bool
 }
 
 func (q *Queries) InsertCallEvent(ctx context.Context, arg InsertCallEventParams) error {
@@ -1031,6 +1476,7 @@ func (q *Queries) InsertCallEvent(ctx context.Context, arg InsertCallEventParams
 		arg.Response,
 		arg.Error,
 		arg.Stack,
+		arg.Rejected,
 	)
 	return err
 }
@@ -1212,6 +1658,57 @@ func (q *Queries) KillStaleRunners(ctx context.Context, timeout time.Duration) (
 	return count, err
 }
 
+const lockRateLimitBucket = `-- name: LockRateLimitBucket :one
+INSERT INTO rate_limit_buckets (key, tokens, updated_at, full_at)
+VALUES ($1::VARCHAR, $2::FLOAT8, $3::TIMESTAMPTZ, $3::TIMESTAMPTZ)
+ON CONFLICT (key) DO UPDATE SET key = EXCLUDED.key
+RETURNING tokens, updated_at
+`
+
+type LockRateLimitBucketRow struct {
+	Tokens    float64
+	UpdatedAt time.Time
+}
+
+// Lock a rate limit token bucket for update, creating it with the given number
+// of tokens if it does not exist.
+func (q *Queries) LockRateLimitBucket(ctx context.Context, key string, tokens float64, now time.Time) (LockRateLimitBucketRow, error) {
+	row := q.db.QueryRow(ctx, lockRateLimitBucket, key, tokens, now)
+	var i LockRateLimitBucketRow
+	err := row.Scan(&i.Tokens, &i.UpdatedAt)
+	return i, err
+}
+
+const promoteCanaryDeployment = `-- name: PromoteCanaryDeployment :exec
+UPDATE deployments
+SET canary        = FALSE,
+    canary_weight = 0
+WHERE name = $1
+`
+
+func (q *Queries) PromoteCanaryDeployment(ctx context.Context, name model.DeploymentName) error {
+	_, err := q.db.Exec(ctx, promoteCanaryDeployment, name)
+	return err
+}
+
+const publishEventForTopic = `-- name: PublishEventForTopic :execrows
+INSERT INTO subscription_events (subscription_id, payload)
+SELECT s.id, $1::JSONB
+FROM topic_subscriptions s
+         INNER JOIN topics t ON s.topic_id = t.id
+WHERE t.module_name = $2::TEXT
+  AND t.name = $3::TEXT
+`
+
+// Enqueue an event for every subscription to a topic.
+func (q *Queries) PublishEventForTopic(ctx context.Context, payload []byte, moduleName string, name string) (int64, error) {
+	result, err := q.db.Exec(ctx, publishEventForTopic, payload, moduleName, name)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
 const replaceDeployment = `-- name: ReplaceDeployment :one
 WITH update_container AS (
     UPDATE deployments AS d
@@ -1233,42 +1730,77 @@ func (q *Queries) ReplaceDeployment(ctx context.Context, oldDeployment string, n
 }
 
 const reserveRunner = `-- name: ReserveRunner :one
-UPDATE runners
-SET state               = 'reserved',
-    reservation_timeout = $1::timestamptz,
+WITH deployment AS (
     -- If a deployment is not found, then the deployment ID is -1
-    -- and the update will fail due to a FK constraint.
-    deployment_id       = COALESCE((SELECT id
-                                    FROM deployments d
-                                    WHERE d.name = $2
-                                    LIMIT 1), -1)
-WHERE id = (SELECT id
-            FROM runners r
-            WHERE r.state = 'idle'
-              AND r.labels @> $3::jsonb
-            LIMIT 1 FOR UPDATE SKIP LOCKED)
-RETURNING runners.id, runners.key, runners.created, runners.last_seen, runners.reservation_timeout, runners.state, runners.endpoint, runners.module_name, runners.deployment_id, runners.labels
-`
-
-// Find an idle runner and reserve it for the given deployment.
-func (q *Queries) ReserveRunner(ctx context.Context, reservationTimeout time.Time, deploymentName model.DeploymentName, labels []byte) (Runner, error) {
-	row := q.db.QueryRow(ctx, reserveRunner, reservationTimeout, deploymentName, labels)
+    -- and the reservation will fail due to a FK constraint.
+    SELECT COALESCE((SELECT id
+                     FROM deployments d
+                     WHERE d.name = $1
+                     LIMIT 1), -1) AS id),
+     runner AS (
+         SELECT r.id
+         FROM runners r
+         WHERE r.state <> 'dead'
+           AND r.labels @> $2::jsonb
+           AND NOT EXISTS (SELECT 1
+                           FROM runner_deployments rd
+                           WHERE rd.runner_id = r.id
+                             AND rd.deployment_id = (SELECT id FROM deployment))
+           AND (SELECT COUNT(*) FROM runner_deployments rd WHERE rd.runner_id = r.id) < r.capacity
+         ORDER BY r.capacity - (SELECT COUNT(*) FROM runner_deployments rd WHERE rd.runner_id = r.id)
+         LIMIT 1 FOR UPDATE SKIP LOCKED),
+     reservation AS (
+         INSERT INTO runner_deployments (runner_id, deployment_id, state, reservation_timeout)
+             SELECT id, (SELECT id FROM deployment), 'reserved', $3::timestamptz
+             FROM runner
+             RETURNING runner_id)
+UPDATE runners
+SET state = CASE WHEN state = 'idle' THEN 'reserved'::runner_state ELSE state END
+WHERE id = (SELECT runner_id FROM reservation)
+RETURNING runners.id, runners.key, runners.created, runners.last_seen, runners.state, runners.endpoint, runners.capacity, runners.labels, runners.unhealthy
+`
+
+// Find a runner with spare capacity and reserve it for the given deployment.
+//
+// Runners with the least spare capacity are preferred so that deployments are
+// packed onto as few runners as possible. A runner is never reserved for a
+// deployment it already hosts.
+//
+// The capacity check is made against the snapshot taken before the runner is
+// locked, so it must be repeated with GetRunnerDeploymentCount once it is.
+func (q *Queries) ReserveRunner(ctx context.Context, deploymentName model.DeploymentName, labels []byte, reservationTimeout time.Time) (Runner, error) {
+	row := q.db.QueryRow(ctx, reserveRunner, deploymentName, labels, reservationTimeout)
 	var i Runner
 	err := row.Scan(
 		&i.ID,
 		&i.Key,
 		&i.Created,
 		&i.LastSeen,
-		&i.ReservationTimeout,
 		&i.State,
 		&i.Endpoint,
-		&i.ModuleName,
-		&i.DeploymentID,
+		&i.Capacity,
 		&i.Labels,
+		&i.Unhealthy,
 	)
 	return i, err
 }
 
+const setCanaryWeight = `-- name: SetCanaryWeight :execrows
+UPDATE deployments
+SET canary_weight = $1::INT
+WHERE name = $2
+  AND canary
+  AND min_replicas > 0
+`
+
+func (q *Queries) SetCanaryWeight(ctx context.Context, weight int32, name model.DeploymentName) (int64, error) {
+	result, err := q.db.Exec(ctx, setCanaryWeight, weight, name)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
 const setDeploymentDesiredReplicas = `-- name: SetDeploymentDesiredReplicas :exec
 UPDATE deployments
 SET min_replicas = $2
@@ -1281,6 +1813,119 @@ func (q *Queries) SetDeploymentDesiredReplicas(ctx context.Context, name model.D
 	return err
 }
 
+const setRunnerDeployments = `-- name: SetRunnerDeployments :many
+WITH assigned AS (SELECT id, name
+                  FROM deployments d
+                  WHERE d.name = ANY ($1::TEXT[])),
+     released AS (
+         DELETE FROM runner_deployments rd
+             WHERE rd.runner_id = $2::BIGINT
+                 AND rd.state IN ('assigned', 'draining')
+                 AND rd.deployment_id NOT IN (SELECT id FROM assigned)
+             RETURNING 1),
+     upserted AS (
+         INSERT
+             INTO runner_deployments (runner_id, deployment_id, state)
+                 SELECT $2::BIGINT, id, 'assigned'
+                 FROM assigned
+             ON CONFLICT (runner_id, deployment_id) DO UPDATE SET state               = CASE
+                                                                                            WHEN runner_deployments.state = 'draining'
+                                                                                                THEN 'draining'::runner_deployment_state
+                                                                                            ELSE 'assigned' END,
+                                                                  reservation_timeout = NULL
+             RETURNING deployment_id)
+SELECT a.name
+FROM assigned a
+         INNER JOIN upserted u ON u.deployment_id = a.id
+`
+
+// Replace the deployments assigned to a runner with the given deployments,
+// returning the names of those that exist.
+//
+// Reservations for deployments that are not yet assigned are left in place,
+// and deployments that are draining remain so until the runner drops them.
+func (q *Queries) SetRunnerDeployments(ctx context.Context, deploymentNames []string, runnerID int64) ([]model.DeploymentName, error) {
+	rows, err := q.db.Query(ctx, setRunnerDeployments, deploymentNames, runnerID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []model.DeploymentName
+	for rows.Next() {
+		var name model.DeploymentName
+		if err := rows.Scan(&name); err != nil {
+			return nil, err
+		}
+		items = append(items, name)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const startCanaryDeployment = `-- name: StartCanaryDeployment :exec
+UPDATE deployments
+SET min_replicas  = $1::INT,
+    canary        = TRUE,
+    canary_weight = $2::INT
+WHERE name = $3
+`
+
+func (q *Queries) StartCanaryDeployment(ctx context.Context, minReplicas int32, weight int32, name model.DeploymentName) error {
+	_, err := q.db.Exec(ctx, startCanaryDeployment, minReplicas, weight, name)
+	return err
+}
+
+const undrainRunnerDeployment = `-- name: UndrainRunnerDeployment :one
+WITH matches AS (
+    UPDATE runner_deployments rd
+        SET state = 'assigned'
+        FROM runners r, deployments d
+        WHERE rd.runner_id = r.id
+            AND rd.deployment_id = d.id
+            AND rd.state = 'draining'
+            AND r.key = $1
+            AND d.name = $2
+        RETURNING 1)
+SELECT COUNT(*)
+FROM matches
+`
+
+// Return a draining deployment on a runner to the routing table, after it
+// could not be terminated.
+func (q *Queries) UndrainRunnerDeployment(ctx context.Context, key Key, name model.DeploymentName) (int64, error) {
+	row := q.db.QueryRow(ctx, undrainRunnerDeployment, key, name)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const updateRateLimitBucket = `-- name: UpdateRateLimitBucket :exec
+UPDATE rate_limit_buckets
+SET tokens     = $1::FLOAT8,
+    updated_at = $2::TIMESTAMPTZ,
+    full_at    = $3::TIMESTAMPTZ
+WHERE key = $4::VARCHAR
+`
+
+type UpdateRateLimitBucketParams struct {
+	Tokens    float64
+	UpdatedAt time.Time
+	FullAt    time.Time
+	Key       string
+}
+
+func (q *Queries) UpdateRateLimitBucket(ctx context.Context, arg UpdateRateLimitBucketParams) error {
+	_, err := q.db.Exec(ctx, updateRateLimitBucket,
+		arg.Tokens,
+		arg.UpdatedAt,
+		arg.FullAt,
+		arg.Key,
+	)
+	return err
+}
+
 const upsertController = `-- name: UpsertController :one
 INSERT INTO controller (key, endpoint)
 VALUES ($1, $2)
@@ -1312,52 +1957,81 @@ func (q *Queries) UpsertModule(ctx context.Context, language string, name string
 }
 
 const upsertRunner = `-- name: UpsertRunner :one
-WITH deployment_rel AS (
-    SELECT CASE
-               WHEN $5::TEXT IS NULL
-                   THEN NULL
-               ELSE COALESCE((SELECT id
-                              FROM deployments d
-                              WHERE d.name = $5
-                              LIMIT 1), -1) END AS id)
 INSERT
-INTO runners (key, endpoint, state, labels, deployment_id, last_seen)
+INTO runners (key, endpoint, state, labels, capacity, unhealthy, last_seen)
 VALUES ($1,
         $2,
         $3,
         $4,
-        (SELECT id FROM deployment_rel),
+        $5,
+        $6,
         NOW() AT TIME ZONE 'utc')
-ON CONFLICT (key) DO UPDATE SET endpoint      = $2,
-                                state         = $3,
-                                labels        = $4,
-                                deployment_id = (SELECT id FROM deployment_rel),
-                                last_seen     = NOW() AT TIME ZONE 'utc'
-RETURNING deployment_id
+ON CONFLICT (key) DO UPDATE SET endpoint  = $2,
+                                state     = $3,
+                                labels    = $4,
+                                capacity  = $5,
+                                unhealthy = $6,
+                                last_seen = NOW() AT TIME ZONE 'utc'
+RETURNING id
 `
 
 type UpsertRunnerParams struct {
-	Key            Key
-	Endpoint       string
-	State          RunnerState
-	Labels         []byte
-	DeploymentName optional.Option[string]
+	Key       Key
+	Endpoint  string
+	State     RunnerState
+	Labels    []byte
+	Capacity  int32
+	Unhealthy bool
 }
 
-// Upsert a runner and return the deployment ID that it is assigned to, if any.
-// If the deployment name is null, then deployment_rel.id will be null,
-// otherwise we try to retrieve the deployments.id using the key. If
-// there is no corresponding deployment, then the deployment ID is -1
-// and the parent statement will fail due to a foreign key constraint.
-func (q *Queries) UpsertRunner(ctx context.Context, arg UpsertRunnerParams) (optional.Option[int64], error) {
+// Upsert a runner and return its ID.
+func (q *Queries) UpsertRunner(ctx context.Context, arg UpsertRunnerParams) (int64, error) {
 	row := q.db.QueryRow(ctx, upsertRunner,
 		arg.Key,
 		arg.Endpoint,
 		arg.State,
 		arg.Labels,
-		arg.DeploymentName,
+		arg.Capacity,
+		arg.Unhealthy,
+	)
+	var id int64
+	err := row.Scan(&id)
+	return id, err
+}
+
+const upsertSubscription = `-- name: UpsertSubscription :exec
+INSERT INTO topic_subscriptions (topic_id, module_name, name)
+VALUES ((SELECT id FROM topics WHERE topics.module_name = $1::TEXT AND topics.name = $2::TEXT),
+        $3::TEXT,
+        $4::TEXT)
+ON CONFLICT (module_name, name) DO UPDATE SET topic_id = excluded.topic_id
+`
+
+type UpsertSubscriptionParams struct {
+	TopicModule string
+	Topic       string
+	ModuleName  string
+	Name        string
+}
+
+// Create a subscription, or point an existing subscription at a new topic.
+func (q *Queries) UpsertSubscription(ctx context.Context, arg UpsertSubscriptionParams) error {
+	_, err := q.db.Exec(ctx, upsertSubscription,
+		arg.TopicModule,
+		arg.Topic,
+		arg.ModuleName,
+		arg.Name,
 	)
-	var deployment_id optional.Option[int64]
-	err := row.Scan(&deployment_id)
-	return deployment_id, err
+	return err
+}
+
+const upsertTopic = `-- name: UpsertTopic :exec
+INSERT INTO topics (module_name, name)
+VALUES ($1::TEXT, $2::TEXT)
+ON CONFLICT (module_name, name) DO NOTHING
+`
+
+func (q *Queries) UpsertTopic(ctx context.Context, moduleName string, name string) error {
+	_, err := q.db.Exec(ctx, upsertTopic, moduleName, name)
+	return err
 }
@@ -47,14 +47,23 @@ CREATE TABLE deployments
     "schema"     module_schema_pb  NOT NULL,
     -- Labels are used to match deployments to runners.
     "labels"     JSONB          NOT NULL DEFAULT '{}',
-    min_replicas INT            NOT NULL DEFAULT 0
+    min_replicas INT            NOT NULL DEFAULT 0,
+    -- Canary deployments receive a share of their module's traffic alongside
+    -- the module's stable deployment.
+    canary       BOOLEAN        NOT NULL DEFAULT FALSE,
+    -- Percentage of the module's traffic routed to a canary deployment.
+    canary_weight INT           NOT NULL DEFAULT 0 CHECK (canary_weight BETWEEN 0 AND 100),
+    -- Resource limits applied to each process of the deployment.
+    limits        JSONB         NOT NULL DEFAULT '{}'
 );
 
 CREATE UNIQUE INDEX deployments_name_idx ON deployments (name);
 CREATE INDEX deployments_module_id_idx ON deployments (module_id);
--- Only allow one deployment per module.
+-- Only allow one stable deployment and one canary deployment per module.
 CREATE UNIQUE INDEX deployments_unique_idx ON deployments (module_id)
-    WHERE min_replicas > 0;
+    WHERE min_replicas > 0 AND NOT canary;
+CREATE UNIQUE INDEX deployments_canary_unique_idx ON deployments (module_id)
+    WHERE min_replicas > 0 AND canary;
 
 CREATE TRIGGER deployments_notify_event
     AFTER INSERT OR UPDATE OR DELETE
@@ -86,11 +95,11 @@ CREATE TABLE deployment_artefacts
 CREATE INDEX deployment_artefacts_deployment_id_idx ON deployment_artefacts (deployment_id);
 
 CREATE TYPE runner_state AS ENUM (
-    -- The Runner is available to run deployments.
+    -- The Runner is not hosting any deployments.
     'idle',
-    -- The Runner is reserved but has not yet deployed.
+    -- The Runner is reserved for a deployment but has not yet deployed it.
     'reserved',
-    -- The Runner has been assigned a deployment.
+    -- The Runner is hosting at least one deployment.
     'assigned',
     -- The Runner is dead.
     'dead'
@@ -99,70 +108,49 @@ CREATE TYPE runner_state AS ENUM (
 -- Runners are processes that are available to run modules.
 CREATE TABLE runners
 (
-    id                  BIGINT       NOT NULL GENERATED BY DEFAULT AS IDENTITY PRIMARY KEY,
+    id        BIGINT       NOT NULL GENERATED BY DEFAULT AS IDENTITY PRIMARY KEY,
     -- Unique identifier for this runner, generated at startup.
-    key                 UUID UNIQUE  NOT NULL,
-    created             TIMESTAMPTZ  NOT NULL DEFAULT (NOW() AT TIME ZONE 'utc'),
-    last_seen           TIMESTAMPTZ  NOT NULL DEFAULT (NOW() AT TIME ZONE 'utc'),
-    -- If the runner is reserved, this is the time at which the reservation expires.
-    reservation_timeout TIMESTAMPTZ,
-    state               runner_state NOT NULL DEFAULT 'idle',
-    endpoint            VARCHAR      NOT NULL,
-    -- Some denormalisation for performance. Without this we need to do a two table join.
-    module_name         VARCHAR,
-    deployment_id       BIGINT       REFERENCES deployments (id) ON DELETE SET NULL,
-    labels              JSONB        NOT NULL DEFAULT '{}'
+    key       UUID UNIQUE  NOT NULL,
+    created   TIMESTAMPTZ  NOT NULL DEFAULT (NOW() AT TIME ZONE 'utc'),
+    last_seen TIMESTAMPTZ  NOT NULL DEFAULT (NOW() AT TIME ZONE 'utc'),
+    state     runner_state NOT NULL DEFAULT 'idle',
+    endpoint  VARCHAR      NOT NULL,
+    -- Maximum number of deployments the runner can host.
+    capacity  INT          NOT NULL DEFAULT 1,
+    labels    JSONB        NOT NULL DEFAULT '{}',
+    -- True if any of the runner's deployments are crash-looping. Unhealthy
+    -- runners are not routed to.
+    unhealthy BOOLEAN      NOT NULL DEFAULT FALSE
 );
 
--- Automatically update module_name when deployment_id is set or unset.
-CREATE OR REPLACE FUNCTION runners_update_module_name() RETURNS TRIGGER AS
-$$
-BEGIN
-    IF NEW.deployment_id IS NULL
-    THEN
-        NEW.module_name = NULL;
-    ELSE
-        SELECT m.name
-        INTO NEW.module_name
-        FROM modules m
-                 INNER JOIN deployments d on m.id = d.module_id
-        WHERE d.id = NEW.deployment_id;
-    END IF;
-    RETURN NEW;
-END;
-$$ LANGUAGE plpgsql;
-
-CREATE TRIGGER runners_update_module_name
-    BEFORE INSERT OR UPDATE
-    ON runners
-    FOR EACH ROW
-EXECUTE PROCEDURE runners_update_module_name();
-
--- Set a default reservation_timeout when a runner is reserved.
-CREATE OR REPLACE FUNCTION runners_set_reservation_timeout() RETURNS TRIGGER AS
-$$
-BEGIN
-    IF OLD.state != 'reserved' AND NEW.state = 'reserved' AND NEW.reservation_timeout IS NULL
-    THEN
-        NEW.reservation_timeout = NOW() AT TIME ZONE 'utc' + INTERVAL '2 minutes';
-    END IF;
-    RETURN NEW;
-END;
-$$ LANGUAGE plpgsql;
-
-CREATE TRIGGER runners_set_reservation_timeout
-    BEFORE INSERT OR UPDATE
-    ON runners
-    FOR EACH ROW
-EXECUTE PROCEDURE runners_set_reservation_timeout();
-
 CREATE UNIQUE INDEX runners_key ON runners (key);
 CREATE UNIQUE INDEX runners_endpoint_not_dead_idx ON runners (endpoint) WHERE state <> 'dead';
-CREATE INDEX runners_module_name_idx ON runners (module_name);
 CREATE INDEX runners_state_idx ON runners (state);
-CREATE INDEX runners_deployment_id_idx ON runners (deployment_id);
 CREATE INDEX runners_labels_idx ON runners USING GIN (labels);
 
+CREATE TYPE runner_deployment_state AS ENUM (
+    -- The deployment is reserved on the runner but has not yet been deployed.
+    'reserved',
+    -- The deployment is running on the runner.
+    'assigned',
+    -- The deployment is running on the runner but is no longer routed to
+    -- while its in-flight calls complete.
+    'draining'
+    );
+
+-- Deployments hosted by, or reserved on, each runner.
+CREATE TABLE runner_deployments
+(
+    runner_id           BIGINT                  NOT NULL REFERENCES runners (id) ON DELETE CASCADE,
+    deployment_id       BIGINT                  NOT NULL REFERENCES deployments (id) ON DELETE CASCADE,
+    state               runner_deployment_state NOT NULL DEFAULT 'reserved',
+    -- If the deployment is reserved, this is the time at which the reservation expires.
+    reservation_timeout TIMESTAMPTZ,
+    PRIMARY KEY (runner_id, deployment_id)
+);
+
+CREATE INDEX runner_deployments_deployment_id_idx ON runner_deployments (deployment_id);
+
 CREATE TABLE ingress_routes
 (
     method        VARCHAR NOT NULL,
@@ -176,9 +164,81 @@ CREATE TABLE ingress_routes
 
 CREATE INDEX ingress_routes_method_path_idx ON ingress_routes (method, path);
 
+CREATE TABLE cron_jobs
+(
+    id             BIGINT      NOT NULL GENERATED BY DEFAULT AS IDENTITY PRIMARY KEY,
+    -- The deployment that the cron job belongs to.
+    deployment_id  BIGINT      NOT NULL REFERENCES deployments (id) ON DELETE CASCADE,
+    -- Duplicated here to avoid having to join from this to deployments then modules.
+    module_name    VARCHAR     NOT NULL,
+    verb           VARCHAR     NOT NULL,
+    -- Cron expression, eg. "*/5 * * * *".
+    schedule       VARCHAR     NOT NULL,
+    -- The next time the job should execute. Controllers claim an execution by
+    -- atomically advancing this to the following scheduled time.
+    next_execution TIMESTAMPTZ NOT NULL
+);
+
+CREATE UNIQUE INDEX cron_jobs_deployment_verb_idx ON cron_jobs (deployment_id, verb);
+CREATE INDEX cron_jobs_next_execution_idx ON cron_jobs (next_execution);
+
+CREATE TABLE topics
+(
+    id          BIGINT      NOT NULL GENERATED BY DEFAULT AS IDENTITY PRIMARY KEY,
+    created_at  TIMESTAMPTZ NOT NULL DEFAULT (NOW() AT TIME ZONE 'utc'),
+    -- The module that declares the topic. Topics outlive deployments so that
+    -- undelivered events survive redeploys.
+    module_name VARCHAR     NOT NULL,
+    name        VARCHAR     NOT NULL
+);
+
+CREATE UNIQUE INDEX topics_module_name_idx ON topics (module_name, name);
+
+CREATE TABLE topic_subscriptions
+(
+    id          BIGINT      NOT NULL GENERATED BY DEFAULT AS IDENTITY PRIMARY KEY,
+    created_at  TIMESTAMPTZ NOT NULL DEFAULT (NOW() AT TIME ZONE 'utc'),
+    topic_id    BIGINT      NOT NULL REFERENCES topics (id) ON DELETE CASCADE,
+    -- The module that declares the subscription.
+    module_name VARCHAR     NOT NULL,
+    name        VARCHAR     NOT NULL
+);
+
+CREATE UNIQUE INDEX topic_subscriptions_module_name_idx ON topic_subscriptions (module_name, name);
+CREATE INDEX topic_subscriptions_topic_id_idx ON topic_subscriptions (topic_id);
+
+CREATE TYPE subscription_event_state AS ENUM (
+    -- The event is waiting to be delivered.
+    'pending',
+    -- Delivery of the event failed too many times and it will not be retried.
+    'dead_letter'
+    );
+
+-- A durable queue of events for each subscription. Events are copied into the
+-- queue of every subscription to the topic when they are published, and are
+-- deleted once they have been delivered.
+CREATE TABLE subscription_events
+(
+    id              BIGINT                   NOT NULL GENERATED BY DEFAULT AS IDENTITY PRIMARY KEY,
+    created_at      TIMESTAMPTZ              NOT NULL DEFAULT (NOW() AT TIME ZONE 'utc'),
+    subscription_id BIGINT                   NOT NULL REFERENCES topic_subscriptions (id) ON DELETE CASCADE,
+    state           subscription_event_state NOT NULL DEFAULT 'pending',
+    -- Number of delivery attempts so far.
+    attempts        INT                      NOT NULL DEFAULT 0,
+    -- The earliest time the next delivery attempt can be made. Controllers
+    -- claim an event by pushing this into the future.
+    next_attempt    TIMESTAMPTZ              NOT NULL DEFAULT (NOW() AT TIME ZONE 'utc'),
+    -- The error from the most recent failed delivery attempt.
+    last_error      VARCHAR,
+    payload         JSONB                    NOT NULL
+);
+
+CREATE INDEX subscription_events_subscription_id_idx ON subscription_events (subscription_id);
+CREATE INDEX subscription_events_next_attempt_idx ON subscription_events (next_attempt)
+    WHERE state = 'pending';
+
 CREATE TYPE origin AS ENUM (
     'ingress',
-    -- Not supported yet.
     'cron',
     'pubsub'
     );
@@ -218,6 +278,20 @@ CREATE TABLE controller
 
 CREATE UNIQUE INDEX controller_endpoint_not_dead_idx ON controller (endpoint) WHERE state <> 'dead';
 
+-- Rate limit token buckets, shared by all controllers.
+CREATE TABLE rate_limit_buckets
+(
+    -- SHA256 of the limit, what it applies to and the client it is keyed by.
+    key        VARCHAR PRIMARY KEY,
+    tokens     DOUBLE PRECISION NOT NULL,
+    updated_at TIMESTAMPTZ      NOT NULL,
+    -- The time at which the bucket will have refilled, after which it is
+    -- equivalent to a new bucket.
+    full_at    TIMESTAMPTZ      NOT NULL
+);
+
+CREATE INDEX rate_limit_buckets_full_at_idx ON rate_limit_buckets (full_at);
+
 CREATE TYPE event_type AS ENUM (
     'call',
     'log',
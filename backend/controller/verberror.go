@@ -0,0 +1,42 @@
+package controller
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"connectrpc.com/connect"
+
+	ftlv1 "github.com/TBD54566975/ftl/backend/protos/xyz/block/ftl/v1"
+)
+
+// verbErrorResponse is the JSON body of an ingress response for a typed error
+// returned by a Verb.
+type verbErrorResponse struct {
+	Code    string          `json:"code"`
+	Message string          `json:"message"`
+	Details json.RawMessage `json:"details,omitempty"`
+}
+
+// writeVerbError writes an error returned by an ingress Verb as the HTTP
+// response.
+//
+// Typed errors are written as JSON with a status derived from their code,
+// while all other errors are internal server errors.
+func writeVerbError(w http.ResponseWriter, callErr *ftlv1.CallResponse_Error) {
+	if callErr.Code == nil {
+		http.Error(w, callErr.Message, http.StatusInternalServerError)
+		return
+	}
+	var code connect.Code
+	if err := code.UnmarshalText([]byte(*callErr.Code)); err != nil {
+		code = connect.CodeUnknown
+	}
+	body, err := json.Marshal(verbErrorResponse{Code: *callErr.Code, Message: callErr.Message, Details: callErr.Details})
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(connectCodeToHTTP(code))
+	_, _ = w.Write(body)
+}
@@ -0,0 +1,48 @@
+package controller
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/alecthomas/assert/v2"
+
+	ftlv1 "github.com/TBD54566975/ftl/backend/protos/xyz/block/ftl/v1"
+)
+
+func TestWriteVerbError(t *testing.T) {
+	notFound := "not_found"
+	invalid := "invalid"
+	tests := []struct {
+		name        string
+		err         *ftlv1.CallResponse_Error
+		status      int
+		contentType string
+		body        string
+	}{
+		{name: "Untyped",
+			err:         &ftlv1.CallResponse_Error{Message: "boom"},
+			status:      http.StatusInternalServerError,
+			contentType: "text/plain; charset=utf-8",
+			body:        "boom\n"},
+		{name: "Typed",
+			err:         &ftlv1.CallResponse_Error{Message: "no such user", Code: &notFound, Details: []byte(`{"id":"123"}`)},
+			status:      http.StatusNotFound,
+			contentType: "application/json; charset=utf-8",
+			body:        `{"code":"not_found","message":"no such user","details":{"id":"123"}}`},
+		{name: "UnknownCode",
+			err:         &ftlv1.CallResponse_Error{Message: "huh", Code: &invalid},
+			status:      http.StatusInternalServerError,
+			contentType: "application/json; charset=utf-8",
+			body:        `{"code":"invalid","message":"huh"}`},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			writeVerbError(w, test.err)
+			assert.Equal(t, test.status, w.Code)
+			assert.Equal(t, test.contentType, w.Header().Get("Content-Type"))
+			assert.Equal(t, test.body, w.Body.String())
+		})
+	}
+}
@@ -41,6 +41,8 @@ const (
 	VerbServicePingProcedure = "/xyz.block.ftl.v1.VerbService/Ping"
 	// VerbServiceCallProcedure is the fully-qualified name of the VerbService's Call RPC.
 	VerbServiceCallProcedure = "/xyz.block.ftl.v1.VerbService/Call"
+	// VerbServiceCallStreamProcedure is the fully-qualified name of the VerbService's CallStream RPC.
+	VerbServiceCallStreamProcedure = "/xyz.block.ftl.v1.VerbService/CallStream"
 	// ControllerServicePingProcedure is the fully-qualified name of the ControllerService's Ping RPC.
 	ControllerServicePingProcedure = "/xyz.block.ftl.v1.ControllerService/Ping"
 	// ControllerServiceProcessListProcedure is the fully-qualified name of the ControllerService's
@@ -73,6 +75,18 @@ const (
 	// ControllerServiceReplaceDeployProcedure is the fully-qualified name of the ControllerService's
 	// ReplaceDeploy RPC.
 	ControllerServiceReplaceDeployProcedure = "/xyz.block.ftl.v1.ControllerService/ReplaceDeploy"
+	// ControllerServiceCanaryDeployProcedure is the fully-qualified name of the ControllerService's
+	// CanaryDeploy RPC.
+	ControllerServiceCanaryDeployProcedure = "/xyz.block.ftl.v1.ControllerService/CanaryDeploy"
+	// ControllerServiceSetCanaryWeightProcedure is the fully-qualified name of the ControllerService's
+	// SetCanaryWeight RPC.
+	ControllerServiceSetCanaryWeightProcedure = "/xyz.block.ftl.v1.ControllerService/SetCanaryWeight"
+	// ControllerServiceRollbackProcedure is the fully-qualified name of the ControllerService's
+	// Rollback RPC.
+	ControllerServiceRollbackProcedure = "/xyz.block.ftl.v1.ControllerService/Rollback"
+	// ControllerServiceGetDeploymentHistoryProcedure is the fully-qualified name of the
+	// ControllerService's GetDeploymentHistory RPC.
+	ControllerServiceGetDeploymentHistoryProcedure = "/xyz.block.ftl.v1.ControllerService/GetDeploymentHistory"
 	// ControllerServiceStreamDeploymentLogsProcedure is the fully-qualified name of the
 	// ControllerService's StreamDeploymentLogs RPC.
 	ControllerServiceStreamDeploymentLogsProcedure = "/xyz.block.ftl.v1.ControllerService/StreamDeploymentLogs"
@@ -82,6 +96,9 @@ const (
 	// ControllerServicePullSchemaProcedure is the fully-qualified name of the ControllerService's
 	// PullSchema RPC.
 	ControllerServicePullSchemaProcedure = "/xyz.block.ftl.v1.ControllerService/PullSchema"
+	// ControllerServicePublishEventProcedure is the fully-qualified name of the ControllerService's
+	// PublishEvent RPC.
+	ControllerServicePublishEventProcedure = "/xyz.block.ftl.v1.ControllerService/PublishEvent"
 	// RunnerServicePingProcedure is the fully-qualified name of the RunnerService's Ping RPC.
 	RunnerServicePingProcedure = "/xyz.block.ftl.v1.RunnerService/Ping"
 	// RunnerServiceReserveProcedure is the fully-qualified name of the RunnerService's Reserve RPC.
@@ -98,6 +115,11 @@ type VerbServiceClient interface {
 	Ping(context.Context, *connect.Request[v1.PingRequest]) (*connect.Response[v1.PingResponse], error)
 	// Issue a synchronous call to a Verb.
 	Call(context.Context, *connect.Request[v1.CallRequest]) (*connect.Response[v1.CallResponse], error)
+	// Issue a call to a streaming Verb, receiving each message it sends.
+	//
+	// A stream ends either when the Verb returns, or with a final error
+	// response.
+	CallStream(context.Context, *connect.Request[v1.CallRequest]) (*connect.ServerStreamForClient[v1.CallResponse], error)
 }
 
 // NewVerbServiceClient constructs a client for the xyz.block.ftl.v1.VerbService service. By
@@ -121,13 +143,19 @@ func NewVerbServiceClient(httpClient connect.HTTPClient, baseURL string, opts ..
 			baseURL+VerbServiceCallProcedure,
 			opts...,
 		),
+		callStream: connect.NewClient[v1.CallRequest, v1.CallResponse](
+			httpClient,
+			baseURL+VerbServiceCallStreamProcedure,
+			opts...,
+		),
 	}
 }
 
 // verbServiceClient implements VerbServiceClient.
 type verbServiceClient struct {
-	ping *connect.Client[v1.PingRequest, v1.PingResponse]
-	call *connect.Client[v1.CallRequest, v1.CallResponse]
+	ping       *connect.Client[v1.PingRequest, v1.PingResponse]
+	call       *connect.Client[v1.CallRequest, v1.CallResponse]
+	callStream *connect.Client[v1.CallRequest, v1.CallResponse]
 }
 
 // Ping calls xyz.block.ftl.v1.VerbService.Ping.
@@ -140,12 +168,22 @@ func (c *verbServiceClient) Call(ctx context.Context, req *connect.Request[v1.Ca
 	return c.call.CallUnary(ctx, req)
 }
 
+// CallStream calls xyz.block.ftl.v1.VerbService.CallStream.
+func (c *verbServiceClient) CallStream(ctx context.Context, req *connect.Request[v1.CallRequest]) (*connect.ServerStreamForClient[v1.CallResponse], error) {
+	return c.callStream.CallServerStream(ctx, req)
+}
+
 // VerbServiceHandler is an implementation of the xyz.block.ftl.v1.VerbService service.
 type VerbServiceHandler interface {
 	// Ping service for readiness.
 	Ping(context.Context, *connect.Request[v1.PingRequest]) (*connect.Response[v1.PingResponse], error)
 	// Issue a synchronous call to a Verb.
 	Call(context.Context, *connect.Request[v1.CallRequest]) (*connect.Response[v1.CallResponse], error)
+	// Issue a call to a streaming Verb, receiving each message it sends.
+	//
+	// A stream ends either when the Verb returns, or with a final error
+	// response.
+	CallStream(context.Context, *connect.Request[v1.CallRequest], *connect.ServerStream[v1.CallResponse]) error
 }
 
 // NewVerbServiceHandler builds an HTTP handler from the service implementation. It returns the path
@@ -165,12 +203,19 @@ func NewVerbServiceHandler(svc VerbServiceHandler, opts ...connect.HandlerOption
 		svc.Call,
 		opts...,
 	)
+	verbServiceCallStreamHandler := connect.NewServerStreamHandler(
+		VerbServiceCallStreamProcedure,
+		svc.CallStream,
+		opts...,
+	)
 	return "/xyz.block.ftl.v1.VerbService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case VerbServicePingProcedure:
 			verbServicePingHandler.ServeHTTP(w, r)
 		case VerbServiceCallProcedure:
 			verbServiceCallHandler.ServeHTTP(w, r)
+		case VerbServiceCallStreamProcedure:
+			verbServiceCallStreamHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -188,6 +233,10 @@ func (UnimplementedVerbServiceHandler) Call(context.Context, *connect.Request[v1
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("xyz.block.ftl.v1.VerbService.Call is not implemented"))
 }
 
+func (UnimplementedVerbServiceHandler) CallStream(context.Context, *connect.Request[v1.CallRequest], *connect.ServerStream[v1.CallResponse]) error {
+	return connect.NewError(connect.CodeUnimplemented, errors.New("xyz.block.ftl.v1.VerbService.CallStream is not implemented"))
+}
+
 // ControllerServiceClient is a client for the xyz.block.ftl.v1.ControllerService service.
 type ControllerServiceClient interface {
 	// Ping service for readiness.
@@ -220,6 +269,20 @@ type ControllerServiceClient interface {
 	// If a deployment already exists for the module of the new deployment,
 	// it will be scaled down and replaced by the new one.
 	ReplaceDeploy(context.Context, *connect.Request[v1.ReplaceDeployRequest]) (*connect.Response[v1.ReplaceDeployResponse], error)
+	// Deploy a new deployment alongside the existing deployment of its module.
+	//
+	// The new deployment receives a weighted share of the module's traffic, and
+	// is rolled back automatically if its error rate is too high.
+	CanaryDeploy(context.Context, *connect.Request[v1.CanaryDeployRequest]) (*connect.Response[v1.CanaryDeployResponse], error)
+	// Change the share of its module's traffic routed to a canary deployment.
+	//
+	// A weight of 100 promotes the canary, replacing the existing deployment.
+	SetCanaryWeight(context.Context, *connect.Request[v1.SetCanaryWeightRequest]) (*connect.Response[v1.SetCanaryWeightResponse], error)
+	// Replace the active deployment of a module with one of its previous
+	// deployments.
+	Rollback(context.Context, *connect.Request[v1.RollbackRequest]) (*connect.Response[v1.RollbackResponse], error)
+	// List the current and previous deployments of a module.
+	GetDeploymentHistory(context.Context, *connect.Request[v1.GetDeploymentHistoryRequest]) (*connect.Response[v1.GetDeploymentHistoryResponse], error)
 	// Stream logs from a deployment
 	StreamDeploymentLogs(context.Context) *connect.ClientStreamForClient[v1.StreamDeploymentLogsRequest, v1.StreamDeploymentLogsResponse]
 	// Get the full schema.
@@ -229,6 +292,10 @@ type ControllerServiceClient interface {
 	// Note that if there are no deployments this will block indefinitely, making it unsuitable for
 	// just retrieving the schema. Use GetSchema for that.
 	PullSchema(context.Context, *connect.Request[v1.PullSchemaRequest]) (*connect.ServerStreamForClient[v1.PullSchemaResponse], error)
+	// Publish an event to a topic.
+	//
+	// The event is durably enqueued for each subscription to the topic.
+	PublishEvent(context.Context, *connect.Request[v1.PublishEventRequest]) (*connect.Response[v1.PublishEventResponse], error)
 }
 
 // NewControllerServiceClient constructs a client for the xyz.block.ftl.v1.ControllerService
@@ -297,6 +364,26 @@ func NewControllerServiceClient(httpClient connect.HTTPClient, baseURL string, o
 			baseURL+ControllerServiceReplaceDeployProcedure,
 			opts...,
 		),
+		canaryDeploy: connect.NewClient[v1.CanaryDeployRequest, v1.CanaryDeployResponse](
+			httpClient,
+			baseURL+ControllerServiceCanaryDeployProcedure,
+			opts...,
+		),
+		setCanaryWeight: connect.NewClient[v1.SetCanaryWeightRequest, v1.SetCanaryWeightResponse](
+			httpClient,
+			baseURL+ControllerServiceSetCanaryWeightProcedure,
+			opts...,
+		),
+		rollback: connect.NewClient[v1.RollbackRequest, v1.RollbackResponse](
+			httpClient,
+			baseURL+ControllerServiceRollbackProcedure,
+			opts...,
+		),
+		getDeploymentHistory: connect.NewClient[v1.GetDeploymentHistoryRequest, v1.GetDeploymentHistoryResponse](
+			httpClient,
+			baseURL+ControllerServiceGetDeploymentHistoryProcedure,
+			opts...,
+		),
 		streamDeploymentLogs: connect.NewClient[v1.StreamDeploymentLogsRequest, v1.StreamDeploymentLogsResponse](
 			httpClient,
 			baseURL+ControllerServiceStreamDeploymentLogsProcedure,
@@ -312,6 +399,11 @@ func NewControllerServiceClient(httpClient connect.HTTPClient, baseURL string, o
 			baseURL+ControllerServicePullSchemaProcedure,
 			opts...,
 		),
+		publishEvent: connect.NewClient[v1.PublishEventRequest, v1.PublishEventResponse](
+			httpClient,
+			baseURL+ControllerServicePublishEventProcedure,
+			opts...,
+		),
 	}
 }
 
@@ -328,9 +420,14 @@ type controllerServiceClient struct {
 	registerRunner         *connect.Client[v1.RegisterRunnerRequest, v1.RegisterRunnerResponse]
 	updateDeploy           *connect.Client[v1.UpdateDeployRequest, v1.UpdateDeployResponse]
 	replaceDeploy          *connect.Client[v1.ReplaceDeployRequest, v1.ReplaceDeployResponse]
+	canaryDeploy           *connect.Client[v1.CanaryDeployRequest, v1.CanaryDeployResponse]
+	setCanaryWeight        *connect.Client[v1.SetCanaryWeightRequest, v1.SetCanaryWeightResponse]
+	rollback               *connect.Client[v1.RollbackRequest, v1.RollbackResponse]
+	getDeploymentHistory   *connect.Client[v1.GetDeploymentHistoryRequest, v1.GetDeploymentHistoryResponse]
 	streamDeploymentLogs   *connect.Client[v1.StreamDeploymentLogsRequest, v1.StreamDeploymentLogsResponse]
 	getSchema              *connect.Client[v1.GetSchemaRequest, v1.GetSchemaResponse]
 	pullSchema             *connect.Client[v1.PullSchemaRequest, v1.PullSchemaResponse]
+	publishEvent           *connect.Client[v1.PublishEventRequest, v1.PublishEventResponse]
 }
 
 // Ping calls xyz.block.ftl.v1.ControllerService.Ping.
@@ -388,6 +485,26 @@ func (c *controllerServiceClient) ReplaceDeploy(ctx context.Context, req *connec
 	return c.replaceDeploy.CallUnary(ctx, req)
 }
 
+// CanaryDeploy calls xyz.block.ftl.v1.ControllerService.CanaryDeploy.
+func (c *controllerServiceClient) CanaryDeploy(ctx context.Context, req *connect.Request[v1.CanaryDeployRequest]) (*connect.Response[v1.CanaryDeployResponse], error) {
+	return c.canaryDeploy.CallUnary(ctx, req)
+}
+
+// SetCanaryWeight calls xyz.block.ftl.v1.ControllerService.SetCanaryWeight.
+func (c *controllerServiceClient) SetCanaryWeight(ctx context.Context, req *connect.Request[v1.SetCanaryWeightRequest]) (*connect.Response[v1.SetCanaryWeightResponse], error) {
+	return c.setCanaryWeight.CallUnary(ctx, req)
+}
+
+// Rollback calls xyz.block.ftl.v1.ControllerService.Rollback.
+func (c *controllerServiceClient) Rollback(ctx context.Context, req *connect.Request[v1.RollbackRequest]) (*connect.Response[v1.RollbackResponse], error) {
+	return c.rollback.CallUnary(ctx, req)
+}
+
+// GetDeploymentHistory calls xyz.block.ftl.v1.ControllerService.GetDeploymentHistory.
+func (c *controllerServiceClient) GetDeploymentHistory(ctx context.Context, req *connect.Request[v1.GetDeploymentHistoryRequest]) (*connect.Response[v1.GetDeploymentHistoryResponse], error) {
+	return c.getDeploymentHistory.CallUnary(ctx, req)
+}
+
 // StreamDeploymentLogs calls xyz.block.ftl.v1.ControllerService.StreamDeploymentLogs.
 func (c *controllerServiceClient) StreamDeploymentLogs(ctx context.Context) *connect.ClientStreamForClient[v1.StreamDeploymentLogsRequest, v1.StreamDeploymentLogsResponse] {
 	return c.streamDeploymentLogs.CallClientStream(ctx)
@@ -403,6 +520,11 @@ func (c *controllerServiceClient) PullSchema(ctx context.Context, req *connect.R
 	return c.pullSchema.CallServerStream(ctx, req)
 }
 
+// PublishEvent calls xyz.block.ftl.v1.ControllerService.PublishEvent.
+func (c *controllerServiceClient) PublishEvent(ctx context.Context, req *connect.Request[v1.PublishEventRequest]) (*connect.Response[v1.PublishEventResponse], error) {
+	return c.publishEvent.CallUnary(ctx, req)
+}
+
 // ControllerServiceHandler is an implementation of the xyz.block.ftl.v1.ControllerService service.
 type ControllerServiceHandler interface {
 	// Ping service for readiness.
@@ -435,6 +557,20 @@ type ControllerServiceHandler interface {
 	// If a deployment already exists for the module of the new deployment,
 	// it will be scaled down and replaced by the new one.
 	ReplaceDeploy(context.Context, *connect.Request[v1.ReplaceDeployRequest]) (*connect.Response[v1.ReplaceDeployResponse], error)
+	// Deploy a new deployment alongside the existing deployment of its module.
+	//
+	// The new deployment receives a weighted share of the module's traffic, and
+	// is rolled back automatically if its error rate is too high.
+	CanaryDeploy(context.Context, *connect.Request[v1.CanaryDeployRequest]) (*connect.Response[v1.CanaryDeployResponse], error)
+	// Change the share of its module's traffic routed to a canary deployment.
+	//
+	// A weight of 100 promotes the canary, replacing the existing deployment.
+	SetCanaryWeight(context.Context, *connect.Request[v1.SetCanaryWeightRequest]) (*connect.Response[v1.SetCanaryWeightResponse], error)
+	// Replace the active deployment of a module with one of its previous
+	// deployments.
+	Rollback(context.Context, *connect.Request[v1.RollbackRequest]) (*connect.Response[v1.RollbackResponse], error)
+	// List the current and previous deployments of a module.
+	GetDeploymentHistory(context.Context, *connect.Request[v1.GetDeploymentHistoryRequest]) (*connect.Response[v1.GetDeploymentHistoryResponse], error)
 	// Stream logs from a deployment
 	StreamDeploymentLogs(context.Context, *connect.ClientStream[v1.StreamDeploymentLogsRequest]) (*connect.Response[v1.StreamDeploymentLogsResponse], error)
 	// Get the full schema.
@@ -444,6 +580,10 @@ type ControllerServiceHandler interface {
 	// Note that if there are no deployments this will block indefinitely, making it unsuitable for
 	// just retrieving the schema. Use GetSchema for that.
 	PullSchema(context.Context, *connect.Request[v1.PullSchemaRequest], *connect.ServerStream[v1.PullSchemaResponse]) error
+	// Publish an event to a topic.
+	//
+	// The event is durably enqueued for each subscription to the topic.
+	PublishEvent(context.Context, *connect.Request[v1.PublishEventRequest]) (*connect.Response[v1.PublishEventResponse], error)
 }
 
 // NewControllerServiceHandler builds an HTTP handler from the service implementation. It returns
@@ -508,6 +648,26 @@ func NewControllerServiceHandler(svc ControllerServiceHandler, opts ...connect.H
 		svc.ReplaceDeploy,
 		opts...,
 	)
+	controllerServiceCanaryDeployHandler := connect.NewUnaryHandler(
+		ControllerServiceCanaryDeployProcedure,
+		svc.CanaryDeploy,
+		opts...,
+	)
+	controllerServiceSetCanaryWeightHandler := connect.NewUnaryHandler(
+		ControllerServiceSetCanaryWeightProcedure,
+		svc.SetCanaryWeight,
+		opts...,
+	)
+	controllerServiceRollbackHandler := connect.NewUnaryHandler(
+		ControllerServiceRollbackProcedure,
+		svc.Rollback,
+		opts...,
+	)
+	controllerServiceGetDeploymentHistoryHandler := connect.NewUnaryHandler(
+		ControllerServiceGetDeploymentHistoryProcedure,
+		svc.GetDeploymentHistory,
+		opts...,
+	)
 	controllerServiceStreamDeploymentLogsHandler := connect.NewClientStreamHandler(
 		ControllerServiceStreamDeploymentLogsProcedure,
 		svc.StreamDeploymentLogs,
@@ -523,6 +683,11 @@ func NewControllerServiceHandler(svc ControllerServiceHandler, opts ...connect.H
 		svc.PullSchema,
 		opts...,
 	)
+	controllerServicePublishEventHandler := connect.NewUnaryHandler(
+		ControllerServicePublishEventProcedure,
+		svc.PublishEvent,
+		opts...,
+	)
 	return "/xyz.block.ftl.v1.ControllerService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case ControllerServicePingProcedure:
@@ -547,12 +712,22 @@ func NewControllerServiceHandler(svc ControllerServiceHandler, opts ...connect.H
 			controllerServiceUpdateDeployHandler.ServeHTTP(w, r)
 		case ControllerServiceReplaceDeployProcedure:
 			controllerServiceReplaceDeployHandler.ServeHTTP(w, r)
+		case ControllerServiceCanaryDeployProcedure:
+			controllerServiceCanaryDeployHandler.ServeHTTP(w, r)
+		case ControllerServiceSetCanaryWeightProcedure:
+			controllerServiceSetCanaryWeightHandler.ServeHTTP(w, r)
+		case ControllerServiceRollbackProcedure:
+			controllerServiceRollbackHandler.ServeHTTP(w, r)
+		case ControllerServiceGetDeploymentHistoryProcedure:
+			controllerServiceGetDeploymentHistoryHandler.ServeHTTP(w, r)
 		case ControllerServiceStreamDeploymentLogsProcedure:
 			controllerServiceStreamDeploymentLogsHandler.ServeHTTP(w, r)
 		case ControllerServiceGetSchemaProcedure:
 			controllerServiceGetSchemaHandler.ServeHTTP(w, r)
 		case ControllerServicePullSchemaProcedure:
 			controllerServicePullSchemaHandler.ServeHTTP(w, r)
+		case ControllerServicePublishEventProcedure:
+			controllerServicePublishEventHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -606,6 +781,22 @@ func (UnimplementedControllerServiceHandler) ReplaceDeploy(context.Context, *con
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("xyz.block.ftl.v1.ControllerService.ReplaceDeploy is not implemented"))
 }
 
+func (UnimplementedControllerServiceHandler) CanaryDeploy(context.Context, *connect.Request[v1.CanaryDeployRequest]) (*connect.Response[v1.CanaryDeployResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("xyz.block.ftl.v1.ControllerService.CanaryDeploy is not implemented"))
+}
+
+func (UnimplementedControllerServiceHandler) SetCanaryWeight(context.Context, *connect.Request[v1.SetCanaryWeightRequest]) (*connect.Response[v1.SetCanaryWeightResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("xyz.block.ftl.v1.ControllerService.SetCanaryWeight is not implemented"))
+}
+
+func (UnimplementedControllerServiceHandler) Rollback(context.Context, *connect.Request[v1.RollbackRequest]) (*connect.Response[v1.RollbackResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("xyz.block.ftl.v1.ControllerService.Rollback is not implemented"))
+}
+
+func (UnimplementedControllerServiceHandler) GetDeploymentHistory(context.Context, *connect.Request[v1.GetDeploymentHistoryRequest]) (*connect.Response[v1.GetDeploymentHistoryResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("xyz.block.ftl.v1.ControllerService.GetDeploymentHistory is not implemented"))
+}
+
 func (UnimplementedControllerServiceHandler) StreamDeploymentLogs(context.Context, *connect.ClientStream[v1.StreamDeploymentLogsRequest]) (*connect.Response[v1.StreamDeploymentLogsResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("xyz.block.ftl.v1.ControllerService.StreamDeploymentLogs is not implemented"))
 }
@@ -618,6 +809,10 @@ func (UnimplementedControllerServiceHandler) PullSchema(context.Context, *connec
 	return connect.NewError(connect.CodeUnimplemented, errors.New("xyz.block.ftl.v1.ControllerService.PullSchema is not implemented"))
 }
 
+func (UnimplementedControllerServiceHandler) PublishEvent(context.Context, *connect.Request[v1.PublishEventRequest]) (*connect.Response[v1.PublishEventResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("xyz.block.ftl.v1.ControllerService.PublishEvent is not implemented"))
+}
+
 // RunnerServiceClient is a client for the xyz.block.ftl.v1.RunnerService service.
 type RunnerServiceClient interface {
 	Ping(context.Context, *connect.Request[v1.PingRequest]) (*connect.Response[v1.PingResponse], error)
@@ -17,6 +17,30 @@ func ArtefactToProto(artefact *model.Artefact) *DeploymentArtefact {
 	}
 }
 
+func LimitsToProto(limits model.DeploymentLimits) *DeploymentLimits {
+	return &DeploymentLimits{
+		Cpu:         limits.CPU,
+		MemoryBytes: limits.MemoryBytes,
+		OpenFiles:   limits.OpenFiles,
+	}
+}
+
+// ToModel converts the limits to their model representation, treating nil as
+// unlimited.
+func (l *DeploymentLimits) ToModel() (model.DeploymentLimits, error) {
+	if l == nil {
+		return model.DeploymentLimits{}, nil
+	}
+	if l.Cpu < 0 || l.MemoryBytes < 0 || l.OpenFiles < 0 {
+		return model.DeploymentLimits{}, fmt.Errorf("deployment limits must not be negative")
+	}
+	return model.DeploymentLimits{
+		CPU:         l.Cpu,
+		MemoryBytes: l.MemoryBytes,
+		OpenFiles:   l.OpenFiles,
+	}, nil
+}
+
 func (m *Metadata) Set(key, value string) {
 	out := make([]*Metadata_Pair, 0, len(m.Values))
 	for _, pair := range m.Values {
@@ -60,13 +84,15 @@ func (m *Metadata) Delete(key string) {
 	m.Values = out
 }
 
-func (r *RegisterRunnerRequest) DeploymentAsOptional() (optional.Option[model.DeploymentName], error) {
-	if r.Deployment == nil {
-		return optional.None[model.DeploymentName](), nil
-	}
-	key, err := model.ParseDeploymentName(*r.Deployment)
-	if err != nil {
-		return optional.None[model.DeploymentName](), fmt.Errorf("%s: %w", "invalid deployment key", err)
+// DeploymentNames parses the names of the deployments the Runner is hosting.
+func (r *RegisterRunnerRequest) DeploymentNames() ([]model.DeploymentName, error) {
+	out := make([]model.DeploymentName, 0, len(r.Deployments))
+	for _, deployment := range r.Deployments {
+		key, err := model.ParseDeploymentName(deployment)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", "invalid deployment key", err)
+		}
+		out = append(out, key)
 	}
-	return optional.Some(key), nil
+	return out, nil
 }
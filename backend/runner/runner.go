@@ -6,13 +6,15 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"maps"
 	"math/rand"
 	"net/url"
 	"os"
 	"path/filepath"
 	"runtime"
+	"sort"
+	"strings"
 	"sync"
-	"syscall"
 	"time"
 
 	"connectrpc.com/connect"
@@ -32,6 +34,7 @@ import (
 	"github.com/TBD54566975/ftl/internal/log"
 	"github.com/TBD54566975/ftl/internal/model"
 	"github.com/TBD54566975/ftl/internal/rpc"
+	"github.com/TBD54566975/ftl/internal/rpc/headers"
 	"github.com/TBD54566975/ftl/internal/slices"
 	"github.com/TBD54566975/ftl/internal/unstoppable"
 )
@@ -39,15 +42,29 @@ import (
 type Config struct {
 	Bind               *url.URL        `help:"Endpoint the Runner should bind to and advertise." default:"http://localhost:8893" env:"FTL_RUNNER_BIND"`
 	Advertise          *url.URL        `help:"Endpoint the Runner should advertise (use --bind if omitted)." default:"" env:"FTL_RUNNER_ADVERTISE"`
-	Key                model.RunnerKey `help:"Runner key (auto)." placeholder:"R<ULID>" default:"R00000000000000000000000000"`
+	Key                model.RunnerKey `help:"Runner key (auto)." placeholder:"R<ULID>" default:"R00000000000000000000000000" env:"FTL_RUNNER_KEY"`
 	ControllerEndpoint *url.URL        `name:"ftl-endpoint" help:"Controller endpoint." env:"FTL_ENDPOINT" default:"http://localhost:8892"`
 	TemplateDir        string          `help:"Template directory to copy into each deployment, if any." type:"existingdir"`
 	DeploymentDir      string          `help:"Directory to store deployments in." default:"${deploymentdir}"`
+	ArtefactCacheDir   string          `help:"Directory to cache downloaded artefacts in, shared by runners on the same host." default:"${artefactcachedir}"`
+	ArtefactCacheSize  int64           `help:"Maximum size of the artefact cache in bytes." default:"2147483648"`
 	Language           []string        `short:"l" help:"Languages the runner supports." env:"FTL_LANGUAGE" default:"go,kotlin"`
 	HeartbeatPeriod    time.Duration   `help:"Minimum period between heartbeats." default:"3s"`
 	HeartbeatJitter    time.Duration   `help:"Jitter to add to heartbeat period." default:"2s"`
+	RestartBackoffMin  time.Duration   `help:"Minimum delay before restarting a deployment process that has exited." default:"1s"`
+	RestartBackoffMax  time.Duration   `help:"Maximum delay before restarting a deployment process that has exited." default:"1m"`
+	CrashLoopThreshold int             `help:"Number of consecutive crashes after which a deployment is considered to be crash-looping." default:"3"`
+	CrashLoopPeriod    time.Duration   `help:"Deployment processes that exit within this period of starting are considered to have crashed." default:"1m"`
+	Capacity           int             `help:"Maximum number of deployments the Runner can host." default:"1" env:"FTL_RUNNER_CAPACITY"`
+	CgroupParent       string          `help:"cgroup v2 directory beneath which deployment processes are placed to enforce CPU and memory limits." env:"FTL_RUNNER_CGROUP_PARENT"`
+	DrainTimeout       time.Duration   `help:"Maximum time to wait for in-flight calls to complete before a deployment process is terminated." default:"30s" env:"FTL_RUNNER_DRAIN_TIMEOUT"`
+	InheritEnvars      []string        `help:"Environment variables passed through to deployment processes, in addition to those the Runner sets. A trailing * matches a prefix." default:"PATH,HOME,USER,LANG,LC_*,TZ,JAVA_HOME,LOG_*,FTL_CONFIG_*,FTL_SECRET_*" env:"FTL_RUNNER_INHERIT_ENVARS"`
 }
 
+// How long a reservation for a deployment is held before it is released if
+// the deployment doesn't arrive.
+const reservationTimeout = 2 * time.Minute
+
 func Start(ctx context.Context, config Config) error {
 	if config.Advertise.String() == "" {
 		config.Advertise = config.Bind
@@ -68,6 +85,10 @@ func Start(ctx context.Context, config Config) error {
 	if err != nil {
 		return fmt.Errorf("%s: %w", "failed to create deployment directory", err)
 	}
+	artefactCache, err := download.NewCache(config.ArtefactCacheDir, config.ArtefactCacheSize)
+	if err != nil {
+		return err
+	}
 	logger.Debugf("Using FTL endpoint: %s", config.ControllerEndpoint)
 	logger.Debugf("Listening on %s", config.Bind)
 
@@ -92,11 +113,13 @@ func Start(ctx context.Context, config Config) error {
 		key:                key,
 		config:             config,
 		controllerClient:   controllerClient,
+		artefactCache:      artefactCache,
 		forceUpdate:        make(chan struct{}, 16),
 		labels:             labels,
 		deploymentLogQueue: make(chan log.Entry, 10000),
+		reservations:       map[model.DeploymentName]time.Time{},
 	}
-	svc.state.Store(ftlv1.RunnerState_RUNNER_IDLE)
+	svc.deployments.Store(map[model.DeploymentName]*deployment{})
 
 	go rpc.RetryStreamingClientStream(ctx, backoff.Backoff{}, controllerClient.RegisterRunner, svc.registrationLoop)
 	go rpc.RetryStreamingClientStream(ctx, backoff.Backoff{}, controllerClient.StreamDeploymentLogs, svc.streamLogsLoop)
@@ -112,20 +135,101 @@ var _ ftlv1connect.VerbServiceHandler = (*Service)(nil)
 
 type deployment struct {
 	key    model.DeploymentName
-	plugin *plugin.Plugin[ftlv1connect.VerbServiceClient]
-	// Cancelled when plugin terminates
-	ctx context.Context
+	module string
+	limits model.DeploymentLimits
+	// The current process, which is replaced when it is restarted.
+	plugin       atomic.Value[*plugin.Plugin[ftlv1connect.VerbServiceClient]]
+	crashLooping atomic.Value[bool]
+	// Cancelled to stop supervising the deployment and terminate its process.
+	stopping context.Context
+	stop     context.CancelFunc
+	// Closed once the deployment has stopped, with the error terminating its
+	// process, if any, in err.
+	done chan struct{}
+	err  error
+
+	drainLock sync.Mutex
+	draining  bool
+	// Number of calls in flight.
+	calls int
+	// Closed once the deployment is draining and no calls are in flight.
+	drained chan struct{}
+}
+
+// startCall records a call in flight to the deployment, returning a function
+// that must be called once the call completes.
+//
+// Calls are rejected as unavailable once the deployment is draining, so that
+// the Controller routes them elsewhere.
+func (d *deployment) startCall() (func(), error) {
+	d.drainLock.Lock()
+	defer d.drainLock.Unlock()
+	if d.draining {
+		return nil, drainingError(fmt.Errorf("deployment %s is draining", d.key))
+	}
+	d.calls++
+	return d.endCall, nil
+}
+
+func (d *deployment) endCall() {
+	d.drainLock.Lock()
+	defer d.drainLock.Unlock()
+	d.calls--
+	if d.draining && d.calls == 0 {
+		close(d.drained)
+	}
+}
+
+func (d *deployment) isDraining() bool {
+	d.drainLock.Lock()
+	defer d.drainLock.Unlock()
+	return d.draining
+}
+
+// drain stops the deployment accepting calls and waits for up to timeout for
+// those in flight to complete, returning false if they did not.
+func (d *deployment) drain(timeout time.Duration) bool {
+	d.drainLock.Lock()
+	if !d.draining {
+		d.draining = true
+		if d.calls == 0 {
+			close(d.drained)
+		}
+	}
+	d.drainLock.Unlock()
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+	select {
+	case <-d.drained:
+		return true
+	case <-timer.C:
+		return false
+	}
+}
+
+// drainingError returns an unavailable error for a call the Runner will not
+// serve, marked so that the Controller routes the call elsewhere regardless of
+// the Verb's retry policy.
+func drainingError(err error) error {
+	connectErr := connect.NewError(connect.CodeUnavailable, err)
+	headers.SetDraining(connectErr.Meta())
+	return connectErr
 }
 
 type Service struct {
 	key         model.RunnerKey
 	lock        sync.Mutex
-	state       atomic.Value[ftlv1.RunnerState]
 	forceUpdate chan struct{}
-	deployment  atomic.Value[optional.Option[*deployment]]
+	// Deployments hosted by the Runner. The map is replaced rather than
+	// modified, while holding lock.
+	deployments atomic.Value[map[model.DeploymentName]*deployment]
+	// Deployments the Runner is reserved for or is deploying, and when the
+	// reservation expires. A zero expiry never expires. Guarded by lock.
+	reservations map[model.DeploymentName]time.Time
 
 	config           Config
 	controllerClient ftlv1connect.ControllerServiceClient
+	artefactCache    *download.Cache
 	// Failed to register with the Controller
 	registrationFailure atomic.Value[optional.Option[error]]
 	labels              *structpb.Struct
@@ -133,20 +237,141 @@ type Service struct {
 }
 
 func (s *Service) Call(ctx context.Context, req *connect.Request[ftlv1.CallRequest]) (*connect.Response[ftlv1.CallResponse], error) {
-	deployment, ok := s.deployment.Load().Get()
-	if !ok {
+	deployment, err := s.deploymentForCall(req)
+	if err != nil {
+		return nil, err
+	}
+	done, err := deployment.startCall()
+	if err != nil {
+		return nil, err
+	}
+	defer done()
+	return deployment.plugin.Load().Client.Call(ctx, req)
+}
+
+func (s *Service) CallStream(ctx context.Context, req *connect.Request[ftlv1.CallRequest], stream *connect.ServerStream[ftlv1.CallResponse]) error {
+	deployment, err := s.deploymentForCall(req)
+	if err != nil {
+		return err
+	}
+	done, err := deployment.startCall()
+	if err != nil {
+		return err
+	}
+	defer done()
+	resp, err := deployment.plugin.Load().Client.CallStream(ctx, req)
+	if err != nil {
+		return err
+	}
+	defer resp.Close()
+	// Each message is sent before the next is received, so a slow client
+	// applies backpressure all the way to the Verb.
+	for resp.Receive() {
+		if err := stream.Send(resp.Msg()); err != nil {
+			return err
+		}
+	}
+	return resp.Err()
+}
+
+// deploymentForCall returns the deployment a call is routed to.
+//
+// The Controller names the deployment in a header. Calls without one are
+// routed to the only deployment of the Verb's module that is not draining, if
+// there is exactly one.
+func (s *Service) deploymentForCall(req *connect.Request[ftlv1.CallRequest]) (*deployment, error) {
+	deployments := s.deployments.Load()
+	key, ok, err := headers.GetDeployment(req.Header())
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	if ok {
+		deployment, ok := deployments[key]
+		if !ok {
+			return nil, drainingError(fmt.Errorf("deployment %s not found", key))
+		}
+		return deployment, nil
+	}
+	var found *deployment
+	for _, deployment := range deployments {
+		if req.Msg.Verb != nil && deployment.module != req.Msg.Verb.Module {
+			continue
+		}
+		if deployment.isDraining() {
+			continue
+		}
+		if found != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("%s header is required to route calls to module %q", headers.DeploymentHeader, found.module))
+		}
+		found = deployment
+	}
+	if found == nil {
 		return nil, connect.NewError(connect.CodeUnavailable, errors.New("no deployment"))
 	}
-	return deployment.plugin.Client.Call(ctx, req)
+	return found, nil
 }
 
 func (s *Service) Reserve(ctx context.Context, c *connect.Request[ftlv1.ReserveRequest]) (*connect.Response[ftlv1.ReserveResponse], error) {
-	if !s.state.CompareAndSwap(ftlv1.RunnerState_RUNNER_IDLE, ftlv1.RunnerState_RUNNER_RESERVED) {
-		return nil, fmt.Errorf("can only reserve from IDLE state, not %s", s.state.Load())
+	key, err := model.ParseDeploymentName(c.Msg.DeploymentName)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("%s: %w", "invalid deployment key", err))
+	}
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if err := s.reserve(key, time.Now().Add(reservationTimeout)); err != nil {
+		return nil, err
 	}
+	s.requestUpdate()
 	return connect.NewResponse(&ftlv1.ReserveResponse{}), nil
 }
 
+// reserve capacity for a deployment until expiry.
+//
+// Must be called with lock held.
+func (s *Service) reserve(key model.DeploymentName, expiry time.Time) error {
+	now := time.Now()
+	for reserved, expires := range s.reservations {
+		if !expires.IsZero() && expires.Before(now) {
+			delete(s.reservations, reserved)
+		}
+	}
+	deployments := s.deployments.Load()
+	if _, ok := deployments[key]; ok {
+		return connect.NewError(connect.CodeAlreadyExists, fmt.Errorf("deployment %s is already deployed", key))
+	}
+	if _, ok := s.reservations[key]; ok {
+		return connect.NewError(connect.CodeAlreadyExists, fmt.Errorf("deployment %s is already reserved", key))
+	}
+	if len(deployments)+len(s.reservations) >= s.config.Capacity {
+		return connect.NewError(connect.CodeResourceExhausted, fmt.Errorf("runner is at capacity (%d deployments)", s.config.Capacity))
+	}
+	s.reservations[key] = expiry
+	return nil
+}
+
+// storeDeployment adds a deployment to those hosted by the Runner, or removes
+// it if dep is nil.
+//
+// Must be called with lock held.
+func (s *Service) storeDeployment(key model.DeploymentName, dep *deployment) {
+	deployments := maps.Clone(s.deployments.Load())
+	if dep == nil {
+		delete(deployments, key)
+	} else {
+		deployments[key] = dep
+	}
+	s.deployments.Store(deployments)
+}
+
+// requestUpdate asks the registration loop to update the Controller.
+func (s *Service) requestUpdate() {
+	// Don't block if an update is already pending.
+	select {
+	case s.forceUpdate <- struct{}{}:
+	default:
+	}
+}
+
 func (s *Service) Ping(ctx context.Context, req *connect.Request[ftlv1.PingRequest]) (*connect.Response[ftlv1.PingResponse], error) {
 	return connect.NewResponse(&ftlv1.PingResponse{}), nil
 }
@@ -164,23 +389,23 @@ func (s *Service) Deploy(ctx context.Context, req *connect.Request[ftlv1.DeployR
 	deploymentLogger := s.getDeploymentLogger(ctx, key)
 	ctx = log.ContextWithLogger(ctx, deploymentLogger)
 
+	// Hold the reservation, or reserve capacity if the deployment wasn't
+	// reserved, until the deployment completes.
 	s.lock.Lock()
-	defer s.lock.Unlock()
-	if s.deployment.Load().Ok() {
-		return nil, connect.NewError(connect.CodeResourceExhausted, errors.New("already deployed"))
-	}
-
-	// Set the state of the Runner, and update the Controller.
-	setState := func(state ftlv1.RunnerState) {
-		s.state.Store(state)
-		s.forceUpdate <- struct{}{}
+	if _, ok := s.reservations[key]; ok {
+		s.reservations[key] = time.Time{}
+	} else if err := s.reserve(key, time.Time{}); err != nil {
+		s.lock.Unlock()
+		return nil, err
 	}
-
-	setState(ftlv1.RunnerState_RUNNER_RESERVED)
+	s.lock.Unlock()
+	s.requestUpdate()
 	defer func() {
 		if err != nil {
-			setState(ftlv1.RunnerState_RUNNER_IDLE)
-			s.deployment.Store(optional.None[*deployment]())
+			s.lock.Lock()
+			delete(s.reservations, key)
+			s.lock.Unlock()
+			s.requestUpdate()
 		}
 	}()
 
@@ -204,119 +429,147 @@ func (s *Service) Deploy(ctx context.Context, req *connect.Request[ftlv1.DeployR
 			return nil, fmt.Errorf("%s: %w", "failed to create deployment directory", err)
 		}
 	}
-	err = download.Artefacts(ctx, s.controllerClient, key, deploymentDir)
+	err = s.artefactCache.Artefacts(ctx, s.controllerClient, key, gdResp.Msg.Artefacts, deploymentDir)
 	if err != nil {
 		return nil, fmt.Errorf("%s: %w", "failed to download artefacts", err)
 	}
 
+	envars := []string{
+		"FTL_ENDPOINT=" + s.config.ControllerEndpoint.String(),
+		"FTL_CONFIG=" + filepath.Join(internal.GitRoot(""), "ftl-project.toml"),
+		"FTL_OBSERVABILITY_ENDPOINT=" + s.config.ControllerEndpoint.String(),
+	}
+	for name, dsn := range req.Msg.DatabaseDsns {
+		envars = append(envars, databaseDSNEnvar(module.Name, name)+"="+dsn)
+	}
+
+	limits, err := gdResp.Msg.Limits.ToModel()
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", "invalid deployment limits", err)
+	}
+	options := []plugin.Option{
+		plugin.WithEnvars(envars...),
+		plugin.WithInheritedEnvars(s.config.InheritEnvars...),
+		plugin.WithPrivateTempDir(),
+		plugin.WithLimits(plugin.Limits{CPU: limits.CPU, MemoryBytes: limits.MemoryBytes, OpenFiles: limits.OpenFiles}),
+	}
+	if s.config.CgroupParent != "" {
+		// Runners may share a parent cgroup, so the runner key keeps names unique.
+		options = append(options, plugin.WithCgroupParent(s.config.CgroupParent, s.config.Key.String()+"-"+key.String()))
+	}
+
 	verbCtx := log.ContextWithLogger(ctx, deploymentLogger.Attrs(map[string]string{"module": module.Name}))
-	deployment, cmdCtx, err := plugin.Spawn(
-		unstoppable.Context(verbCtx),
-		log.FromContext(ctx).GetLevel(),
-		gdResp.Msg.Schema.Name,
-		deploymentDir,
-		"./main",
-		ftlv1connect.NewVerbServiceClient,
-		plugin.WithEnvars(
-			"FTL_ENDPOINT="+s.config.ControllerEndpoint.String(),
-			"FTL_CONFIG="+filepath.Join(internal.GitRoot(""), "ftl-project.toml"),
-			"FTL_OBSERVABILITY_ENDPOINT="+s.config.ControllerEndpoint.String(),
-		),
-	)
+	spawn := func() (*plugin.Plugin[ftlv1connect.VerbServiceClient], context.Context, error) {
+		return plugin.Spawn(
+			unstoppable.Context(verbCtx),
+			log.FromContext(ctx).GetLevel(),
+			gdResp.Msg.Schema.Name,
+			deploymentDir,
+			"./main",
+			ftlv1connect.NewVerbServiceClient,
+			options...,
+		)
+	}
+	deployment, cmdCtx, err := spawn()
 	if err != nil {
 		return nil, fmt.Errorf("%s: %w", "failed to spawn plugin", err)
 	}
 
-	dep := s.makeDeployment(cmdCtx, key, deployment)
-	s.deployment.Store(optional.Some(dep))
-
-	setState(ftlv1.RunnerState_RUNNER_ASSIGNED)
+	dep := s.makeDeployment(key, module.Name, deployment)
+	dep.limits = limits
+	s.lock.Lock()
+	delete(s.reservations, key)
+	s.storeDeployment(key, dep)
+	s.lock.Unlock()
+	go s.supervise(unstoppable.Context(ctx), dep, cmdCtx, spawn)
+	s.requestUpdate()
 	return connect.NewResponse(&ftlv1.DeployResponse{}), nil
 }
 
 func (s *Service) Terminate(ctx context.Context, c *connect.Request[ftlv1.TerminateRequest]) (*connect.Response[ftlv1.RegisterRunnerRequest], error) {
-	s.lock.Lock()
-	defer s.lock.Unlock()
-	depl, ok := s.deployment.Load().Get()
-	if !ok {
-		return nil, connect.NewError(connect.CodeNotFound, errors.New("no deployment"))
-	}
 	deploymentName, err := model.ParseDeploymentName(c.Msg.DeploymentName)
 	if err != nil {
 		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("%s: %w", "invalid deployment key", err))
 	}
-	if depl.key != deploymentName {
-		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("deployment key mismatch"))
+	depl, ok := s.deployments.Load()[deploymentName]
+	if !ok {
+		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("deployment %s not found", deploymentName))
 	}
 
-	// Soft kill.
-	err = depl.plugin.Cmd.Kill(syscall.SIGTERM)
-	if err != nil {
-		return nil, fmt.Errorf("%s: %w", "failed to kill plugin", err)
+	// The Controller stops routing calls to the deployment before terminating
+	// it, but calls already in flight are given a chance to complete.
+	if !depl.drain(s.config.DrainTimeout) {
+		log.FromContext(ctx).Warnf("Deployment %s did not drain within %s, terminating", deploymentName, s.config.DrainTimeout)
 	}
-	// Hard kill after 10 seconds.
-	select {
-	case <-depl.ctx.Done():
-	case <-time.After(10 * time.Second):
-		err := depl.plugin.Cmd.Kill(syscall.SIGKILL)
-		if err != nil {
-			// Should we os.Exit(1) here?
-			return nil, fmt.Errorf("%s: %w", "failed to kill plugin", err)
-		}
+	depl.stop()
+	<-depl.done
+	// The deployment is no longer supervised, and can't accept calls once
+	// draining, so it's removed even if its process failed to terminate.
+	s.lock.Lock()
+	if s.deployments.Load()[deploymentName] == depl {
+		s.storeDeployment(deploymentName, nil)
 	}
-	s.deployment.Store(optional.None[*deployment]())
-	s.state.Store(ftlv1.RunnerState_RUNNER_IDLE)
-	return connect.NewResponse(&ftlv1.RegisterRunnerRequest{
-		Key:      s.key.String(),
-		Endpoint: s.config.Advertise.String(),
-		State:    ftlv1.RunnerState_RUNNER_IDLE,
-		Labels:   s.labels,
-	}), nil
+	s.lock.Unlock()
+	if depl.err != nil {
+		return nil, fmt.Errorf("%s: %w", "failed to terminate deployment", depl.err)
+	}
+	return connect.NewResponse(s.registration()), nil
+}
+
+func (s *Service) makeDeployment(key model.DeploymentName, module string, plugin *plugin.Plugin[ftlv1connect.VerbServiceClient]) *deployment {
+	stopping, stop := context.WithCancel(context.Background())
+	dep := &deployment{
+		key:      key,
+		module:   module,
+		stopping: stopping,
+		stop:     stop,
+		done:     make(chan struct{}),
+		drained:  make(chan struct{}),
+	}
+	dep.plugin.Store(plugin)
+	dep.crashLooping.Store(false)
+	return dep
 }
 
-func (s *Service) makeDeployment(ctx context.Context, key model.DeploymentName, plugin *plugin.Plugin[ftlv1connect.VerbServiceClient]) *deployment {
-	return &deployment{
-		ctx:    ctx,
-		key:    key,
-		plugin: plugin,
+// registration describes the current state of the Runner to the Controller.
+func (s *Service) registration() *ftlv1.RegisterRunnerRequest {
+	s.lock.Lock()
+	reserved := len(s.reservations) > 0
+	deployments := s.deployments.Load()
+	s.lock.Unlock()
+
+	// Deployment processes that exit are restarted, so the Runner remains
+	// assigned until its deployments are terminated.
+	state := ftlv1.RunnerState_RUNNER_IDLE
+	if len(deployments) > 0 {
+		state = ftlv1.RunnerState_RUNNER_ASSIGNED
+	} else if reserved {
+		state = ftlv1.RunnerState_RUNNER_RESERVED
+	}
+	names := make([]string, 0, len(deployments))
+	unhealthy := false
+	for key, depl := range deployments {
+		names = append(names, key.String())
+		unhealthy = unhealthy || depl.crashLooping.Load()
+	}
+	sort.Strings(names)
+	return &ftlv1.RegisterRunnerRequest{
+		Key:         s.key.String(),
+		Endpoint:    s.config.Advertise.String(),
+		Labels:      s.labels,
+		Deployments: names,
+		Capacity:    int32(s.config.Capacity),
+		State:       state,
+		Unhealthy:   unhealthy,
 	}
 }
 
 func (s *Service) registrationLoop(ctx context.Context, send func(request *ftlv1.RegisterRunnerRequest) error) error {
 	logger := log.FromContext(ctx)
 
-	// Figure out the appropriate state.
-	state := s.state.Load()
-	var errPtr *string
-	var deploymentName *string
-	depl, ok := s.deployment.Load().Get()
-	if ok {
-		dkey := depl.key.String()
-		deploymentName = &dkey
-		select {
-		case <-depl.ctx.Done():
-			state = ftlv1.RunnerState_RUNNER_IDLE
-			err := context.Cause(depl.ctx)
-			errStr := err.Error()
-			errPtr = &errStr
-			s.getDeploymentLogger(ctx, depl.key).Errorf(err, "Deployment terminated")
-			s.deployment.Store(optional.None[*deployment]())
-
-		default:
-			state = ftlv1.RunnerState_RUNNER_ASSIGNED
-		}
-		s.state.Store(state)
-	}
-
-	logger.Tracef("Registering with Controller as %s", state)
-	err := send(&ftlv1.RegisterRunnerRequest{
-		Key:        s.key.String(),
-		Endpoint:   s.config.Advertise.String(),
-		Labels:     s.labels,
-		Deployment: deploymentName,
-		State:      state,
-		Error:      errPtr,
-	})
+	registration := s.registration()
+	logger.Tracef("Registering with Controller as %s", registration.State)
+	err := send(registration)
 	if err != nil {
 		s.registrationFailure.Store(optional.Some(err))
 		return fmt.Errorf("%s: %w", "failed to register with Controller", err)
@@ -389,3 +642,9 @@ func (s *Service) getDeploymentLogger(ctx context.Context, deploymentName model.
 	sink := newDeploymentLogsSink(s.deploymentLogQueue)
 	return log.FromContext(ctx).AddSink(sink).Attrs(attrs)
 }
+
+// databaseDSNEnvar returns the environment variable that ftl.PostgresDatabase
+// reads the DSN of a module's database from.
+func databaseDSNEnvar(module, name string) string {
+	return fmt.Sprintf("FTL_POSTGRES_DSN_%s_%s", strings.ToUpper(module), strings.ToUpper(name))
+}
@@ -0,0 +1,59 @@
+package runner
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/alecthomas/assert/v2"
+
+	"github.com/TBD54566975/ftl/internal/model"
+	"github.com/TBD54566975/ftl/internal/rpc/headers"
+)
+
+func TestReserveCapacity(t *testing.T) {
+	s := &Service{
+		config:       Config{Capacity: 2},
+		reservations: map[model.DeploymentName]time.Time{},
+	}
+	s.deployments.Store(map[model.DeploymentName]*deployment{})
+	hosted := model.NewDeploymentName("hosted")
+	s.storeDeployment(hosted, &deployment{key: hosted, module: "hosted"})
+
+	err := s.reserve(hosted, time.Now().Add(time.Minute))
+	assert.Equal(t, connect.CodeAlreadyExists, connect.CodeOf(err))
+
+	reserved := model.NewDeploymentName("reserved")
+	assert.NoError(t, s.reserve(reserved, time.Now().Add(time.Minute)))
+	err = s.reserve(reserved, time.Now().Add(time.Minute))
+	assert.Equal(t, connect.CodeAlreadyExists, connect.CodeOf(err))
+
+	err = s.reserve(model.NewDeploymentName("other"), time.Now().Add(time.Minute))
+	assert.Equal(t, connect.CodeResourceExhausted, connect.CodeOf(err))
+
+	// Expired reservations release their capacity.
+	s.reservations[reserved] = time.Now().Add(-time.Second)
+	assert.NoError(t, s.reserve(model.NewDeploymentName("other"), time.Now().Add(time.Minute)))
+	_, ok := s.reservations[reserved]
+	assert.False(t, ok)
+}
+
+func TestDrainDeployment(t *testing.T) {
+	dep := &deployment{key: model.NewDeploymentName("test"), module: "test", drained: make(chan struct{})}
+	done, err := dep.startCall()
+	assert.NoError(t, err)
+
+	// Draining times out while a call is in flight.
+	assert.False(t, dep.drain(time.Millisecond))
+
+	// New calls are rejected once draining.
+	_, err = dep.startCall()
+	assert.Equal(t, connect.CodeUnavailable, connect.CodeOf(err))
+	var connectErr *connect.Error
+	assert.True(t, errors.As(err, &connectErr))
+	assert.True(t, headers.IsDraining(connectErr.Meta()))
+
+	done()
+	assert.True(t, dep.drain(time.Second))
+}
@@ -0,0 +1,159 @@
+package runner
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"syscall"
+	"time"
+
+	"github.com/jpillora/backoff"
+
+	"github.com/TBD54566975/ftl/backend/protos/xyz/block/ftl/v1/ftlv1connect"
+	"github.com/TBD54566975/ftl/common/plugin"
+	"github.com/TBD54566975/ftl/internal/log"
+)
+
+type spawnFunc func() (*plugin.Plugin[ftlv1connect.VerbServiceClient], context.Context, error)
+
+// crashLoopDetector detects a deployment whose process repeatedly exits
+// shortly after starting.
+type crashLoopDetector struct {
+	threshold int
+	period    time.Duration
+	crashes   int
+}
+
+// exited records that the process exited, or failed to start, after running
+// for uptime, returning true if the deployment is crash-looping.
+func (c *crashLoopDetector) exited(uptime time.Duration) bool {
+	if uptime >= c.period {
+		c.crashes = 0
+	}
+	c.crashes++
+	return c.crashes >= c.threshold
+}
+
+// stable records that the process has been running for longer than the
+// crash-loop period.
+func (c *crashLoopDetector) stable() { c.crashes = 0 }
+
+// supervise the process of a deployment, restarting it with exponential
+// backoff whenever it exits, until the deployment is stopped.
+//
+// Each exit is recorded in the deployment's logs along with the exit code and
+// the last lines the process wrote to stderr, and whether the process was
+// killed for exceeding its memory limit. A deployment whose process
+// exits within CrashLoopPeriod of starting CrashLoopThreshold times in a row
+// is crash-looping, and is reported to the Controller as unhealthy until a
+// process stays up for CrashLoopPeriod.
+func (s *Service) supervise(ctx context.Context, dep *deployment, cmdCtx context.Context, spawn spawnFunc) {
+	defer close(dep.done)
+	logger := log.FromContext(ctx)
+	retry := backoff.Backoff{Min: s.config.RestartBackoffMin, Max: s.config.RestartBackoffMax, Jitter: true}
+	crashes := crashLoopDetector{threshold: s.config.CrashLoopThreshold, period: s.config.CrashLoopPeriod}
+
+	// exited records that the process exited or failed to start, returning
+	// the delay before it should be restarted.
+	exited := func(uptime time.Duration) time.Duration {
+		if uptime >= s.config.CrashLoopPeriod {
+			retry.Reset()
+		}
+		if crashes.exited(uptime) {
+			s.setCrashLooping(dep, true)
+		}
+		return retry.Duration()
+	}
+
+	for {
+		started := time.Now()
+		if !s.waitForExit(dep, cmdCtx, &crashes) {
+			return
+		}
+		uptime := time.Since(started)
+		delay := exited(uptime)
+		current := dep.plugin.Load()
+		exitCode := current.Cmd.ProcessState.ExitCode()
+		if cause := context.Cause(cmdCtx); errors.Is(cause, plugin.ErrOOMKilled) {
+			logger.Attrs(map[string]string{"exit_code": strconv.Itoa(exitCode), "oom_killed": "true"}).Errorf(cause,
+				"Deployment process was killed for exceeding its memory limit of %d bytes after %s, restarting in %s\n%s",
+				dep.limits.MemoryBytes, uptime.Round(time.Millisecond), delay.Round(time.Millisecond), current.Stderr.Bytes())
+		} else {
+			logger.Attrs(map[string]string{"exit_code": strconv.Itoa(exitCode)}).Errorf(cause,
+				"Deployment process exited with code %d after %s, restarting in %s\n%s",
+				exitCode, uptime.Round(time.Millisecond), delay.Round(time.Millisecond), current.Stderr.Bytes())
+		}
+
+		for {
+			select {
+			case <-dep.stopping.Done():
+				return
+			case <-time.After(delay):
+			}
+			started = time.Now()
+			restarted, restartedCtx, err := spawn()
+			if err == nil {
+				dep.plugin.Store(restarted)
+				cmdCtx = restartedCtx
+				break
+			}
+			delay = exited(time.Since(started))
+			logger.Errorf(err, "Failed to restart deployment process, retrying in %s", delay.Round(time.Millisecond))
+		}
+	}
+}
+
+// waitForExit waits for the deployment's process to exit, returning false if
+// the deployment was stopped first, in which case the process is terminated.
+func (s *Service) waitForExit(dep *deployment, cmdCtx context.Context, crashes *crashLoopDetector) bool {
+	stable := time.NewTimer(s.config.CrashLoopPeriod)
+	defer stable.Stop()
+	for {
+		select {
+		case <-dep.stopping.Done():
+			// The process may have exited at the same time.
+			if cmdCtx.Err() == nil {
+				dep.err = terminate(dep.plugin.Load(), cmdCtx)
+			}
+			return false
+
+		case <-stable.C:
+			crashes.stable()
+			s.setCrashLooping(dep, false)
+
+		case <-cmdCtx.Done():
+			return true
+		}
+	}
+}
+
+func (s *Service) setCrashLooping(dep *deployment, crashLooping bool) {
+	if dep.crashLooping.Swap(crashLooping) == crashLooping {
+		return
+	}
+	s.requestUpdate()
+}
+
+// terminate a deployment's process, killing it if it does not exit within 10
+// seconds. A process that has already exited is not an error.
+func terminate(p *plugin.Plugin[ftlv1connect.VerbServiceClient], cmdCtx context.Context) error {
+	// Soft kill.
+	err := p.Cmd.Kill(syscall.SIGTERM)
+	if errors.Is(err, syscall.ESRCH) {
+		return nil
+	} else if err != nil {
+		return fmt.Errorf("%s: %w", "failed to kill plugin", err)
+	}
+	// Hard kill after 10 seconds.
+	select {
+	case <-cmdCtx.Done():
+	case <-time.After(10 * time.Second):
+		err := p.Cmd.Kill(syscall.SIGKILL)
+		if err != nil && !errors.Is(err, syscall.ESRCH) {
+			// Should we os.Exit(1) here?
+			return fmt.Errorf("%s: %w", "failed to kill plugin", err)
+		}
+	}
+	return nil
+}
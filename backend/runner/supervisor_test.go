@@ -0,0 +1,40 @@
+package runner
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/alecthomas/assert/v2"
+
+	"github.com/TBD54566975/ftl/backend/protos/xyz/block/ftl/v1/ftlv1connect"
+	"github.com/TBD54566975/ftl/common/plugin"
+	"github.com/TBD54566975/ftl/internal/exec"
+	"github.com/TBD54566975/ftl/internal/log"
+)
+
+func TestCrashLoopDetector(t *testing.T) {
+	crashes := crashLoopDetector{threshold: 3, period: time.Minute}
+	assert.False(t, crashes.exited(time.Second))
+	assert.False(t, crashes.exited(time.Second))
+	assert.True(t, crashes.exited(time.Second))
+	assert.True(t, crashes.exited(time.Second))
+
+	// A process that stays up resets the count.
+	crashes.stable()
+	assert.False(t, crashes.exited(time.Second))
+	assert.False(t, crashes.exited(time.Second))
+	assert.False(t, crashes.exited(time.Hour))
+	assert.False(t, crashes.exited(time.Second))
+	assert.True(t, crashes.exited(time.Second))
+}
+
+func TestTerminateExitedProcess(t *testing.T) {
+	ctx := log.ContextWithNewDefaultLogger(context.Background())
+	cmd := exec.Command(ctx, log.Debug, ".", "true")
+	assert.NoError(t, cmd.Run())
+	cmdCtx, cancel := context.WithCancel(ctx)
+	cancel()
+	err := terminate(&plugin.Plugin[ftlv1connect.VerbServiceClient]{Cmd: cmd}, cmdCtx)
+	assert.NoError(t, err)
+}
@@ -14,6 +14,8 @@ builtin module builtin {
     query {String: [String]}
     headers {String: [String]}
     body Body
+    // Identity of the caller returned by the route's authorizer, if any.
+    principal String?
   }
 
   // HTTP response structure used for HTTP ingress verbs.
@@ -26,6 +28,24 @@ builtin module builtin {
   }
 
   data Empty {}
+
+  // Request passed to the authorizer verb of an ingress route.
+  data AuthRequest {
+    method String
+    path String
+    headers {String: [String]}
+    // Credentials from the Authorization header, if the route requires an
+    // authentication scheme.
+    credentials String?
+  }
+
+  // Response from the authorizer verb of an ingress route.
+  data AuthResponse {
+    // Requests that are not allowed are rejected with 403 Forbidden.
+    allowed Bool
+    // Identity of the caller, passed to HTTP ingress verbs.
+    principal String?
+  }
 }
 `
 
@@ -102,9 +102,9 @@ func (d *Data) Monomorphise(ref *DataRef) (*Data, error) {
 			n.Type = t
 
 		case *Any, *Bool, *Bytes, *Data, *DataRef, *Database, Decl, *Float,
-			IngressPathComponent, *IngressPathLiteral, *IngressPathParameter, *Int,
-			Metadata, *MetadataCalls, *MetadataDatabases, *MetadataIngress, *Module,
-			*Schema, *String, *Time, Type, *TypeParameter, *Unit, *Verb, *Enum, *EnumVariant,
+			IngressPathComponent, *IngressPathLiteral, *IngressPathParameter, *IngressCORS, *IngressCache, *IngressRateLimit, *Int,
+			Metadata, *MetadataCalls, *MetadataDatabases, *MetadataIngress, *MetadataCron, *MetadataSubscriber, *MetadataRetry, *MetadataTimeout, *MetadataRateLimit, *Module,
+			*Schema, *String, *Time, Type, *TypeParameter, *Unit, *Verb, *Enum, *EnumVariant, *Topic, *Subscription,
 			Value, *IntValue, *StringValue:
 		}
 		return next()
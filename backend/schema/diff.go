@@ -0,0 +1,240 @@
+package schema
+
+import (
+	"fmt"
+)
+
+// A Change is a difference between two versions of a module's schema.
+type Change struct {
+	// Decl is the declaration that changed.
+	Decl    Ref
+	Message string
+	// Breaking is true if consumers of the old schema may be incompatible with
+	// the new schema.
+	Breaking bool
+}
+
+func (c Change) String() string {
+	kind := "compatible"
+	if c.Breaking {
+		kind = "breaking"
+	}
+	return fmt.Sprintf("%s: %s: %s", kind, c.Decl.String(), c.Message)
+}
+
+// BreakingChanges returns the subset of changes that are breaking.
+func BreakingChanges(changes []Change) []Change {
+	out := []Change{}
+	for _, change := range changes {
+		if change.Breaking {
+			out = append(out, change)
+		}
+	}
+	return out
+}
+
+// Diff returns the changes between two versions of a module, classified as
+// compatible or breaking.
+//
+// Removing a verb, data structure, enum or topic, changing the type of a
+// field or verb, and adding or making a field required are all breaking.
+func Diff(old, new *Module) []Change {
+	changes := []Change{}
+	newDecls := map[string]Decl{}
+	for _, decl := range new.Decls {
+		if name, _, ok := declNameAndKind(decl); ok {
+			newDecls[name] = decl
+		}
+	}
+	oldDecls := map[string]bool{}
+	for _, oldDecl := range old.Decls {
+		name, kind, ok := declNameAndKind(oldDecl)
+		if !ok {
+			continue
+		}
+		oldDecls[name] = true
+		ref := Ref{Module: new.Name, Name: name}
+		newDecl, ok := newDecls[name]
+		if !ok {
+			// Subscriptions and databases are private to their module.
+			_, private := oldDecl.(*Subscription)
+			if _, ok := oldDecl.(*Database); ok {
+				private = true
+			}
+			changes = append(changes, Change{Decl: ref, Message: kind + " removed", Breaking: !private})
+			continue
+		}
+		if _, newKind, _ := declNameAndKind(newDecl); newKind != kind {
+			changes = append(changes, Change{Decl: ref, Message: fmt.Sprintf("changed from %s to %s", kind, newKind), Breaking: true})
+			continue
+		}
+		changes = append(changes, diffDecl(ref, oldDecl, newDecl)...)
+	}
+	for _, newDecl := range new.Decls {
+		name, kind, ok := declNameAndKind(newDecl)
+		if !ok || oldDecls[name] {
+			continue
+		}
+		changes = append(changes, Change{Decl: Ref{Module: new.Name, Name: name}, Message: kind + " added"})
+	}
+	return changes
+}
+
+// diffDecl compares two declarations of the same kind.
+func diffDecl(ref Ref, oldDecl, newDecl Decl) []Change {
+	changes := []Change{}
+	breaking := func(format string, args ...any) {
+		changes = append(changes, Change{Decl: ref, Message: fmt.Sprintf(format, args...), Breaking: true})
+	}
+	compatible := func(format string, args ...any) {
+		changes = append(changes, Change{Decl: ref, Message: fmt.Sprintf(format, args...)})
+	}
+	switch old := oldDecl.(type) {
+	case *Verb:
+		new := newDecl.(*Verb) //nolint:forcetypeassert
+		if old.Request.String() != new.Request.String() {
+			breaking("request changed from %s to %s", old.Request, new.Request)
+		}
+		if old.Response.String() != new.Response.String() {
+			breaking("response changed from %s to %s", old.Response, new.Response)
+		}
+
+	case *Data:
+		new := newDecl.(*Data) //nolint:forcetypeassert
+		if len(old.TypeParameters) != len(new.TypeParameters) {
+			breaking("type parameters changed")
+		}
+		for _, oldField := range old.Fields {
+			newField := new.FieldByName(oldField.Name)
+			if newField == nil {
+				breaking("field %s removed", oldField.Name)
+				continue
+			}
+			oldType, oldOptional := unwrapOptional(oldField.Type)
+			newType, newOptional := unwrapOptional(newField.Type)
+			switch {
+			case oldType.String() != newType.String():
+				breaking("field %s changed type from %s to %s", oldField.Name, oldField.Type, newField.Type)
+			case oldOptional && !newOptional:
+				breaking("field %s became required", oldField.Name)
+			case !oldOptional && newOptional:
+				compatible("field %s became optional", oldField.Name)
+			}
+		}
+		for _, newField := range new.Fields {
+			if old.FieldByName(newField.Name) != nil {
+				continue
+			}
+			if _, optional := unwrapOptional(newField.Type); optional {
+				compatible("optional field %s added", newField.Name)
+			} else {
+				breaking("required field %s added", newField.Name)
+			}
+		}
+
+	case *Enum:
+		new := newDecl.(*Enum) //nolint:forcetypeassert
+		if old.Type.String() != new.Type.String() {
+			breaking("type changed from %s to %s", old.Type, new.Type)
+		}
+		newVariants := map[string]*EnumVariant{}
+		for _, variant := range new.Variants {
+			newVariants[variant.Name] = variant
+		}
+		oldVariants := map[string]bool{}
+		for _, oldVariant := range old.Variants {
+			oldVariants[oldVariant.Name] = true
+			newVariant, ok := newVariants[oldVariant.Name]
+			if !ok {
+				breaking("variant %s removed", oldVariant.Name)
+			} else if oldVariant.Value.String() != newVariant.Value.String() {
+				breaking("variant %s changed value from %s to %s", oldVariant.Name, oldVariant.Value, newVariant.Value)
+			}
+		}
+		for _, newVariant := range new.Variants {
+			if !oldVariants[newVariant.Name] {
+				compatible("variant %s added", newVariant.Name)
+			}
+		}
+
+	case *Topic:
+		new := newDecl.(*Topic) //nolint:forcetypeassert
+		if old.Event.String() != new.Event.String() {
+			breaking("event changed from %s to %s", old.Event, new.Event)
+		}
+
+	case *Subscription:
+		new := newDecl.(*Subscription) //nolint:forcetypeassert
+		if old.Topic.String() != new.Topic.String() {
+			compatible("topic changed from %s to %s", old.Topic, new.Topic)
+		}
+
+	case *Bool, *Bytes, *Database, *Float, *Int, *Module, *String, *Time,
+		*Unit, *Any, *TypeParameter:
+	}
+	return changes
+}
+
+// ConsumedDecls returns the names of the declarations in module that are
+// referenced by consumers, either directly or through other declarations of
+// module that they reference.
+func ConsumedDecls(module *Module, consumers []*Module) map[string]bool {
+	consumed := map[string]bool{}
+	queue := []string{}
+	collect := func(node Node, local bool) {
+		_ = Visit(node, func(n Node, next func() error) error {
+			// Calls between a module's own verbs do not expose their types.
+			if _, ok := n.(Metadata); ok && local {
+				return nil
+			}
+			if ref, ok := n.(interface{ Untyped() Ref }); ok {
+				ref := ref.Untyped()
+				if (ref.Module == module.Name || (local && ref.Module == "")) && !consumed[ref.Name] {
+					consumed[ref.Name] = true
+					queue = append(queue, ref.Name)
+				}
+			}
+			return next()
+		})
+	}
+	for _, consumer := range consumers {
+		if consumer.Name != module.Name {
+			collect(consumer, false)
+		}
+	}
+	for len(queue) > 0 {
+		name := queue[0]
+		queue = queue[1:]
+		if decl := module.Resolve(Ref{Name: name}); decl != nil {
+			collect(decl.Decl, true)
+		}
+	}
+	return consumed
+}
+
+func unwrapOptional(t Type) (Type, bool) {
+	if optional, ok := t.(*Optional); ok {
+		return optional.Type, true
+	}
+	return t, false
+}
+
+func declNameAndKind(decl Decl) (name, kind string, ok bool) {
+	switch decl := decl.(type) {
+	case *Verb:
+		return decl.Name, "verb", true
+	case *Data:
+		return decl.Name, "data", true
+	case *Enum:
+		return decl.Name, "enum", true
+	case *Topic:
+		return decl.Name, "topic", true
+	case *Subscription:
+		return decl.Name, "subscription", true
+	case *Database:
+		return decl.Name, "database", true
+	case *Bool, *Bytes, *Float, *Int, *Module, *String, *Time,
+		*Unit, *Any, *TypeParameter:
+	}
+	return "", "", false
+}
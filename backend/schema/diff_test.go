@@ -0,0 +1,89 @@
+package schema
+
+import (
+	"testing"
+
+	"github.com/alecthomas/assert/v2"
+
+	"github.com/TBD54566975/ftl/internal/slices"
+)
+
+func TestDiff(t *testing.T) {
+	old, err := ParseModuleString("", `
+		module echo {
+			data EchoRequest {
+				name String
+				nickname String?
+				age Int
+			}
+			data EchoResponse {
+				message String
+			}
+			enum Colour(String) {
+				Red("red")
+				Blue("blue")
+			}
+			verb echo(echo.EchoRequest) echo.EchoResponse
+			verb legacy(Unit) Unit
+		}
+	`)
+	assert.NoError(t, err)
+	new, err := ParseModuleString("", `
+		module echo {
+			data EchoRequest {
+				name String?
+				nickname String
+				email String
+				greeting String?
+			}
+			data EchoResponse {
+				message Int
+			}
+			enum Colour(String) {
+				Red("red")
+				Green("green")
+			}
+			verb echo(echo.EchoRequest) echo.EchoResponse
+			verb time(Unit) Time
+		}
+	`)
+	assert.NoError(t, err)
+	actual := slices.Map(Diff(old, new), func(c Change) string { return c.String() })
+	expected := []string{
+		"compatible: echo.EchoRequest: field name became optional",
+		"breaking: echo.EchoRequest: field nickname became required",
+		"breaking: echo.EchoRequest: field age removed",
+		"breaking: echo.EchoRequest: required field email added",
+		"compatible: echo.EchoRequest: optional field greeting added",
+		"breaking: echo.EchoResponse: field message changed type from String to Int",
+		"breaking: echo.Colour: variant Blue removed",
+		"compatible: echo.Colour: variant Green added",
+		"breaking: echo.legacy: verb removed",
+		"compatible: echo.time: verb added",
+	}
+	assert.Equal(t, expected, actual)
+	assert.Equal(t, 6, len(BreakingChanges(Diff(old, new))))
+	assert.Equal(t, []Change{}, Diff(old, old))
+}
+
+func TestConsumedDecls(t *testing.T) {
+	sch, err := ParseString("", `
+		module echo {
+			data EchoRequest {
+				inner echo.Inner
+			}
+			data Inner {}
+			data Unused {}
+			verb echo(echo.EchoRequest) Unit
+				calls echo.internal
+			verb internal(echo.Unused) Unit
+		}
+		module caller {
+			verb call(Unit) Unit
+				calls echo.echo
+		}
+	`)
+	assert.NoError(t, err)
+	consumed := ConsumedDecls(sch.Module("echo").MustGet(), sch.Modules)
+	assert.Equal(t, map[string]bool{"echo": true, "EchoRequest": true, "Inner": true}, consumed)
+}
@@ -19,42 +19,90 @@ func DataToJSONSchema(schema *Schema, dataRef DataRef) (*jsonschema.Schema, erro
 		return nil, fmt.Errorf("unknown data type %s", dataRef)
 	}
 
-	// Collect all data types.
-	dataTypes := schema.DataMap()
-
 	// Encode root, and collect all data types reachable from the root.
-	refs := map[Ref]*DataRef{}
+	refs := &jsRefs{prefix: "#/definitions/", types: map[Ref]Type{}}
 	root := nodeToJSSchema(data, refs)
-	if len(refs) == 0 {
+	if len(refs.types) == 0 {
 		return root, nil
 	}
 
 	// Resolve and encode all data types reachable from the root.
 	root.Definitions = map[string]jsonschema.SchemaOrBool{}
-	for key, dataRef := range refs {
-		data, ok := dataTypes[Ref{Module: key.Module, Name: key.Name}]
-		if !ok {
-			return nil, fmt.Errorf("unknown data type %s", key)
-		}
+	if err := refs.define(schema, root.Definitions); err != nil {
+		return nil, err
+	}
+	return root, nil
+}
 
-		if len(dataRef.TypeParameters) > 0 {
-			monomorphisedData, err := data.Monomorphise(dataRef)
-			if err != nil {
-				return nil, err
-			}
-			data = monomorphisedData
+// TypeToJSONSchema converts a Type to a JSON Schema for embedding in a larger
+// document, such as an OpenAPI document.
+//
+// References to data types and enums are prefixed with refPrefix, and the
+// JSON Schemas of all the types reachable from typ are added to definitions.
+func TypeToJSONSchema(schema *Schema, typ Type, refPrefix string, definitions map[string]jsonschema.SchemaOrBool) (*jsonschema.Schema, error) {
+	refs := &jsRefs{prefix: refPrefix, types: map[Ref]Type{}}
+	root := nodeToJSSchema(typ, refs)
+	if err := refs.define(schema, definitions); err != nil {
+		return nil, err
+	}
+	return root, nil
+}
+
+// jsRefs collects the data types and enums referenced by a JSON Schema.
+type jsRefs struct {
+	prefix string
+	types  map[Ref]Type
+}
 
-			ref := fmt.Sprintf("%s.%s", dataRef.Module, genericRefName(dataRef))
-			root.Definitions[ref] = jsonschema.SchemaOrBool{TypeObject: nodeToJSSchema(data, refs)}
-		} else {
-			root.Definitions[dataRef.String()] = jsonschema.SchemaOrBool{TypeObject: nodeToJSSchema(data, refs)}
+// define encodes all collected types, and any types they reference, into
+// definitions.
+func (r *jsRefs) define(schema *Schema, definitions map[string]jsonschema.SchemaOrBool) error {
+	dataTypes := schema.DataMap()
+	for {
+		pending := false
+		for key, ref := range r.types {
+			name := jsRefName(ref)
+			if _, ok := definitions[name]; ok {
+				continue
+			}
+			pending = true
+			if enumRef, ok := ref.(*EnumRef); ok {
+				enum := schema.ResolveEnumRef(enumRef)
+				if enum == nil {
+					return fmt.Errorf("unknown enum %s", key)
+				}
+				definitions[name] = jsonschema.SchemaOrBool{TypeObject: nodeToJSSchema(enum, r)}
+				continue
+			}
+			dataRef := ref.(*DataRef) //nolint:forcetypeassert
+			data, ok := dataTypes[Ref{Module: key.Module, Name: key.Name}]
+			if !ok {
+				return fmt.Errorf("unknown data type %s", key)
+			}
+			if len(dataRef.TypeParameters) > 0 {
+				monomorphisedData, err := data.Monomorphise(dataRef)
+				if err != nil {
+					return err
+				}
+				data = monomorphisedData
+			}
+			definitions[name] = jsonschema.SchemaOrBool{TypeObject: nodeToJSSchema(data, r)}
+		}
+		if !pending {
+			return nil
 		}
+	}
+}
 
+// jsRefName returns the name of the definition of a data type or enum.
+func jsRefName(ref Type) string {
+	if dataRef, ok := ref.(*DataRef); ok && len(dataRef.TypeParameters) > 0 {
+		return fmt.Sprintf("%s.%s", dataRef.Module, genericRefName(dataRef))
 	}
-	return root, nil
+	return ref.String()
 }
 
-func nodeToJSSchema(node Node, dataRefs map[Ref]*DataRef) *jsonschema.Schema {
+func nodeToJSSchema(node Node, refs *jsRefs) *jsonschema.Schema {
 	switch node := node.(type) {
 	case *Any:
 		return &jsonschema.Schema{}
@@ -72,7 +120,7 @@ func nodeToJSSchema(node Node, dataRefs map[Ref]*DataRef) *jsonschema.Schema {
 			AdditionalProperties: jsBool(false),
 		}
 		for _, field := range node.Fields {
-			jsField := nodeToJSSchema(field.Type, dataRefs)
+			jsField := nodeToJSSchema(field.Type, refs)
 			jsField.Description = jsComments(field.Comments)
 			if _, ok := field.Type.(*Optional); !ok {
 				schema.Required = append(schema.Required, field.Name)
@@ -118,7 +166,7 @@ func nodeToJSSchema(node Node, dataRefs map[Ref]*DataRef) *jsonschema.Schema {
 			Type: &jsonschema.Type{SimpleTypes: &st},
 			Items: &jsonschema.Items{
 				SchemaOrBool: &jsonschema.SchemaOrBool{
-					TypeObject: nodeToJSSchema(node.Element, dataRefs),
+					TypeObject: nodeToJSSchema(node.Element, refs),
 				},
 			},
 		}
@@ -128,37 +176,48 @@ func nodeToJSSchema(node Node, dataRefs map[Ref]*DataRef) *jsonschema.Schema {
 		// JSON schema generic map of key type to value type
 		return &jsonschema.Schema{
 			Type:                 &jsonschema.Type{SimpleTypes: &st},
-			PropertyNames:        &jsonschema.SchemaOrBool{TypeObject: nodeToJSSchema(node.Key, dataRefs)},
-			AdditionalProperties: &jsonschema.SchemaOrBool{TypeObject: nodeToJSSchema(node.Value, dataRefs)},
+			PropertyNames:        &jsonschema.SchemaOrBool{TypeObject: nodeToJSSchema(node.Key, refs)},
+			AdditionalProperties: &jsonschema.SchemaOrBool{TypeObject: nodeToJSSchema(node.Value, refs)},
 		}
 
 	case *DataRef:
-		var ref string
-		if len(node.TypeParameters) > 0 {
-			name := genericRefName(node)
-			ref = fmt.Sprintf("#/definitions/%s.%s", node.Module, name)
-		} else {
-			ref = fmt.Sprintf("#/definitions/%s", node.String())
-		}
-
-		dataRefs[node.Untyped()] = node
+		ref := refs.prefix + jsRefName(node)
+		refs.types[node.Untyped()] = node
 		schema := &jsonschema.Schema{Ref: &ref}
 
 		return schema
 
+	case *EnumRef:
+		ref := refs.prefix + jsRefName(node)
+		refs.types[node.Untyped()] = node
+		return &jsonschema.Schema{Ref: &ref}
+
+	case *Enum:
+		schema := nodeToJSSchema(node.Type, refs)
+		schema.Description = jsComments(node.Comments)
+		for _, variant := range node.Variants {
+			switch value := variant.Value.(type) {
+			case *StringValue:
+				schema.Enum = append(schema.Enum, value.Value)
+			case *IntValue:
+				schema.Enum = append(schema.Enum, value.Value)
+			}
+		}
+		return schema
+
 	case *Optional:
 		null := jsonschema.Null
 		return &jsonschema.Schema{AnyOf: []jsonschema.SchemaOrBool{
-			{TypeObject: nodeToJSSchema(node.Type, dataRefs)},
+			{TypeObject: nodeToJSSchema(node.Type, refs)},
 			{TypeObject: &jsonschema.Schema{Type: &jsonschema.Type{SimpleTypes: &null}}},
 		}}
 
 	case *TypeParameter:
 		return &jsonschema.Schema{}
 
-	case Decl, *Field, Metadata, *MetadataCalls, *MetadataDatabases, *MetadataIngress,
-		IngressPathComponent, *IngressPathLiteral, *IngressPathParameter, *Module,
-		*Schema, Type, *Database, *Verb, *VerbRef, *SourceRef, *SinkRef, *Enum, *EnumRef, *EnumVariant,
+	case Decl, *Field, Metadata, *MetadataCalls, *MetadataDatabases, *MetadataIngress, *MetadataCron, *MetadataSubscriber, *MetadataRetry, *MetadataTimeout, *MetadataRateLimit,
+		IngressPathComponent, *IngressPathLiteral, *IngressPathParameter, *IngressCORS, *IngressCache, *IngressRateLimit, *Module,
+		*Schema, Type, *Database, *Verb, *VerbRef, *SourceRef, *SinkRef, *EnumVariant, *Topic, *TopicRef, *Subscription,
 		Value, *StringValue, *IntValue:
 		panic(fmt.Sprintf("unsupported node type %T", node))
 
@@ -30,6 +30,16 @@ var jsonSchemaSample = &Schema{
 					{Name: "ref", Type: &DataRef{Module: "bar", Name: "Bar"}},
 					{Name: "any", Type: &Any{}},
 					{Name: "keyValue", Type: &DataRef{Module: "foo", Name: "Generic", TypeParameters: []Type{&String{}, &Int{}}}},
+					{Name: "enum", Type: &EnumRef{Module: "foo", Name: "Color"}},
+				},
+			},
+			&Enum{
+				Name:     "Color",
+				Comments: []string{"Enum comment"},
+				Type:     &String{},
+				Variants: []*EnumVariant{
+					{Name: "Red", Value: &StringValue{Value: "Red"}},
+					{Name: "Blue", Value: &StringValue{Value: "Blue"}},
 				},
 			},
 			&Data{
@@ -71,7 +81,8 @@ func TestDataToJSONSchema(t *testing.T) {
     "optionalMap",
     "ref",
     "any",
-    "keyValue"
+    "keyValue",
+    "enum"
   ],
   "additionalProperties": false,
   "definitions": {
@@ -87,6 +98,14 @@ func TestDataToJSONSchema(t *testing.T) {
       },
       "type": "object"
     },
+    "foo.Color": {
+      "description": "Enum comment",
+      "enum": [
+        "Red",
+        "Blue"
+      ],
+      "type": "string"
+    },
     "foo.Generic[String, Int]": {
       "required": [
         "key",
@@ -142,6 +161,9 @@ func TestDataToJSONSchema(t *testing.T) {
     "bool": {
       "type": "boolean"
     },
+    "enum": {
+      "$ref": "#/definitions/foo.Color"
+    },
     "float": {
       "type": "number"
     },
@@ -232,7 +254,8 @@ func TestJSONSchemaValidation(t *testing.T) {
     "optionalMap": {"one": 2, "two": null},
     "ref": {"bar": "Name"},
     "any": [{"name": "Name"}, "string", 1, 1.23, true, "2018-11-13T20:20:39+00:00", ["one"], {"one": 2}, null],
-    "keyValue": {"key": "string", "value": 1}
+    "keyValue": {"key": "string", "value": 1},
+    "enum": "Red"
   }
    `
 
@@ -0,0 +1,36 @@
+package schema
+
+import (
+	"fmt"
+	"strconv"
+
+	"google.golang.org/protobuf/proto"
+
+	schemapb "github.com/TBD54566975/ftl/backend/protos/xyz/block/ftl/v1/schema"
+)
+
+// MetadataCron schedules a Verb to be called periodically.
+//
+// eg. cron "*/5 * * * *"
+type MetadataCron struct {
+	Pos Position `parser:"" protobuf:"1,optional"`
+
+	Cron string `parser:"'cron' @String" protobuf:"2"`
+}
+
+var _ Metadata = (*MetadataCron)(nil)
+
+func (m *MetadataCron) Position() Position { return m.Pos }
+func (m *MetadataCron) String() string {
+	return fmt.Sprintf("cron %s", strconv.Quote(m.Cron))
+}
+
+func (m *MetadataCron) schemaChildren() []Node { return nil }
+func (*MetadataCron) schemaMetadata()          {}
+
+func (m *MetadataCron) ToProto() proto.Message {
+	return &schemapb.MetadataCron{
+		Pos:  posToProto(m.Pos),
+		Cron: m.Cron,
+	}
+}
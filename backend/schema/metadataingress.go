@@ -2,19 +2,45 @@ package schema
 
 import (
 	"fmt"
+	"strconv"
 	"strings"
+	"time"
 
+	"github.com/alecthomas/types/optional"
 	"google.golang.org/protobuf/proto"
 
 	schemapb "github.com/TBD54566975/ftl/backend/protos/xyz/block/ftl/v1/schema"
 )
 
+// MetadataIngress exposes a Verb as an ingress route.
+//
+// eg. ingress http GET /users/{id} auth=bearer authorizer=auth.check
 type MetadataIngress struct {
 	Pos Position `parser:"" protobuf:"1,optional"`
 
-	Type   string                 `parser:"'ingress' @('http')?" protobuf:"2"`
+	// Type is "http" for request/response routes, or "sse" or "websocket" for
+	// routes that stream the messages sent by the Verb.
+	Type   string                 `parser:"'ingress' @('http' | 'sse' | 'websocket')?" protobuf:"2"`
 	Method string                 `parser:"@('GET' | 'POST' | 'PUT' | 'DELETE')" protobuf:"3"`
 	Path   []IngressPathComponent `parser:"('/' @@)+" protobuf:"4"`
+	// Auth is the HTTP authentication scheme that requests must use, if any.
+	// The credentials are verified by the Authorizer, which is required.
+	Auth string `parser:"('auth' '=' @('bearer' | 'basic'))?" protobuf:"5"`
+	// Authorizer is a Verb called by the controller to authorize each request
+	// before it reaches the ingress Verb. Use GetAuthorizer to access it.
+	Authorizer *VerbRef `parser:"('authorizer' '=' @@)?" protobuf:"6,optional"`
+	// CORS is the cross-origin resource sharing policy of the route, if any.
+	// Use GetCORS to access it.
+	//
+	// This is not a pointer because DeepCopy does not support more than one
+	// nil pointer field in a struct.
+	CORS IngressCORS `parser:"@@?" protobuf:"7,optional"`
+	// Cache is the response cache policy of the route, if any. Use GetCache to
+	// access it.
+	Cache IngressCache `parser:"@@?" protobuf:"8,optional"`
+	// RateLimit limits the rate of requests to the route, if set. Use
+	// GetRateLimit to access it.
+	RateLimit IngressRateLimit `parser:"@@?" protobuf:"9,optional"`
 }
 
 var _ Metadata = (*MetadataIngress)(nil)
@@ -27,30 +53,345 @@ func (m *MetadataIngress) String() string {
 		case *IngressPathLiteral:
 			path[i] = v.Text
 		case *IngressPathParameter:
-			path[i] = fmt.Sprintf("{%s}", v.Name)
+			path[i] = v.Pattern()
 		}
 	}
-	return fmt.Sprintf("ingress %s %s /%s", m.Type, strings.ToUpper(m.Method), strings.Join(path, "/"))
+	out := fmt.Sprintf("ingress %s %s /%s", m.Type, strings.ToUpper(m.Method), strings.Join(path, "/"))
+	if m.Auth != "" {
+		out += " auth=" + m.Auth
+	}
+	if authorizer, ok := m.GetAuthorizer().Get(); ok {
+		out += " authorizer=" + authorizer.String()
+	}
+	if cors, ok := m.GetCORS().Get(); ok {
+		out += " " + cors.String()
+	}
+	if cache, ok := m.GetCache().Get(); ok {
+		out += " " + cache.String()
+	}
+	if limit, ok := m.GetRateLimit().Get(); ok {
+		out += " " + limit.String()
+	}
+	return out
+}
+
+// IsStreaming returns true if the route streams the messages sent by its Verb
+// to the client.
+func (m *MetadataIngress) IsStreaming() bool {
+	return m.Type == "sse" || m.Type == "websocket"
+}
+
+// GetAuthorizer returns the authorizer Verb of the ingress route, if any.
+//
+// Deep copies of the schema replace a nil Authorizer with an empty reference,
+// so both are treated as absent.
+func (m *MetadataIngress) GetAuthorizer() optional.Option[*VerbRef] {
+	if m.Authorizer == nil || m.Authorizer.Name == "" {
+		return optional.None[*VerbRef]()
+	}
+	return optional.Some(m.Authorizer)
+}
+
+// GetCORS returns the CORS policy of the ingress route, if any.
+func (m *MetadataIngress) GetCORS() optional.Option[*IngressCORS] {
+	if len(m.CORS.Origins) == 0 {
+		return optional.None[*IngressCORS]()
+	}
+	return optional.Some(&m.CORS)
+}
+
+// GetCache returns the response cache policy of the ingress route, if any.
+func (m *MetadataIngress) GetCache() optional.Option[*IngressCache] {
+	if m.Cache.TTL == "" {
+		return optional.None[*IngressCache]()
+	}
+	return optional.Some(&m.Cache)
+}
+
+// GetRateLimit returns the rate limit of the ingress route, if any.
+func (m *MetadataIngress) GetRateLimit() optional.Option[*IngressRateLimit] {
+	if m.RateLimit.Interval == "" {
+		return optional.None[*IngressRateLimit]()
+	}
+	return optional.Some(&m.RateLimit)
 }
 
 func (m *MetadataIngress) schemaChildren() []Node {
-	out := make([]Node, 0, len(m.Path))
+	out := make([]Node, 0, len(m.Path)+4)
 	for _, ref := range m.Path {
 		out = append(out, ref)
 	}
+	if authorizer, ok := m.GetAuthorizer().Get(); ok {
+		out = append(out, authorizer)
+	}
+	if cors, ok := m.GetCORS().Get(); ok {
+		out = append(out, cors)
+	}
+	if cache, ok := m.GetCache().Get(); ok {
+		out = append(out, cache)
+	}
+	if limit, ok := m.GetRateLimit().Get(); ok {
+		out = append(out, limit)
+	}
 	return out
 }
 
 func (*MetadataIngress) schemaMetadata() {}
 
 func (m *MetadataIngress) ToProto() proto.Message {
+	var authorizer *schemapb.VerbRef
+	if ref, ok := m.GetAuthorizer().Get(); ok {
+		authorizer = ref.ToProto().(*schemapb.VerbRef) //nolint:forcetypeassert
+	}
+	var cors *schemapb.IngressCORS
+	if policy, ok := m.GetCORS().Get(); ok {
+		cors = policy.ToProto().(*schemapb.IngressCORS) //nolint:forcetypeassert
+	}
+	var cache *schemapb.IngressCache
+	if policy, ok := m.GetCache().Get(); ok {
+		cache = policy.ToProto().(*schemapb.IngressCache) //nolint:forcetypeassert
+	}
+	var rateLimit *schemapb.IngressRateLimit
+	if limit, ok := m.GetRateLimit().Get(); ok {
+		rateLimit = limit.ToProto().(*schemapb.IngressRateLimit) //nolint:forcetypeassert
+	}
 	return &schemapb.MetadataIngress{
-		Pos:    posToProto(m.Pos),
-		Type:   m.Type,
-		Method: m.Method,
-		Path:   ingressListToProto(m.Path),
+		Pos:        posToProto(m.Pos),
+		Type:       m.Type,
+		Method:     m.Method,
+		Path:       ingressListToProto(m.Path),
+		Auth:       m.Auth,
+		Authorizer: authorizer,
+		Cors:       cors,
+		Cache:      cache,
+		RateLimit:  rateLimit,
+	}
+}
+
+// IngressCORS is the cross-origin resource sharing policy of an ingress route.
+//
+// eg. cors(origins=["https://example.com"], headers=["Authorization"], credentials, maxage=1h)
+type IngressCORS struct {
+	Pos Position `parser:"" protobuf:"1,optional"`
+
+	// Origins may contain "*" to allow any origin.
+	Origins []string `parser:"'cors' '(' 'origins' '=' '[' @String (',' @String)* ']'" protobuf:"2"`
+	// Methods allowed in preflight requests, in addition to the route's method.
+	Methods []string `parser:"(',' 'methods' '=' '[' @('GET' | 'POST' | 'PUT' | 'DELETE') (',' @('GET' | 'POST' | 'PUT' | 'DELETE'))* ']')?" protobuf:"3"`
+	// Headers allowed in requests.
+	Headers     []string `parser:"(',' 'headers' '=' '[' @String (',' @String)* ']')?" protobuf:"4"`
+	Credentials bool     `parser:"(',' @'credentials')?" protobuf:"5"`
+	// MaxAge is how long browsers may cache the result of a preflight request.
+	MaxAge string `parser:"(',' 'maxage' '=' @Duration)? ')'" protobuf:"6"`
+}
+
+var _ Node = (*IngressCORS)(nil)
+
+func (c *IngressCORS) Position() Position { return c.Pos }
+func (c *IngressCORS) String() string {
+	quote := func(values []string) string {
+		quoted := make([]string, len(values))
+		for i, v := range values {
+			quoted[i] = strconv.Quote(v)
+		}
+		return strings.Join(quoted, ", ")
+	}
+	args := []string{fmt.Sprintf("origins=[%s]", quote(c.Origins))}
+	if len(c.Methods) > 0 {
+		args = append(args, fmt.Sprintf("methods=[%s]", strings.Join(c.Methods, ", ")))
+	}
+	if len(c.Headers) > 0 {
+		args = append(args, fmt.Sprintf("headers=[%s]", quote(c.Headers)))
+	}
+	if c.Credentials {
+		args = append(args, "credentials")
+	}
+	if c.MaxAge != "" {
+		args = append(args, "maxage="+c.MaxAge)
+	}
+	return fmt.Sprintf("cors(%s)", strings.Join(args, ", "))
+}
+func (*IngressCORS) schemaChildren() []Node { return nil }
+func (c *IngressCORS) ToProto() proto.Message {
+	return &schemapb.IngressCORS{
+		Pos:         posToProto(c.Pos),
+		Origins:     c.Origins,
+		Methods:     c.Methods,
+		Headers:     c.Headers,
+		Credentials: c.Credentials,
+		MaxAge:      c.MaxAge,
+	}
+}
+
+// AllowsOrigin returns true if the policy allows requests from origin.
+func (c *IngressCORS) AllowsOrigin(origin string) bool {
+	for _, allowed := range c.Origins {
+		if allowed == "*" || strings.EqualFold(allowed, origin) {
+			return true
+		}
 	}
+	return false
 }
+
+func ingressCORSFromProto(s *schemapb.IngressCORS) IngressCORS {
+	if s == nil {
+		return IngressCORS{}
+	}
+	return IngressCORS{
+		Pos:         posFromProto(s.Pos),
+		Origins:     s.Origins,
+		Methods:     s.Methods,
+		Headers:     s.Headers,
+		Credentials: s.Credentials,
+		MaxAge:      s.MaxAge,
+	}
+}
+
+// IngressCache is the response cache policy of a GET ingress route.
+//
+// Responses are cached for TTL, after which they may be served for up to
+// StaleWhileRevalidate while they are refreshed in the background.
+//
+// eg. cache(ttl=5m, vary=["Accept-Language"], stale=1m)
+type IngressCache struct {
+	Pos Position `parser:"" protobuf:"1,optional"`
+
+	TTL string `parser:"'cache' '(' 'ttl' '=' @Duration" protobuf:"2"`
+	// Vary lists request headers that responses depend on.
+	Vary                 []string `parser:"(',' 'vary' '=' '[' @String (',' @String)* ']')?" protobuf:"3"`
+	StaleWhileRevalidate string   `parser:"(',' 'stale' '=' @Duration)? ')'" protobuf:"4"`
+}
+
+var _ Node = (*IngressCache)(nil)
+
+func (c *IngressCache) Position() Position { return c.Pos }
+func (c *IngressCache) String() string {
+	args := []string{"ttl=" + c.TTL}
+	if len(c.Vary) > 0 {
+		vary := make([]string, len(c.Vary))
+		for i, header := range c.Vary {
+			vary[i] = strconv.Quote(header)
+		}
+		args = append(args, fmt.Sprintf("vary=[%s]", strings.Join(vary, ", ")))
+	}
+	if c.StaleWhileRevalidate != "" {
+		args = append(args, "stale="+c.StaleWhileRevalidate)
+	}
+	return fmt.Sprintf("cache(%s)", strings.Join(args, ", "))
+}
+func (*IngressCache) schemaChildren() []Node { return nil }
+func (c *IngressCache) ToProto() proto.Message {
+	return &schemapb.IngressCache{
+		Pos:                  posToProto(c.Pos),
+		Ttl:                  c.TTL,
+		Vary:                 c.Vary,
+		StaleWhileRevalidate: c.StaleWhileRevalidate,
+	}
+}
+
+// Durations returns how long responses are fresh for, and how long they may
+// be served stale for after that.
+func (c *IngressCache) Durations() (ttl, stale time.Duration, err error) {
+	ttl, err = time.ParseDuration(c.TTL)
+	if err != nil {
+		return 0, 0, fmt.Errorf("invalid cache TTL: %w", err)
+	}
+	if ttl <= 0 {
+		return 0, 0, fmt.Errorf("cache TTL %s must be positive", c.TTL)
+	}
+	if c.StaleWhileRevalidate != "" {
+		stale, err = time.ParseDuration(c.StaleWhileRevalidate)
+		if err != nil {
+			return 0, 0, fmt.Errorf("invalid cache stale duration: %w", err)
+		}
+	}
+	return ttl, stale, nil
+}
+
+func ingressCacheFromProto(s *schemapb.IngressCache) IngressCache {
+	if s == nil {
+		return IngressCache{}
+	}
+	return IngressCache{
+		Pos:                  posFromProto(s.Pos),
+		TTL:                  s.Ttl,
+		Vary:                 s.Vary,
+		StaleWhileRevalidate: s.StaleWhileRevalidate,
+	}
+}
+
+// IngressRateLimit limits the rate of requests to an ingress route, in
+// aggregate or per client. See MetadataRateLimit, which limits calls to a Verb
+// from any source.
+//
+// eg. ratelimit(100/1m, burst=20, by=header "X-Api-Key")
+type IngressRateLimit struct {
+	Pos Position `parser:"" protobuf:"1,optional"`
+
+	Rate     int    `parser:"'ratelimit' '(' @Number" protobuf:"2"`
+	Interval string `parser:"'/' @Duration" protobuf:"3"`
+	// Burst defaults to Rate if zero.
+	Burst int `parser:"(',' 'burst' '=' @Number)?" protobuf:"4"`
+	// Key is "ip", "header", or empty to limit requests in aggregate.
+	Key    string `parser:"(',' 'by' '=' @('ip' | 'header')" protobuf:"5"`
+	Header string `parser:"@String?)? ')'" protobuf:"6"`
+}
+
+var _ Node = (*IngressRateLimit)(nil)
+
+func (r *IngressRateLimit) Position() Position { return r.Pos }
+func (r *IngressRateLimit) String() string {
+	args := []string{fmt.Sprintf("%d/%s", r.Rate, r.Interval)}
+	if r.Burst != 0 {
+		args = append(args, fmt.Sprintf("burst=%d", r.Burst))
+	}
+	switch r.Key {
+	case "ip":
+		args = append(args, "by=ip")
+	case "header":
+		args = append(args, "by=header "+strconv.Quote(r.Header))
+	}
+	return fmt.Sprintf("ratelimit(%s)", strings.Join(args, ", "))
+}
+func (*IngressRateLimit) schemaChildren() []Node { return nil }
+func (r *IngressRateLimit) ToProto() proto.Message {
+	return &schemapb.IngressRateLimit{
+		Pos:      posToProto(r.Pos),
+		Rate:     int64(r.Rate),
+		Interval: r.Interval,
+		Burst:    int64(r.Burst),
+		Key:      r.Key,
+		Header:   r.Header,
+	}
+}
+
+// MetadataRateLimit returns the equivalent limit on calls to a Verb, which
+// shares its validation and bucket parameters.
+func (r *IngressRateLimit) MetadataRateLimit() *MetadataRateLimit {
+	return &MetadataRateLimit{
+		Pos:      r.Pos,
+		Rate:     r.Rate,
+		Interval: r.Interval,
+		Burst:    r.Burst,
+		Key:      r.Key,
+		Header:   r.Header,
+	}
+}
+
+func ingressRateLimitFromProto(s *schemapb.IngressRateLimit) IngressRateLimit {
+	if s == nil {
+		return IngressRateLimit{}
+	}
+	return IngressRateLimit{
+		Pos:      posFromProto(s.Pos),
+		Rate:     int(s.Rate),
+		Interval: s.Interval,
+		Burst:    int(s.Burst),
+		Key:      s.Key,
+		Header:   s.Header,
+	}
+}
+
 func ingressPathComponentListToSchema(s []*schemapb.IngressPathComponent) []IngressPathComponent {
 	var out []IngressPathComponent
 	for _, n := range s {
@@ -62,8 +403,9 @@ func ingressPathComponentListToSchema(s []*schemapb.IngressPathComponent) []Ingr
 			})
 		case *schemapb.IngressPathComponent_IngressPathParameter:
 			out = append(out, &IngressPathParameter{
-				Pos:  posFromProto(n.IngressPathParameter.Pos),
-				Name: n.IngressPathParameter.Name,
+				Pos:      posFromProto(n.IngressPathParameter.Pos),
+				Name:     n.IngressPathParameter.Name,
+				CatchAll: n.IngressPathParameter.CatchAll,
 			})
 		}
 	}
@@ -92,10 +434,13 @@ func (l *IngressPathLiteral) ToProto() proto.Message {
 	return &schemapb.IngressPathLiteral{Text: l.Text}
 }
 
+// IngressPathParameter is a "{name}" path segment, or a "{name...}" segment
+// matching the remainder of the path if CatchAll is set.
 type IngressPathParameter struct {
 	Pos Position `parser:"" protobuf:"1,optional"`
 
-	Name string `parser:"'{' @Ident '}'" protobuf:"2"`
+	Name     string `parser:"'{' @Ident" protobuf:"2"`
+	CatchAll bool   `parser:"@('.' '.' '.')? '}'" protobuf:"3"`
 }
 
 var _ IngressPathComponent = (*IngressPathParameter)(nil)
@@ -105,5 +450,13 @@ func (l *IngressPathParameter) String() string            { return l.Name }
 func (*IngressPathParameter) schemaChildren() []Node      { return nil }
 func (*IngressPathParameter) schemaIngressPathComponent() {}
 func (l *IngressPathParameter) ToProto() proto.Message {
-	return &schemapb.IngressPathParameter{Name: l.Name}
+	return &schemapb.IngressPathParameter{Name: l.Name, CatchAll: l.CatchAll}
+}
+
+// Pattern returns the parameter as it appears in an ingress path.
+func (l *IngressPathParameter) Pattern() string {
+	if l.CatchAll {
+		return fmt.Sprintf("{%s...}", l.Name)
+	}
+	return fmt.Sprintf("{%s}", l.Name)
 }
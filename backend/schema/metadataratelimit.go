@@ -0,0 +1,90 @@
+package schema
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+
+	schemapb "github.com/TBD54566975/ftl/backend/protos/xyz/block/ftl/v1/schema"
+)
+
+// MetadataRateLimit limits the rate of calls to a Verb with a token bucket
+// that refills with Rate tokens every Interval and holds at most Burst tokens.
+//
+// Calls are limited in aggregate, or separately per client IP address or per
+// value of a request header.
+//
+// eg. ratelimit 100/1m burst 20 by header "X-Api-Key"
+type MetadataRateLimit struct {
+	Pos Position `parser:"" protobuf:"1,optional"`
+
+	Rate     int    `parser:"'ratelimit' @Number" protobuf:"2"`
+	Interval string `parser:"'/' @Duration" protobuf:"3"`
+	// Burst defaults to Rate if zero.
+	Burst int `parser:"('burst' @Number)?" protobuf:"4"`
+	// Key is "ip", "header", or empty to limit calls in aggregate.
+	Key    string `parser:"('by' @('ip' | 'header')" protobuf:"5"`
+	Header string `parser:"@String?)?" protobuf:"6"`
+}
+
+var _ Metadata = (*MetadataRateLimit)(nil)
+
+func (m *MetadataRateLimit) Position() Position { return m.Pos }
+func (m *MetadataRateLimit) String() string {
+	w := &strings.Builder{}
+	fmt.Fprintf(w, "ratelimit %d/%s", m.Rate, m.Interval)
+	if m.Burst != 0 {
+		fmt.Fprintf(w, " burst %d", m.Burst)
+	}
+	switch m.Key {
+	case "ip":
+		fmt.Fprint(w, " by ip")
+	case "header":
+		fmt.Fprintf(w, " by header %s", strconv.Quote(m.Header))
+	}
+	fmt.Fprintln(w)
+	return w.String()
+}
+
+// Limit returns the number of tokens added to the bucket per interval, the
+// interval, and the capacity of the bucket.
+func (m *MetadataRateLimit) Limit() (rate int, interval time.Duration, burst int, err error) {
+	if m.Rate < 1 {
+		return 0, 0, 0, fmt.Errorf("rate limit %d must be positive", m.Rate)
+	}
+	interval, err = time.ParseDuration(m.Interval)
+	if err != nil {
+		return 0, 0, 0, fmt.Errorf("invalid rate limit interval: %w", err)
+	}
+	if interval <= 0 {
+		return 0, 0, 0, fmt.Errorf("rate limit interval %s must be positive", m.Interval)
+	}
+	burst = m.Burst
+	if burst == 0 {
+		burst = m.Rate
+	}
+	switch {
+	case m.Key == "header" && m.Header == "":
+		return 0, 0, 0, fmt.Errorf("rate limit by header requires a header name")
+	case m.Key != "header" && m.Header != "":
+		return 0, 0, 0, fmt.Errorf("rate limit header %q is only valid when limiting by header", m.Header)
+	}
+	return m.Rate, interval, burst, nil
+}
+
+func (m *MetadataRateLimit) schemaChildren() []Node { return nil }
+func (*MetadataRateLimit) schemaMetadata()          {}
+
+func (m *MetadataRateLimit) ToProto() proto.Message {
+	return &schemapb.MetadataRateLimit{
+		Pos:      posToProto(m.Pos),
+		Rate:     int64(m.Rate),
+		Interval: m.Interval,
+		Burst:    int64(m.Burst),
+		Key:      m.Key,
+		Header:   m.Header,
+	}
+}
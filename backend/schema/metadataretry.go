@@ -0,0 +1,74 @@
+package schema
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+
+	schemapb "github.com/TBD54566975/ftl/backend/protos/xyz/block/ftl/v1/schema"
+)
+
+// MetadataRetry retries failed calls to a Verb with exponential backoff.
+//
+// Only calls that fail because the Verb is unavailable are retried.
+//
+// eg. retry 3 backoff 1s..30s
+type MetadataRetry struct {
+	Pos Position `parser:"" protobuf:"1,optional"`
+
+	Count      int    `parser:"'retry' @Number" protobuf:"2"`
+	MinBackoff string `parser:"('backoff' @Duration" protobuf:"3"`
+	MaxBackoff string `parser:"'.' '.' @Duration)?" protobuf:"4"`
+}
+
+var _ Metadata = (*MetadataRetry)(nil)
+
+func (m *MetadataRetry) Position() Position { return m.Pos }
+func (m *MetadataRetry) String() string {
+	w := &strings.Builder{}
+	fmt.Fprintf(w, "retry %d", m.Count)
+	if m.MinBackoff != "" {
+		fmt.Fprintf(w, " backoff %s..%s", m.MinBackoff, m.MaxBackoff)
+	}
+	fmt.Fprintln(w)
+	return w.String()
+}
+
+// Default backoff between retries if not specified.
+const (
+	DefaultMinRetryBackoff = time.Second
+	DefaultMaxRetryBackoff = time.Second * 30
+)
+
+// Backoff returns the minimum and maximum backoff between retries.
+func (m *MetadataRetry) Backoff() (min, max time.Duration, err error) {
+	if m.MinBackoff == "" {
+		return DefaultMinRetryBackoff, DefaultMaxRetryBackoff, nil
+	}
+	min, err = time.ParseDuration(m.MinBackoff)
+	if err != nil {
+		return 0, 0, fmt.Errorf("invalid minimum backoff: %w", err)
+	}
+	max, err = time.ParseDuration(m.MaxBackoff)
+	if err != nil {
+		return 0, 0, fmt.Errorf("invalid maximum backoff: %w", err)
+	}
+	if min > max {
+		return 0, 0, fmt.Errorf("minimum backoff %s is greater than maximum backoff %s", m.MinBackoff, m.MaxBackoff)
+	}
+	return min, max, nil
+}
+
+func (m *MetadataRetry) schemaChildren() []Node { return nil }
+func (*MetadataRetry) schemaMetadata()          {}
+
+func (m *MetadataRetry) ToProto() proto.Message {
+	return &schemapb.MetadataRetry{
+		Pos:        posToProto(m.Pos),
+		Count:      int64(m.Count),
+		MinBackoff: m.MinBackoff,
+		MaxBackoff: m.MaxBackoff,
+	}
+}
@@ -0,0 +1,48 @@
+package schema
+
+import (
+	"fmt"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+
+	schemapb "github.com/TBD54566975/ftl/backend/protos/xyz/block/ftl/v1/schema"
+)
+
+// MetadataTimeout bounds the time a single call to a Verb may take.
+//
+// eg. timeout 5s
+type MetadataTimeout struct {
+	Pos Position `parser:"" protobuf:"1,optional"`
+
+	Timeout string `parser:"'timeout' @Duration" protobuf:"2"`
+}
+
+var _ Metadata = (*MetadataTimeout)(nil)
+
+func (m *MetadataTimeout) Position() Position { return m.Pos }
+func (m *MetadataTimeout) String() string {
+	return fmt.Sprintf("timeout %s\n", m.Timeout)
+}
+
+// Duration returns the parsed timeout.
+func (m *MetadataTimeout) Duration() (time.Duration, error) {
+	timeout, err := time.ParseDuration(m.Timeout)
+	if err != nil {
+		return 0, fmt.Errorf("invalid timeout: %w", err)
+	}
+	if timeout <= 0 {
+		return 0, fmt.Errorf("timeout %s must be positive", m.Timeout)
+	}
+	return timeout, nil
+}
+
+func (m *MetadataTimeout) schemaChildren() []Node { return nil }
+func (*MetadataTimeout) schemaMetadata()          {}
+
+func (m *MetadataTimeout) ToProto() proto.Message {
+	return &schemapb.MetadataTimeout{
+		Pos:     posToProto(m.Pos),
+		Timeout: m.Timeout,
+	}
+}
@@ -57,6 +57,12 @@ func (m *Module) Scope() Scope {
 		case *Enum:
 			scope[d.Name] = ModuleDecl{m, d}
 
+		case *Topic:
+			scope[d.Name] = ModuleDecl{m, d}
+
+		case *Subscription:
+			scope[d.Name] = ModuleDecl{m, d}
+
 		case *Bool, *Bytes, *Database, *Float, *Int, *Module, *String, *Time,
 			*Unit, *Any, *TypeParameter:
 		}
@@ -83,6 +89,14 @@ func (m *Module) Resolve(ref Ref) *ModuleDecl {
 			if d.Name == ref.Name {
 				return &ModuleDecl{m, d}
 			}
+		case *Topic:
+			if d.Name == ref.Name {
+				return &ModuleDecl{m, d}
+			}
+		case *Subscription:
+			if d.Name == ref.Name {
+				return &ModuleDecl{m, d}
+			}
 
 		case *Bool, *Bytes, *Database, *Float, *Int, *Module, *String, *Time,
 			*Unit, *Any, *TypeParameter:
@@ -168,6 +182,11 @@ func (m *Module) Imports() []string {
 				imports[n.Module] = true
 			}
 
+		case *TopicRef:
+			if n.Module != "" && n.Module != m.Name {
+				imports[n.Module] = true
+			}
+
 		default:
 		}
 		return next()
@@ -114,6 +114,27 @@ func Normalise[T Node](n T) T {
 	case *MetadataIngress:
 		c.Pos = zero
 		c.Path = normaliseSlice(c.Path)
+		if c.Authorizer != nil {
+			c.Authorizer = Normalise(c.Authorizer)
+		}
+		c.CORS.Pos = zero
+		c.Cache.Pos = zero
+		c.RateLimit.Pos = zero
+
+	case *MetadataCron:
+		c.Pos = zero
+
+	case *MetadataSubscriber:
+		c.Pos = zero
+
+	case *MetadataRetry:
+		c.Pos = zero
+
+	case *MetadataTimeout:
+		c.Pos = zero
+
+	case *MetadataRateLimit:
+		c.Pos = zero
 
 	case *Optional:
 		c.Type = Normalise(c.Type)
@@ -124,12 +145,32 @@ func Normalise[T Node](n T) T {
 	case *IngressPathParameter:
 		c.Pos = zero
 
+	case *IngressCORS:
+		c.Pos = zero
+
+	case *IngressCache:
+		c.Pos = zero
+
+	case *IngressRateLimit:
+		c.Pos = zero
+
 	case *SourceRef:
 		c.Pos = zero
 
 	case *SinkRef:
 		c.Pos = zero
 
+	case *Subscription:
+		c.Pos = zero
+		c.Topic = Normalise(c.Topic)
+
+	case *Topic:
+		c.Pos = zero
+		c.Event = Normalise(c.Event)
+
+	case *TopicRef:
+		c.Pos = zero
+
 	case Decl, Metadata, IngressPathComponent, Type, Value: // Can never occur in reality, but here to satisfy the sum-type check.
 		panic("??")
 	}
@@ -12,7 +12,7 @@ import (
 )
 
 var (
-	declUnion            = []Decl{&Data{}, &Verb{}, &Database{}, &Enum{}}
+	declUnion            = []Decl{&Data{}, &Verb{}, &Database{}, &Enum{}, &Topic{}, &Subscription{}}
 	nonOptionalTypeUnion = []Type{
 		&Int{}, &Float{}, &String{}, &Bytes{}, &Bool{}, &Time{}, &Array{},
 		&Map{}, &Any{}, &Unit{},
@@ -21,13 +21,14 @@ var (
 		&DataRef{},
 	}
 	typeUnion     = append(nonOptionalTypeUnion, &Optional{})
-	metadataUnion = []Metadata{&MetadataCalls{}, &MetadataIngress{}, &MetadataDatabases{}}
+	metadataUnion = []Metadata{&MetadataCalls{}, &MetadataIngress{}, &MetadataDatabases{}, &MetadataCron{}, &MetadataSubscriber{}, &MetadataRetry{}, &MetadataTimeout{}, &MetadataRateLimit{}}
 	ingressUnion  = []IngressPathComponent{&IngressPathLiteral{}, &IngressPathParameter{}}
 	valueUnion    = []Value{&StringValue{}, &IntValue{}}
 
 	// Used by protobuf generation.
 	unions = map[reflect.Type][]reflect.Type{
-		reflect.TypeOf((*Type)(nil)).Elem():                 reflectUnion(typeUnion...),
+		// EnumRefs are parsed as DataRefs, see resolveEnumRefs.
+		reflect.TypeOf((*Type)(nil)).Elem():                 append(reflectUnion(typeUnion...), reflect.TypeOf(&EnumRef{})),
 		reflect.TypeOf((*Metadata)(nil)).Elem():             reflectUnion(metadataUnion...),
 		reflect.TypeOf((*IngressPathComponent)(nil)).Elem(): reflectUnion(ingressUnion...),
 		reflect.TypeOf((*Decl)(nil)).Elem():                 reflectUnion(declUnion...),
@@ -39,8 +40,9 @@ var (
 		{Name: "Ident", Pattern: `\b[a-zA-Z_][a-zA-Z0-9_]*\b`},
 		{Name: "Comment", Pattern: `//.*`},
 		{Name: "String", Pattern: `"(?:\\.|[^"])*"`},
+		{Name: "Duration", Pattern: `(?:[0-9]+(?:\.[0-9]+)?(?:ns|us|ms|s|m|h))+\b`},
 		{Name: "Number", Pattern: `[0-9]+(?:\.[0-9]+)?`},
-		{Name: "Punct", Pattern: `[%/\-\_:[\]{}<>()*+?.,\\^$|#~!\'@]`},
+		{Name: "Punct", Pattern: `[%/\-\_:[\]{}<>()*+?.,\\^$|#~!\'@=]`},
 	})
 
 	commonParserOptions = []participle.Option{
@@ -149,6 +151,7 @@ func ParseString(filename, input string) (*Schema, error) {
 	if err != nil {
 		return nil, err
 	}
+	resolveEnumRefs(mod.Modules...)
 	return Validate(mod)
 }
 
@@ -157,6 +160,7 @@ func ParseModuleString(filename, input string) (*Module, error) {
 	if err != nil {
 		return nil, err
 	}
+	resolveEnumRefs(mod)
 	return mod, ValidateModule(mod)
 }
 
@@ -165,6 +169,7 @@ func Parse(filename string, r io.Reader) (*Schema, error) {
 	if err != nil {
 		return nil, err
 	}
+	resolveEnumRefs(mod.Modules...)
 	return Validate(mod)
 }
 
@@ -173,5 +178,63 @@ func ParseModule(filename string, r io.Reader) (*Module, error) {
 	if err != nil {
 		return nil, err
 	}
+	resolveEnumRefs(mod)
 	return mod, ValidateModule(mod)
 }
+
+// References to enums are syntactically indistinguishable from references to
+// data structures, so they are parsed as DataRefs and converted to EnumRefs
+// once all enums in the given modules are known.
+func resolveEnumRefs(modules ...*Module) {
+	enums := map[Ref]bool{}
+	for _, module := range modules {
+		for _, decl := range module.Decls {
+			if enum, ok := decl.(*Enum); ok {
+				enums[Ref{Module: module.Name, Name: enum.Name}] = true
+			}
+		}
+	}
+	if len(enums) == 0 {
+		return
+	}
+	for _, module := range modules {
+		resolve := func(t Type) Type {
+			ref, ok := t.(*DataRef)
+			if !ok || len(ref.TypeParameters) > 0 {
+				return t
+			}
+			key := Ref{Module: ref.Module, Name: ref.Name}
+			if key.Module == "" {
+				key.Module = module.Name
+			}
+			if !enums[key] {
+				return t
+			}
+			return &EnumRef{Pos: ref.Pos, Module: ref.Module, Name: ref.Name}
+		}
+		_ = Visit(module, func(n Node, next func() error) error {
+			switch n := n.(type) {
+			case *Field:
+				n.Type = resolve(n.Type)
+			case *Array:
+				n.Element = resolve(n.Element)
+			case *Map:
+				n.Key = resolve(n.Key)
+				n.Value = resolve(n.Value)
+			case *Optional:
+				n.Type = resolve(n.Type)
+			case *Verb:
+				n.Request = resolve(n.Request)
+				n.Response = resolve(n.Response)
+			case *DataRef:
+				for i, t := range n.TypeParameters {
+					n.TypeParameters[i] = resolve(t)
+				}
+			case *Topic:
+				n.Event = resolve(n.Event)
+			default:
+			}
+			return next()
+		})
+	}
+}
@@ -25,6 +25,7 @@ func ProtobufSchema() string {
 	generateMessage(reflect.TypeOf(SourceRef{}), messages)
 	generateMessage(reflect.TypeOf(SinkRef{}), messages)
 	generateMessage(reflect.TypeOf(EnumRef{}), messages)
+	generateMessage(reflect.TypeOf(TopicRef{}), messages)
 	keys := maps.Keys(messages)
 	slices.Sort(keys)
 	w := &strings.Builder{}
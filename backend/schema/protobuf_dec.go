@@ -29,6 +29,10 @@ func declListToSchema(s []*schemapb.Decl) []Decl {
 			out = append(out, DatabaseFromProto(n.Database))
 		case *schemapb.Decl_Enum:
 			out = append(out, EnumFromProto(n.Enum))
+		case *schemapb.Decl_Topic:
+			out = append(out, TopicFromProto(n.Topic))
+		case *schemapb.Decl_Subscription:
+			out = append(out, SubscriptionFromProto(n.Subscription))
 		}
 	}
 	return out
@@ -40,6 +44,8 @@ func typeToSchema(s *schemapb.Type) Type {
 	// 	return verbRefToSchema(s.VerbRef)
 	case *schemapb.Type_DataRef:
 		return DataRefFromProto(s.DataRef)
+	case *schemapb.Type_EnumRef:
+		return EnumRefFromProto(s.EnumRef)
 	case *schemapb.Type_Int:
 		return &Int{Pos: posFromProto(s.Int.Pos)}
 	case *schemapb.Type_Float:
@@ -105,11 +111,56 @@ func metadataToSchema(s *schemapb.Metadata) Metadata {
 		}
 
 	case *schemapb.Metadata_Ingress:
+		var authorizer *VerbRef
+		if s.Ingress.Authorizer != nil {
+			authorizer = VerbRefFromProto(s.Ingress.Authorizer)
+		}
 		return &MetadataIngress{
-			Pos:    posFromProto(s.Ingress.Pos),
-			Type:   s.Ingress.Type,
-			Method: s.Ingress.Method,
-			Path:   ingressPathComponentListToSchema(s.Ingress.Path),
+			Pos:        posFromProto(s.Ingress.Pos),
+			Type:       s.Ingress.Type,
+			Method:     s.Ingress.Method,
+			Path:       ingressPathComponentListToSchema(s.Ingress.Path),
+			Auth:       s.Ingress.Auth,
+			Authorizer: authorizer,
+			CORS:       ingressCORSFromProto(s.Ingress.Cors),
+			Cache:      ingressCacheFromProto(s.Ingress.Cache),
+			RateLimit:  ingressRateLimitFromProto(s.Ingress.RateLimit),
+		}
+
+	case *schemapb.Metadata_Cron:
+		return &MetadataCron{
+			Pos:  posFromProto(s.Cron.Pos),
+			Cron: s.Cron.Cron,
+		}
+
+	case *schemapb.Metadata_Subscriber:
+		return &MetadataSubscriber{
+			Pos:  posFromProto(s.Subscriber.Pos),
+			Name: s.Subscriber.Name,
+		}
+
+	case *schemapb.Metadata_Retry:
+		return &MetadataRetry{
+			Pos:        posFromProto(s.Retry.Pos),
+			Count:      int(s.Retry.Count),
+			MinBackoff: s.Retry.MinBackoff,
+			MaxBackoff: s.Retry.MaxBackoff,
+		}
+
+	case *schemapb.Metadata_RateLimit:
+		return &MetadataRateLimit{
+			Pos:      posFromProto(s.RateLimit.Pos),
+			Rate:     int(s.RateLimit.Rate),
+			Interval: s.RateLimit.Interval,
+			Burst:    int(s.RateLimit.Burst),
+			Key:      s.RateLimit.Key,
+			Header:   s.RateLimit.Header,
+		}
+
+	case *schemapb.Metadata_Timeout:
+		return &MetadataTimeout{
+			Pos:     posFromProto(s.Timeout.Pos),
+			Timeout: s.Timeout.Timeout,
 		}
 
 	default:
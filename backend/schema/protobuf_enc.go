@@ -38,6 +38,12 @@ func declListToProto(nodes []Decl) []*schemapb.Decl {
 		case *Enum:
 			v = &schemapb.Decl_Enum{Enum: n.ToProto().(*schemapb.Enum)}
 
+		case *Topic:
+			v = &schemapb.Decl_Topic{Topic: n.ToProto().(*schemapb.Topic)}
+
+		case *Subscription:
+			v = &schemapb.Decl_Subscription{Subscription: n.ToProto().(*schemapb.Subscription)}
+
 		case *Bool, *Bytes, *Float, *Int, *Module, *String, *Time, *Unit, *Any,
 			*TypeParameter:
 		}
@@ -60,6 +66,21 @@ func metadataListToProto(nodes []Metadata) []*schemapb.Metadata {
 		case *MetadataIngress:
 			v = &schemapb.Metadata_Ingress{Ingress: n.ToProto().(*schemapb.MetadataIngress)}
 
+		case *MetadataCron:
+			v = &schemapb.Metadata_Cron{Cron: n.ToProto().(*schemapb.MetadataCron)}
+
+		case *MetadataSubscriber:
+			v = &schemapb.Metadata_Subscriber{Subscriber: n.ToProto().(*schemapb.MetadataSubscriber)}
+
+		case *MetadataRetry:
+			v = &schemapb.Metadata_Retry{Retry: n.ToProto().(*schemapb.MetadataRetry)}
+
+		case *MetadataTimeout:
+			v = &schemapb.Metadata_Timeout{Timeout: n.ToProto().(*schemapb.MetadataTimeout)}
+
+		case *MetadataRateLimit:
+			v = &schemapb.Metadata_RateLimit{RateLimit: n.ToProto().(*schemapb.MetadataRateLimit)}
+
 		default:
 			panic(fmt.Sprintf("unhandled metadata type %T", n))
 		}
@@ -92,12 +113,15 @@ func typeToProto(t Type) *schemapb.Type {
 	case *Unit:
 		return &schemapb.Type{Value: &schemapb.Type_Unit{Unit: t.ToProto().(*schemapb.Unit)}}
 
-	case *VerbRef, *SourceRef, *SinkRef, *EnumRef:
+	case *VerbRef, *SourceRef, *SinkRef, *TopicRef:
 		panic("unreachable")
 
 	case *DataRef:
 		return &schemapb.Type{Value: &schemapb.Type_DataRef{DataRef: t.ToProto().(*schemapb.DataRef)}}
 
+	case *EnumRef:
+		return &schemapb.Type{Value: &schemapb.Type_EnumRef{EnumRef: t.ToProto().(*schemapb.EnumRef)}}
+
 	case *Int:
 		return &schemapb.Type{Value: &schemapb.Type_Int{Int: t.ToProto().(*schemapb.Int)}}
 
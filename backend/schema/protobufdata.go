@@ -0,0 +1,268 @@
+package schema
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"golang.org/x/exp/maps"
+	"golang.org/x/exp/slices"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/descriptorpb"
+	_ "google.golang.org/protobuf/types/known/emptypb"     // Register google.protobuf.Empty.
+	_ "google.golang.org/protobuf/types/known/structpb"    // Register google.protobuf.Value.
+	_ "google.golang.org/protobuf/types/known/timestamppb" // Register google.protobuf.Timestamp.
+)
+
+// DataProtobufPackage is the protobuf package of messages mirroring data
+// structures.
+const DataProtobufPackage = "ftl.data"
+
+var invalidProtobufNameRe = regexp.MustCompile(`[^A-Za-z0-9_]+`)
+
+// DataProtobufFile builds a protobuf file with messages mirroring data
+// structures and any data structures they reference.
+//
+// Fields are numbered in declaration order starting at 1, Optional scalars are
+// proto3 optional fields, Time is a google.protobuf.Timestamp, Any is a
+// google.protobuf.Value and Unit is a google.protobuf.Empty.
+func DataProtobufFile(sch *Schema, refs ...*DataRef) (protoreflect.FileDescriptor, error) {
+	builder := &protobufBuilder{sch: sch, messages: map[string]*descriptorpb.DescriptorProto{}, deps: map[string]bool{}}
+	for _, ref := range refs {
+		if _, err := builder.message(ref); err != nil {
+			return nil, err
+		}
+	}
+	deps := maps.Keys(builder.deps)
+	slices.Sort(deps)
+	file := &descriptorpb.FileDescriptorProto{
+		Name:        proto.String(strings.ReplaceAll(DataProtobufPackage, ".", "/") + ".proto"),
+		Package:     proto.String(DataProtobufPackage),
+		Syntax:      proto.String("proto3"),
+		Dependency:  deps,
+		MessageType: builder.order,
+	}
+	fd, err := protodesc.NewFile(file, protoregistry.GlobalFiles)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build protobuf descriptor: %w", err)
+	}
+	return fd, nil
+}
+
+// DataProtobufMessage returns the protobuf message mirroring a data structure.
+func DataProtobufMessage(sch *Schema, ref *DataRef) (protoreflect.MessageDescriptor, error) {
+	fd, err := DataProtobufFile(sch, ref)
+	if err != nil {
+		return nil, err
+	}
+	return fd.Messages().ByName(protoreflect.Name(protobufMessageName(ref))), nil
+}
+
+// DataProtobufSchema returns the protobuf schema of the messages mirroring
+// data structures.
+func DataProtobufSchema(sch *Schema, refs ...*DataRef) (string, error) {
+	fd, err := DataProtobufFile(sch, refs...)
+	if err != nil {
+		return "", err
+	}
+	w := &strings.Builder{}
+	fmt.Fprintf(w, "syntax = \"proto3\";\n\npackage %s;\n", fd.Package())
+	if fd.Imports().Len() > 0 {
+		w.WriteString("\n")
+		for i := range fd.Imports().Len() {
+			fmt.Fprintf(w, "import %q;\n", fd.Imports().Get(i).Path())
+		}
+	}
+	for i := range fd.Messages().Len() {
+		msg := fd.Messages().Get(i)
+		fmt.Fprintf(w, "\nmessage %s {", msg.Name())
+		for j := range msg.Fields().Len() {
+			field := msg.Fields().Get(j)
+			label := ""
+			switch {
+			case field.IsMap():
+			case field.IsList():
+				label = "repeated "
+			case field.HasOptionalKeyword():
+				label = "optional "
+			}
+			fmt.Fprintf(w, "\n  %s%s %s = %d", label, protobufFieldTypeName(field), field.Name(), field.Number())
+			if field.JSONName() != string(field.Name()) {
+				fmt.Fprintf(w, " [json_name = %q]", field.JSONName())
+			}
+			w.WriteString(";")
+		}
+		if msg.Fields().Len() > 0 {
+			w.WriteString("\n")
+		}
+		w.WriteString("}\n")
+	}
+	return w.String(), nil
+}
+
+func protobufFieldTypeName(field protoreflect.FieldDescriptor) string {
+	if field.IsMap() {
+		return fmt.Sprintf("map<%s, %s>", protobufFieldTypeName(field.MapKey()), protobufFieldTypeName(field.MapValue()))
+	}
+	if field.Kind() != protoreflect.MessageKind {
+		return field.Kind().String()
+	}
+	name := field.Message().FullName()
+	if name.Parent() == DataProtobufPackage {
+		return string(name.Name())
+	}
+	return string(name)
+}
+
+func protobufMessageName(ref *DataRef) string {
+	name := invalidProtobufNameRe.ReplaceAllString(strings.ReplaceAll(ref.String(), ".", "_"), "_")
+	return strings.Trim(name, "_")
+}
+
+type protobufBuilder struct {
+	sch      *Schema
+	messages map[string]*descriptorpb.DescriptorProto
+	order    []*descriptorpb.DescriptorProto
+	// deps are the well-known protobuf files the messages import.
+	deps map[string]bool
+}
+
+// message adds the message mirroring a data structure, returning its name.
+func (b *protobufBuilder) message(ref *DataRef) (string, error) {
+	name := protobufMessageName(ref)
+	if _, ok := b.messages[name]; ok {
+		return name, nil
+	}
+	data, err := b.sch.ResolveDataRefMonomorphised(ref)
+	if err != nil {
+		return "", err
+	}
+	msg := &descriptorpb.DescriptorProto{Name: proto.String(name)}
+	b.messages[name] = msg
+	b.order = append(b.order, msg)
+	for i, field := range data.Fields {
+		jsonName := field.Name
+		if field.JSONAlias != "" {
+			jsonName = field.JSONAlias
+		}
+		fd := &descriptorpb.FieldDescriptorProto{
+			Name:     proto.String(field.Name),
+			JsonName: proto.String(jsonName),
+			Number:   proto.Int32(int32(i + 1)),
+			Label:    descriptorpb.FieldDescriptorProto_LABEL_OPTIONAL.Enum(),
+		}
+		typ := field.Type
+		opt, isOptional := typ.(*Optional)
+		if isOptional {
+			typ = opt.Type
+		}
+		switch t := typ.(type) {
+		case *Array:
+			fd.Label = descriptorpb.FieldDescriptorProto_LABEL_REPEATED.Enum()
+			if err := b.fieldType(fd, t.Element, field.Name); err != nil {
+				return "", err
+			}
+
+		case *Map:
+			entry, err := b.mapEntry(field.Name, t)
+			if err != nil {
+				return "", err
+			}
+			msg.NestedType = append(msg.NestedType, entry)
+			fd.Label = descriptorpb.FieldDescriptorProto_LABEL_REPEATED.Enum()
+			fd.Type = descriptorpb.FieldDescriptorProto_TYPE_MESSAGE.Enum()
+			fd.TypeName = proto.String("." + DataProtobufPackage + "." + name + "." + entry.GetName())
+
+		default:
+			if err := b.fieldType(fd, typ, field.Name); err != nil {
+				return "", err
+			}
+			// Data structures have no other oneofs, so the synthetic oneofs
+			// of proto3 optional fields are always last as required.
+			if isOptional && fd.GetType() != descriptorpb.FieldDescriptorProto_TYPE_MESSAGE {
+				fd.Proto3Optional = proto.Bool(true)
+				fd.OneofIndex = proto.Int32(int32(len(msg.OneofDecl)))
+				msg.OneofDecl = append(msg.OneofDecl, &descriptorpb.OneofDescriptorProto{Name: proto.String("_" + field.Name)})
+			}
+		}
+		msg.Field = append(msg.Field, fd)
+	}
+	return name, nil
+}
+
+func (b *protobufBuilder) mapEntry(field string, t *Map) (*descriptorpb.DescriptorProto, error) {
+	entry := &descriptorpb.DescriptorProto{
+		Name:    proto.String(strings.ToUpper(field[:1]) + field[1:] + "Entry"),
+		Options: &descriptorpb.MessageOptions{MapEntry: proto.Bool(true)},
+	}
+	key := &descriptorpb.FieldDescriptorProto{
+		Name:   proto.String("key"),
+		Number: proto.Int32(1),
+		Label:  descriptorpb.FieldDescriptorProto_LABEL_OPTIONAL.Enum(),
+	}
+	switch t.Key.(type) {
+	case *String, *Int, *Bool:
+		if err := b.fieldType(key, t.Key, field); err != nil {
+			return nil, err
+		}
+	default:
+		return nil, fmt.Errorf("map keys of type %s in field %q cannot be encoded as protobuf", t.Key, field)
+	}
+	value := &descriptorpb.FieldDescriptorProto{
+		Name:   proto.String("value"),
+		Number: proto.Int32(2),
+		Label:  descriptorpb.FieldDescriptorProto_LABEL_OPTIONAL.Enum(),
+	}
+	if err := b.fieldType(value, t.Value, field); err != nil {
+		return nil, err
+	}
+	entry.Field = []*descriptorpb.FieldDescriptorProto{key, value}
+	return entry, nil
+}
+
+// fieldType sets the type of a singular field.
+func (b *protobufBuilder) fieldType(fd *descriptorpb.FieldDescriptorProto, typ Type, field string) error {
+	switch t := typ.(type) {
+	case *Int:
+		fd.Type = descriptorpb.FieldDescriptorProto_TYPE_INT64.Enum()
+	case *Float:
+		fd.Type = descriptorpb.FieldDescriptorProto_TYPE_DOUBLE.Enum()
+	case *String:
+		fd.Type = descriptorpb.FieldDescriptorProto_TYPE_STRING.Enum()
+	case *Bool:
+		fd.Type = descriptorpb.FieldDescriptorProto_TYPE_BOOL.Enum()
+	case *Bytes:
+		fd.Type = descriptorpb.FieldDescriptorProto_TYPE_BYTES.Enum()
+	case *Time:
+		b.wellKnownType(fd, "google/protobuf/timestamp.proto", ".google.protobuf.Timestamp")
+	case *Any:
+		b.wellKnownType(fd, "google/protobuf/struct.proto", ".google.protobuf.Value")
+	case *Unit:
+		b.wellKnownType(fd, "google/protobuf/empty.proto", ".google.protobuf.Empty")
+	case *EnumRef:
+		enum := b.sch.ResolveEnumRef(t)
+		if enum == nil {
+			return fmt.Errorf("unknown enum %s in field %q", t, field)
+		}
+		return b.fieldType(fd, enum.Type, field)
+	case *DataRef:
+		name, err := b.message(t)
+		if err != nil {
+			return err
+		}
+		fd.Type = descriptorpb.FieldDescriptorProto_TYPE_MESSAGE.Enum()
+		fd.TypeName = proto.String("." + DataProtobufPackage + "." + name)
+	default:
+		return fmt.Errorf("field %q of type %s cannot be encoded as protobuf", field, typ)
+	}
+	return nil
+}
+
+func (b *protobufBuilder) wellKnownType(fd *descriptorpb.FieldDescriptorProto, file, typeName string) {
+	b.deps[file] = true
+	fd.Type = descriptorpb.FieldDescriptorProto_TYPE_MESSAGE.Enum()
+	fd.TypeName = proto.String(typeName)
+}
@@ -0,0 +1,46 @@
+package schema
+
+import (
+	"testing"
+
+	"github.com/alecthomas/assert/v2"
+)
+
+func TestDataProtobufSchema(t *testing.T) {
+	sch, err := ParseString("", `
+		module test {
+			data Item {
+				name String
+			}
+			data Response {
+				id Int alias json "ID"
+				score Float?
+				items [test.Item]
+				counts {String: Int}
+				created Time
+			}
+		}
+	`)
+	assert.NoError(t, err)
+	actual, err := DataProtobufSchema(sch, &DataRef{Module: "test", Name: "Response"})
+	assert.NoError(t, err)
+	expected := `syntax = "proto3";
+
+package ftl.data;
+
+import "google/protobuf/timestamp.proto";
+
+message test_Response {
+  int64 id = 1 [json_name = "ID"];
+  optional double score = 2;
+  repeated test_Item items = 3;
+  map<string, int64> counts = 4;
+  google.protobuf.Timestamp created = 5;
+}
+
+message test_Item {
+  string name = 1;
+}
+`
+	assert.Equal(t, expected, actual)
+}
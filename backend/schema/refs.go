@@ -11,7 +11,7 @@ import (
 
 // RefProto is a constraint on the type of proto that can be used in a Ref.
 type RefProto interface {
-	schemapb.VerbRef | schemapb.DataRef | schemapb.SinkRef | schemapb.SourceRef | schemapb.EnumRef
+	schemapb.VerbRef | schemapb.DataRef | schemapb.SinkRef | schemapb.SourceRef | schemapb.EnumRef | schemapb.TopicRef
 }
 
 // Ref is an untyped reference to a symbol.
@@ -56,6 +56,9 @@ func (a *AbstractRef[Proto]) ToProto() proto.Message {
 	case *schemapb.EnumRef:
 		return any(&schemapb.EnumRef{Pos: posToProto(a.Pos), Module: a.Module, Name: a.Name}).(proto.Message) //nolint:forcetypeassert
 
+	case *schemapb.TopicRef:
+		return any(&schemapb.TopicRef{Pos: posToProto(a.Pos), Module: a.Module, Name: a.Name}).(proto.Message) //nolint:forcetypeassert
+
 	default:
 		panic(fmt.Sprintf("unsupported ref proto type %T", (*Proto)(nil)))
 	}
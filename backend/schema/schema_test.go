@@ -39,6 +39,8 @@ module todo {
 
   verb create(todo.CreateRequest) todo.CreateResponse
       calls todo.destroy
+      retry 3 backoff 1s..30s
+      timeout 5s
 
 
   verb destroy(builtin.HttpRequest<todo.DestroyRequest>) builtin.HttpResponse<todo.DestroyResponse, String>
@@ -113,6 +115,8 @@ Module
     DataRef
     MetadataCalls
       VerbRef
+    MetadataRetry
+    MetadataTimeout
   Verb
     DataRef
       DataRef
@@ -204,6 +208,386 @@ func TestParsing(t *testing.T) {
 				"1:28: metadata \"calls verb\" is not valid on data structures",
 				"1:34: reference to unknown verb \"verb\"",
 			}},
+		{name: "Cron",
+			input: `
+				module test {
+					verb tick(Unit) Unit
+						cron "*/5 * * * *"
+				}
+			`,
+			expected: &Schema{
+				Modules: []*Module{{
+					Name: "test",
+					Decls: []Decl{
+						&Verb{
+							Name:     "tick",
+							Request:  &Unit{Unit: true},
+							Response: &Unit{Unit: true},
+							Metadata: []Metadata{&MetadataCron{Cron: "*/5 * * * *"}},
+						},
+					},
+				}},
+			},
+		},
+		{name: "InvalidCron",
+			input: `module test { data Data {} verb tick(Data) Unit cron "* * *" }`,
+			errors: []string{
+				"1:49: cron expression \"* * *\" must have 5 fields but has 3",
+				"1:49: cron verb tick(Data) Unit must have the signature tick(Unit) Unit",
+			}},
+		{name: "IngressCatchAll",
+			input: `
+				module test {
+					verb files(builtin.HttpRequest<Unit>) builtin.HttpResponse<Unit, Unit>
+						ingress http GET /files/{path...}
+				}
+			`,
+			expected: &Schema{
+				Modules: []*Module{{
+					Name: "test",
+					Decls: []Decl{
+						&Verb{
+							Name:     "files",
+							Request:  &DataRef{Module: "builtin", Name: "HttpRequest", TypeParameters: []Type{&Unit{Unit: true}}},
+							Response: &DataRef{Module: "builtin", Name: "HttpResponse", TypeParameters: []Type{&Unit{Unit: true}, &Unit{Unit: true}}},
+							Metadata: []Metadata{&MetadataIngress{
+								Type:   "http",
+								Method: "GET",
+								Path: []IngressPathComponent{
+									&IngressPathLiteral{Text: "files"},
+									&IngressPathParameter{Name: "path", CatchAll: true},
+								},
+							}},
+						},
+					},
+				}},
+			},
+		},
+		{name: "AmbiguousIngress",
+			input: `module test { verb a(Unit) Unit ingress GET /users/{id} verb b(Unit) Unit ingress GET /users/{name} verb c(Unit) Unit ingress GET /users/me verb d(Unit) Unit ingress GET /{rest...}/x }`,
+			errors: []string{
+				"1:172: catch-all path parameter {rest...} must be the last segment of the path",
+				"1:75: ingress route GET /users/{name} of test.b is ambiguous with GET /users/{id} of test.a",
+			}},
+		{name: "IngressAuth",
+			input: `
+				module test {
+					verb authorize(builtin.AuthRequest) builtin.AuthResponse
+					verb me(builtin.HttpRequest<Unit>) builtin.HttpResponse<String, String>
+						ingress http GET /me auth=bearer authorizer=authorize
+				}
+			`,
+			expected: &Schema{
+				Modules: []*Module{{
+					Name: "test",
+					Decls: []Decl{
+						&Verb{
+							Name:     "authorize",
+							Request:  &DataRef{Module: "builtin", Name: "AuthRequest"},
+							Response: &DataRef{Module: "builtin", Name: "AuthResponse"},
+						},
+						&Verb{
+							Name:     "me",
+							Request:  &DataRef{Module: "builtin", Name: "HttpRequest", TypeParameters: []Type{&Unit{Unit: true}}},
+							Response: &DataRef{Module: "builtin", Name: "HttpResponse", TypeParameters: []Type{&String{}, &String{}}},
+							Metadata: []Metadata{&MetadataIngress{
+								Type:       "http",
+								Method:     "GET",
+								Path:       []IngressPathComponent{&IngressPathLiteral{Text: "me"}},
+								Auth:       "bearer",
+								Authorizer: &VerbRef{Module: "test", Name: "authorize"},
+							}},
+						},
+					},
+				}},
+			},
+		},
+		{name: "InvalidAuthorizer",
+			input: `module test { verb authorize(Unit) Unit verb me(Unit) Unit ingress GET /me authorizer=authorize }`,
+			errors: []string{
+				"1:87: authorizer verb authorize(Unit) Unit must have the signature authorize(builtin.AuthRequest) builtin.AuthResponse",
+			}},
+		{name: "AuthWithoutAuthorizer",
+			input: `module test { verb me(Unit) Unit ingress GET /me auth=bearer }`,
+			errors: []string{
+				"1:34: auth=bearer requires an authorizer to verify the credentials",
+			}},
+		{name: "IngressCORS",
+			input: `
+				module test {
+					verb search(builtin.HttpRequest<Unit>) builtin.HttpResponse<String, String>
+						ingress http GET /search cors(origins=["https://example.com", "http://localhost:8080"], methods=[GET, POST], headers=["Authorization"], credentials, maxage=1h)
+				}
+			`,
+			expected: &Schema{
+				Modules: []*Module{{
+					Name: "test",
+					Decls: []Decl{
+						&Verb{
+							Name:     "search",
+							Request:  &DataRef{Module: "builtin", Name: "HttpRequest", TypeParameters: []Type{&Unit{Unit: true}}},
+							Response: &DataRef{Module: "builtin", Name: "HttpResponse", TypeParameters: []Type{&String{}, &String{}}},
+							Metadata: []Metadata{&MetadataIngress{
+								Type:   "http",
+								Method: "GET",
+								Path:   []IngressPathComponent{&IngressPathLiteral{Text: "search"}},
+								CORS: IngressCORS{
+									Origins:     []string{"https://example.com", "http://localhost:8080"},
+									Methods:     []string{"GET", "POST"},
+									Headers:     []string{"Authorization"},
+									Credentials: true,
+									MaxAge:      "1h",
+								},
+							}},
+						},
+					},
+				}},
+			},
+		},
+		{name: "InvalidIngressCORS",
+			input: `module test { verb search(Unit) Unit ingress GET /search cors(origins=["*", "example.com"], credentials, maxage=10ms) }`,
+			errors: []string{
+				"1:58: CORS credentials cannot be allowed for any origin",
+				"1:58: CORS max age 10ms must be at least 1s",
+				`1:58: invalid CORS origin "example.com", must be "*" or of the form scheme://host[:port]`,
+			}},
+		{name: "IngressCache",
+			input: `
+				module test {
+					verb search(builtin.HttpRequest<Unit>) builtin.HttpResponse<String, String>
+						ingress http GET /search cache(ttl=5m, vary=["Accept-Language", "Authorization"], stale=30s)
+				}
+			`,
+			expected: &Schema{
+				Modules: []*Module{{
+					Name: "test",
+					Decls: []Decl{
+						&Verb{
+							Name:     "search",
+							Request:  &DataRef{Module: "builtin", Name: "HttpRequest", TypeParameters: []Type{&Unit{Unit: true}}},
+							Response: &DataRef{Module: "builtin", Name: "HttpResponse", TypeParameters: []Type{&String{}, &String{}}},
+							Metadata: []Metadata{&MetadataIngress{
+								Type:   "http",
+								Method: "GET",
+								Path:   []IngressPathComponent{&IngressPathLiteral{Text: "search"}},
+								Cache: IngressCache{
+									TTL:                  "5m",
+									Vary:                 []string{"Accept-Language", "Authorization"},
+									StaleWhileRevalidate: "30s",
+								},
+							}},
+						},
+					},
+				}},
+			},
+		},
+		{name: "IngressRateLimit",
+			input: `
+				module test {
+					verb search(builtin.HttpRequest<Unit>) builtin.HttpResponse<String, String>
+						ingress http GET /search ratelimit(100/1m, burst=20, by=header "X-Api-Key")
+				}
+			`,
+			expected: &Schema{
+				Modules: []*Module{{
+					Name: "test",
+					Decls: []Decl{
+						&Verb{
+							Name:     "search",
+							Request:  &DataRef{Module: "builtin", Name: "HttpRequest", TypeParameters: []Type{&Unit{Unit: true}}},
+							Response: &DataRef{Module: "builtin", Name: "HttpResponse", TypeParameters: []Type{&String{}, &String{}}},
+							Metadata: []Metadata{&MetadataIngress{
+								Type:      "http",
+								Method:    "GET",
+								Path:      []IngressPathComponent{&IngressPathLiteral{Text: "search"}},
+								RateLimit: IngressRateLimit{Rate: 100, Interval: "1m", Burst: 20, Key: "header", Header: "X-Api-Key"},
+							}},
+						},
+					},
+				}},
+			},
+		},
+		{name: "InvalidIngressRateLimit",
+			input: `module test { verb search(Unit) Unit ingress GET /search ratelimit(10/0s, by=header) }`,
+			errors: []string{
+				"1:58: rate limit interval 0s must be positive",
+			}},
+		{name: "IngressSSE",
+			input: `
+				module test {
+					data Price {
+						amount Int
+					}
+
+					verb prices(builtin.HttpRequest<Unit>) test.Price
+						ingress sse GET /prices
+				}
+			`,
+			expected: &Schema{
+				Modules: []*Module{{
+					Name: "test",
+					Decls: []Decl{
+						&Data{Name: "Price", Fields: []*Field{{Name: "amount", Type: &Int{}}}},
+						&Verb{
+							Name:     "prices",
+							Request:  &DataRef{Module: "builtin", Name: "HttpRequest", TypeParameters: []Type{&Unit{Unit: true}}},
+							Response: &DataRef{Module: "test", Name: "Price"},
+							Metadata: []Metadata{&MetadataIngress{
+								Type:   "sse",
+								Method: "GET",
+								Path:   []IngressPathComponent{&IngressPathLiteral{Text: "prices"}},
+							}},
+						},
+					},
+				}},
+			},
+		},
+		{name: "InvalidIngressWebSocket",
+			input: `module test { verb prices(Unit) String ingress websocket POST /prices cache(ttl=1m) }`,
+			errors: []string{
+				"1:40: websocket ingress routes must use GET",
+				"1:40: websocket ingress verb prices(Unit) String must accept a builtin.HttpRequest",
+				"1:71: only GET ingress routes can be cached",
+				"1:71: websocket ingress routes cannot be cached",
+			}},
+		{name: "InvalidIngressCache",
+			input: `module test { verb search(Unit) Unit ingress POST /search cache(ttl=0s) }`,
+			errors: []string{
+				"1:59: cache TTL 0s must be positive",
+				"1:59: only GET ingress routes can be cached",
+			}},
+		{name: "RetryAndTimeout",
+			input: `
+				module test {
+					verb charge(Unit) Unit
+						retry 3 backoff 1s..1m30s
+						timeout 500ms
+					verb refund(Unit) Unit
+						retry 5
+				}
+			`,
+			expected: &Schema{
+				Modules: []*Module{{
+					Name: "test",
+					Decls: []Decl{
+						&Verb{
+							Name:     "charge",
+							Request:  &Unit{Unit: true},
+							Response: &Unit{Unit: true},
+							Metadata: []Metadata{
+								&MetadataRetry{Count: 3, MinBackoff: "1s", MaxBackoff: "1m30s"},
+								&MetadataTimeout{Timeout: "500ms"},
+							},
+						},
+						&Verb{
+							Name:     "refund",
+							Request:  &Unit{Unit: true},
+							Response: &Unit{Unit: true},
+							Metadata: []Metadata{&MetadataRetry{Count: 5}},
+						},
+					},
+				}},
+			},
+		},
+		{name: "InvalidRetryAndTimeout",
+			input: `module test { verb charge(Unit) Unit retry 0 backoff 1m..1s timeout 0s }`,
+			errors: []string{
+				"1:38: minimum backoff 1m is greater than maximum backoff 1s",
+				"1:38: verb charge must retry at least once",
+				"1:61: timeout 0s must be positive",
+			}},
+		{name: "RateLimit",
+			input: `
+				module test {
+					verb search(Unit) Unit
+						ratelimit 100/1m burst 20 by header "X-Api-Key"
+					verb login(Unit) Unit
+						ratelimit 5/1s by ip
+				}
+			`,
+			expected: &Schema{
+				Modules: []*Module{{
+					Name: "test",
+					Decls: []Decl{
+						&Verb{
+							Name:     "search",
+							Request:  &Unit{Unit: true},
+							Response: &Unit{Unit: true},
+							Metadata: []Metadata{&MetadataRateLimit{Rate: 100, Interval: "1m", Burst: 20, Key: "header", Header: "X-Api-Key"}},
+						},
+						&Verb{
+							Name:     "login",
+							Request:  &Unit{Unit: true},
+							Response: &Unit{Unit: true},
+							Metadata: []Metadata{&MetadataRateLimit{Rate: 5, Interval: "1s", Key: "ip"}},
+						},
+					},
+				}},
+			},
+		},
+		{name: "InvalidRateLimit",
+			input: `module test { verb a(Unit) Unit ratelimit 0/1s verb b(Unit) Unit ratelimit 1/1s by header }`,
+			errors: []string{
+				"1:33: rate limit 0 must be positive",
+				"1:66: rate limit by header requires a header name",
+			}},
+		{name: "PubSub",
+			input: `
+				module news {
+					data ArticleEvent {
+						title String
+					}
+
+					topic articles ArticleEvent
+				}
+
+				module search {
+					subscription indexArticles news.articles
+
+					verb index(news.ArticleEvent) Unit
+						subscribe indexArticles
+				}
+			`,
+			expected: &Schema{
+				Modules: []*Module{{
+					Name: "news",
+					Decls: []Decl{
+						&Data{Name: "ArticleEvent", Fields: []*Field{{Name: "title", Type: &String{}}}},
+						&Topic{Name: "articles", Event: &DataRef{Module: "news", Name: "ArticleEvent"}},
+					},
+				}, {
+					Name: "search",
+					Decls: []Decl{
+						&Subscription{Name: "indexArticles", Topic: &TopicRef{Module: "news", Name: "articles"}},
+						&Verb{
+							Name:     "index",
+							Request:  &DataRef{Module: "news", Name: "ArticleEvent"},
+							Response: &Unit{Unit: true},
+							Metadata: []Metadata{&MetadataSubscriber{Name: "indexArticles"}},
+						},
+					},
+				}},
+			},
+		},
+		{name: "InvalidSubscriber",
+			input: `
+module test {
+  data Event {}
+  data Other {}
+  topic events Event
+  subscription all events
+  verb wrongType(Other) Unit subscribe all
+  verb wrongResponse(Event) Event subscribe all
+  verb unknown(Event) Unit subscribe missing
+  subscription invalid Event
+}`,
+			errors: []string{
+				"10:24: reference to invalid topic \"Event\" at 3:3",
+				"10:24: unqualified reference to invalid topic \"Event\"",
+				"7:30: subscriber verb wrongType(test.Other) Unit must accept the event type test.Event of topic test.events",
+				"8:35: subscriber verb wrongResponse(Event) Event must return Unit",
+				"9:28: verb unknown subscribes to unknown subscription \"missing\"",
+			}},
 		{name: "KeywordAsName",
 			input:  `module int { data String { name String } verb verb(String) String }`,
 			errors: []string{"1:14: data structure name \"String\" is a reserved word"}},
@@ -370,6 +754,8 @@ module todo {
   }
   verb create(todo.CreateRequest) todo.CreateResponse
   	calls todo.destroy
+  	retry 3 backoff 1s..30s
+  	timeout 5s
   verb destroy(builtin.HttpRequest<todo.DestroyRequest>) builtin.HttpResponse<todo.DestroyResponse, String>
   	ingress http GET /todo/destroy/{id}
 }
@@ -403,6 +789,31 @@ module foo {
 	assert.Equal(t, Normalise(testSchema.Modules[2]), actual)
 }
 
+func TestParseEnumRef(t *testing.T) {
+	input := `
+module foo {
+  enum Color(String) {
+    Red("Red")
+  }
+  data Paint {
+    color Color
+    colors [foo.Color]
+    other other.Color
+  }
+}
+`
+	actual, err := ParseModuleString("", input)
+	assert.NoError(t, err)
+	paint, ok := actual.Decls[1].(*Data)
+	assert.True(t, ok)
+	assert.Equal(t, []Type{
+		&EnumRef{Module: "foo", Name: "Color"},
+		&Array{Element: &EnumRef{Module: "foo", Name: "Color"}},
+		// Enums in other modules can't be resolved when parsing a single module.
+		&DataRef{Module: "other", Name: "Color"},
+	}, slices.Map(Normalise(paint).Fields, func(f *Field) Type { return f.Type }))
+}
+
 var testSchema = MustValidate(&Schema{
 	Modules: []*Module{
 		{
@@ -437,7 +848,11 @@ var testSchema = MustValidate(&Schema{
 				&Verb{Name: "create",
 					Request:  &DataRef{Module: "todo", Name: "CreateRequest"},
 					Response: &DataRef{Module: "todo", Name: "CreateResponse"},
-					Metadata: []Metadata{&MetadataCalls{Calls: []*VerbRef{{Module: "todo", Name: "destroy"}}}}},
+					Metadata: []Metadata{
+						&MetadataCalls{Calls: []*VerbRef{{Module: "todo", Name: "destroy"}}},
+						&MetadataRetry{Count: 3, MinBackoff: "1s", MaxBackoff: "30s"},
+						&MetadataTimeout{Timeout: "5s"},
+					}},
 				&Verb{Name: "destroy",
 					Request:  &DataRef{Module: "builtin", Name: "HttpRequest", TypeParameters: []Type{&DataRef{Module: "todo", Name: "DestroyRequest"}}},
 					Response: &DataRef{Module: "builtin", Name: "HttpResponse", TypeParameters: []Type{&DataRef{Module: "todo", Name: "DestroyResponse"}, &String{}}},
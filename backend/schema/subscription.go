@@ -0,0 +1,54 @@
+package schema
+
+import (
+	"fmt"
+	"strings"
+
+	"google.golang.org/protobuf/proto"
+
+	schemapb "github.com/TBD54566975/ftl/backend/protos/xyz/block/ftl/v1/schema"
+)
+
+// Subscription is a durable queue of the events published to a Topic.
+//
+// Each event is delivered at least once to the Verbs subscribed to the
+// Subscription.
+//
+// eg. subscription indexArticles news.articles
+type Subscription struct {
+	Pos Position `parser:"" protobuf:"1,optional"`
+
+	Comments []string  `parser:"@Comment*" protobuf:"2"`
+	Name     string    `parser:"'subscription' @Ident" protobuf:"3"`
+	Topic    *TopicRef `parser:"@@" protobuf:"4"`
+}
+
+var _ Decl = (*Subscription)(nil)
+
+func (s *Subscription) Position() Position     { return s.Pos }
+func (*Subscription) schemaDecl()              {}
+func (s *Subscription) schemaChildren() []Node { return []Node{s.Topic} }
+func (s *Subscription) String() string {
+	w := &strings.Builder{}
+	fmt.Fprint(w, encodeComments(s.Comments))
+	fmt.Fprintf(w, "subscription %s %s", s.Name, s.Topic)
+	return w.String()
+}
+
+func (s *Subscription) ToProto() proto.Message {
+	return &schemapb.Subscription{
+		Pos:      posToProto(s.Pos),
+		Comments: s.Comments,
+		Name:     s.Name,
+		Topic:    s.Topic.ToProto().(*schemapb.TopicRef), //nolint:forcetypeassert
+	}
+}
+
+func SubscriptionFromProto(s *schemapb.Subscription) *Subscription {
+	return &Subscription{
+		Pos:      posFromProto(s.Pos),
+		Comments: s.Comments,
+		Name:     s.Name,
+		Topic:    TopicRefFromProto(s.Topic),
+	}
+}
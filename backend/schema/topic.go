@@ -0,0 +1,51 @@
+package schema
+
+import (
+	"fmt"
+	"strings"
+
+	"google.golang.org/protobuf/proto"
+
+	schemapb "github.com/TBD54566975/ftl/backend/protos/xyz/block/ftl/v1/schema"
+)
+
+// Topic is a named stream of events that Verbs can publish to.
+//
+// eg. topic articles news.ArticleEvent
+type Topic struct {
+	Pos Position `parser:"" protobuf:"1,optional"`
+
+	Comments []string `parser:"@Comment*" protobuf:"2"`
+	Name     string   `parser:"'topic' @Ident" protobuf:"3"`
+	Event    Type     `parser:"@@" protobuf:"4"`
+}
+
+var _ Decl = (*Topic)(nil)
+
+func (t *Topic) Position() Position     { return t.Pos }
+func (*Topic) schemaDecl()              {}
+func (t *Topic) schemaChildren() []Node { return []Node{t.Event} }
+func (t *Topic) String() string {
+	w := &strings.Builder{}
+	fmt.Fprint(w, encodeComments(t.Comments))
+	fmt.Fprintf(w, "topic %s %s", t.Name, t.Event)
+	return w.String()
+}
+
+func (t *Topic) ToProto() proto.Message {
+	return &schemapb.Topic{
+		Pos:      posToProto(t.Pos),
+		Comments: t.Comments,
+		Name:     t.Name,
+		Event:    typeToProto(t.Event),
+	}
+}
+
+func TopicFromProto(s *schemapb.Topic) *Topic {
+	return &Topic{
+		Pos:      posFromProto(s.Pos),
+		Comments: s.Comments,
+		Name:     s.Name,
+		Event:    typeToSchema(s.Event),
+	}
+}
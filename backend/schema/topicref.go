@@ -0,0 +1,16 @@
+package schema
+
+import schemapb "github.com/TBD54566975/ftl/backend/protos/xyz/block/ftl/v1/schema"
+
+// TopicRef is a reference to a Topic.
+type TopicRef = AbstractRef[schemapb.TopicRef]
+
+func ParseTopicRef(ref string) (*TopicRef, error) { return ParseRef[schemapb.TopicRef](ref) }
+
+func TopicRefFromProto(s *schemapb.TopicRef) *TopicRef {
+	return &TopicRef{
+		Pos:    posFromProto(s.Pos),
+		Name:   s.Name,
+		Module: s.Module,
+	}
+}
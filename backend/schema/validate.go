@@ -2,16 +2,19 @@ package schema
 
 import (
 	"fmt"
+	"net/url"
 	"reflect"
 	"regexp"
 	"slices"
 	"sort"
 	"strings"
+	"time"
 
 	"github.com/alecthomas/participle/v2"
 	xreflect "golang.design/x/reflect"
 	"golang.org/x/exp/maps"
 
+	"github.com/TBD54566975/ftl/internal/cron"
 	"github.com/TBD54566975/ftl/internal/errors"
 )
 
@@ -136,6 +139,15 @@ func Validate(schema *Schema) (*Schema, error) {
 							merr = append(merr, fmt.Errorf("%s: HTTP ingress verb %s(%s) %s must have the signature %s(builtin.HttpRequest) builtin.HttpResponse",
 								md.Pos, n.Name, n.Request, n.Response, n.Name))
 						}
+						if md.IsStreaming() {
+							if !strings.HasPrefix(n.Request.String(), "builtin.HttpRequest") {
+								merr = append(merr, fmt.Errorf("%s: %s ingress verb %s(%s) %s must accept a builtin.HttpRequest",
+									md.Pos, md.Type, n.Name, n.Request, n.Response))
+							}
+							if !strings.EqualFold(md.Method, "GET") {
+								merr = append(merr, fmt.Errorf("%s: %s ingress routes must use GET", md.Pos, md.Type))
+							}
+						}
 						ingress[md.String()] = n
 					}
 				}
@@ -154,11 +166,33 @@ func Validate(schema *Schema) (*Schema, error) {
 					merr = append(merr, fmt.Errorf("%s: enum type must be String or Int, not %s", n.Pos, n.Type))
 				}
 
+			case *EnumRef:
+				if mdecl := scopes.Resolve(n.Untyped()); mdecl != nil {
+					if _, ok := mdecl.Decl.(*Enum); !ok {
+						merr = append(merr, fmt.Errorf("%s: reference to invalid enum %q at %s", n.Pos, n, mdecl.Decl.Position()))
+					} else if mdecl.Module != nil {
+						n.Module = mdecl.Module.Name
+					}
+				} else {
+					merr = append(merr, fmt.Errorf("%s: reference to unknown enum %q", n.Pos, n))
+				}
+
+			case *TopicRef:
+				if mdecl := scopes.Resolve(n.Untyped()); mdecl != nil {
+					if _, ok := mdecl.Decl.(*Topic); !ok {
+						merr = append(merr, fmt.Errorf("%s: reference to invalid topic %q at %s", n.Pos, n, mdecl.Decl.Position()))
+					} else if mdecl.Module != nil {
+						n.Module = mdecl.Module.Name
+					}
+				} else {
+					merr = append(merr, fmt.Errorf("%s: reference to unknown topic %q", n.Pos, n))
+				}
+
 			case *Array, *Bool, *Bytes, *Data, *Database, Decl, *Field, *Float,
-				IngressPathComponent, *IngressPathLiteral, *IngressPathParameter,
+				IngressPathComponent, *IngressPathLiteral, *IngressPathParameter, *IngressCORS, *IngressCache, *IngressRateLimit,
 				*Int, *Map, Metadata, *MetadataCalls, *MetadataDatabases,
-				*MetadataIngress, *Module, *Optional, *Schema, *String, *Time, Type,
-				*Unit, *Any, *TypeParameter, *EnumVariant, Value, *IntValue, *StringValue:
+				*MetadataIngress, *MetadataCron, *MetadataSubscriber, *MetadataRetry, *MetadataTimeout, *MetadataRateLimit, *Module, *Optional, *Schema, *String, *Time, Type,
+				*Unit, *Any, *TypeParameter, *EnumVariant, Value, *IntValue, *StringValue, *Topic, *Subscription:
 			}
 			return next()
 		})
@@ -167,10 +201,154 @@ func Validate(schema *Schema) (*Schema, error) {
 		}
 	}
 
+	// Subscribers can only be checked once all references have been resolved.
+	merr = append(merr, validateSubscribers(schema)...)
+	merr = append(merr, validateIngressRoutes(schema)...)
+
 	merr = cleanErrors(merr)
 	return schema, errors.Join(merr...)
 }
 
+// Check that each subscriber Verb accepts the event type of the Topic it is
+// subscribed to.
+func validateSubscribers(schema *Schema) []error {
+	merr := []error{}
+	for _, module := range schema.Modules {
+		for _, verb := range module.Verbs() {
+			for _, md := range verb.Metadata {
+				md, ok := md.(*MetadataSubscriber)
+				if !ok {
+					continue
+				}
+				mdecl := module.Resolve(Ref{Name: md.Name})
+				if mdecl == nil {
+					continue // Reported by ValidateModule.
+				}
+				subscription, ok := mdecl.Decl.(*Subscription)
+				if !ok {
+					continue // Reported by ValidateModule.
+				}
+				topic := schema.ResolveTopicRef(subscription.Topic)
+				if topic == nil {
+					continue // Reported by the TopicRef check.
+				}
+				if verb.Request.String() != topic.Event.String() {
+					merr = append(merr, fmt.Errorf("%s: subscriber verb %s(%s) %s must accept the event type %s of topic %s",
+						md.Pos, verb.Name, verb.Request, verb.Response, topic.Event, subscription.Topic))
+				}
+			}
+		}
+	}
+	return merr
+}
+
+// Check that ingress authorizers have the correct signature, that catch-all
+// path parameters are the last segment of their ingress route, and that no two
+// ingress routes can match a request with the same precedence. Literal segments
+// take precedence over parameters, which take precedence over catch-alls, so
+// routes are ambiguous if they have the same method and the same kind of
+// segment in each position.
+func validateIngressRoutes(schema *Schema) []error {
+	merr := []error{}
+	type route struct {
+		module string
+		verb   *Verb
+		md     *MetadataIngress
+		path   string
+	}
+	routes := map[string]route{}
+	for _, module := range schema.Modules {
+		for _, verb := range module.Verbs() {
+			for _, md := range verb.Metadata {
+				md, ok := md.(*MetadataIngress)
+				if !ok {
+					continue
+				}
+				if md.Auth != "" && !md.GetAuthorizer().Ok() {
+					merr = append(merr, fmt.Errorf("%s: auth=%s requires an authorizer to verify the credentials", md.Pos, md.Auth))
+				}
+				if ref, ok := md.GetAuthorizer().Get(); ok {
+					// Unknown authorizers are reported by the VerbRef check.
+					if authorizer := schema.ResolveVerbRef(ref); authorizer != nil &&
+						(authorizer.Request.String() != "builtin.AuthRequest" || authorizer.Response.String() != "builtin.AuthResponse") {
+						merr = append(merr, fmt.Errorf("%s: authorizer verb %s(%s) %s must have the signature %s(builtin.AuthRequest) builtin.AuthResponse",
+							ref.Pos, authorizer.Name, authorizer.Request, authorizer.Response, authorizer.Name))
+					}
+				}
+				if cors, ok := md.GetCORS().Get(); ok {
+					merr = append(merr, validateIngressCORS(cors)...)
+				}
+				if cache, ok := md.GetCache().Get(); ok {
+					if _, _, err := cache.Durations(); err != nil {
+						merr = append(merr, fmt.Errorf("%s: %s", cache.Pos, err))
+					}
+					if !strings.EqualFold(md.Method, "GET") {
+						merr = append(merr, fmt.Errorf("%s: only GET ingress routes can be cached", cache.Pos))
+					}
+					if md.IsStreaming() {
+						merr = append(merr, fmt.Errorf("%s: %s ingress routes cannot be cached", cache.Pos, md.Type))
+					}
+				}
+				if limit, ok := md.GetRateLimit().Get(); ok {
+					if _, _, _, err := limit.MetadataRateLimit().Limit(); err != nil {
+						merr = append(merr, fmt.Errorf("%s: %s", limit.Pos, err))
+					}
+				}
+				shape := make([]string, len(md.Path))
+				path := make([]string, len(md.Path))
+				for i, component := range md.Path {
+					switch component := component.(type) {
+					case *IngressPathLiteral:
+						shape[i] = component.Text
+						path[i] = component.Text
+					case *IngressPathParameter:
+						shape[i] = "{}"
+						path[i] = component.Pattern()
+						if component.CatchAll {
+							shape[i] = "{...}"
+							if i != len(md.Path)-1 {
+								merr = append(merr, fmt.Errorf("%s: catch-all path parameter %s must be the last segment of the path",
+									component.Pos, component.Pattern()))
+							}
+						}
+					}
+				}
+				key := strings.ToUpper(md.Method) + " /" + strings.Join(shape, "/")
+				current := route{module: module.Name, verb: verb, md: md, path: strings.ToUpper(md.Method) + " /" + strings.Join(path, "/")}
+				// Identical routes are reported as duplicates.
+				if existing, ok := routes[key]; ok && existing.md.String() != md.String() {
+					merr = append(merr, fmt.Errorf("%s: ingress route %s of %s.%s is ambiguous with %s of %s.%s",
+						md.Pos, current.path, module.Name, verb.Name, existing.path, existing.module, existing.verb.Name))
+				}
+				routes[key] = current
+			}
+		}
+	}
+	return merr
+}
+
+func validateIngressCORS(cors *IngressCORS) []error {
+	merr := []error{}
+	for _, origin := range cors.Origins {
+		if origin == "*" {
+			if cors.Credentials {
+				merr = append(merr, fmt.Errorf("%s: CORS credentials cannot be allowed for any origin", cors.Pos))
+			}
+			continue
+		}
+		u, err := url.Parse(origin)
+		if err != nil || u.Scheme == "" || u.Host == "" || (u.Path != "" && u.Path != "/") || u.RawQuery != "" {
+			merr = append(merr, fmt.Errorf("%s: invalid CORS origin %q, must be \"*\" or of the form scheme://host[:port]", cors.Pos, origin))
+		}
+	}
+	if cors.MaxAge != "" {
+		if maxAge, err := time.ParseDuration(cors.MaxAge); err != nil || maxAge < time.Second {
+			merr = append(merr, fmt.Errorf("%s: CORS max age %s must be at least 1s", cors.Pos, cors.MaxAge))
+		}
+	}
+	return merr
+}
+
 var validNameRe = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
 
 // ValidateName validates an FTL name.
@@ -225,6 +403,17 @@ func ValidateModule(module *Module) error {
 				merr = append(merr, fmt.Errorf("%s: reference to unknown enum %q", n.Pos, n))
 			}
 
+		case *TopicRef:
+			if mdecl := scopes.Resolve(n.Untyped()); mdecl != nil {
+				if _, ok := mdecl.Decl.(*Topic); !ok && n.Module == "" {
+					merr = append(merr, fmt.Errorf("%s: unqualified reference to invalid topic %q", n.Pos, n))
+				} else {
+					n.Module = mdecl.Module.Name
+				}
+			} else if n.Module == "" || n.Module == module.Name { // Don't report errors for external modules.
+				merr = append(merr, fmt.Errorf("%s: reference to unknown topic %q", n.Pos, n))
+			}
+
 		case *DataRef:
 			if mdecl := scopes.Resolve(n.Untyped()); mdecl != nil {
 				switch decl := mdecl.Decl.(type) {
@@ -256,6 +445,60 @@ func ValidateModule(module *Module) error {
 			if _, ok := primitivesScope[n.Name]; ok {
 				merr = append(merr, fmt.Errorf("%s: Verb name %q is a reserved word", n.Pos, n.Name))
 			}
+			for _, md := range n.Metadata {
+				if md, ok := md.(*MetadataCron); ok {
+					if _, err := cron.Parse(md.Cron); err != nil {
+						merr = append(merr, fmt.Errorf("%s: %s", md.Pos, err))
+					}
+					_, reqIsUnit := n.Request.(*Unit)
+					_, respIsUnit := n.Response.(*Unit)
+					if !reqIsUnit || !respIsUnit {
+						merr = append(merr, fmt.Errorf("%s: cron verb %s(%s) %s must have the signature %s(Unit) Unit",
+							md.Pos, n.Name, n.Request, n.Response, n.Name))
+					}
+				}
+				if md, ok := md.(*MetadataRetry); ok {
+					if md.Count < 1 {
+						merr = append(merr, fmt.Errorf("%s: verb %s must retry at least once", md.Pos, n.Name))
+					}
+					if _, _, err := md.Backoff(); err != nil {
+						merr = append(merr, fmt.Errorf("%s: %s", md.Pos, err))
+					}
+				}
+				if md, ok := md.(*MetadataTimeout); ok {
+					if _, err := md.Duration(); err != nil {
+						merr = append(merr, fmt.Errorf("%s: %s", md.Pos, err))
+					}
+				}
+				if md, ok := md.(*MetadataRateLimit); ok {
+					if _, _, _, err := md.Limit(); err != nil {
+						merr = append(merr, fmt.Errorf("%s: %s", md.Pos, err))
+					}
+				}
+				if md, ok := md.(*MetadataSubscriber); ok {
+					if mdecl := module.Resolve(Ref{Name: md.Name}); mdecl == nil {
+						merr = append(merr, fmt.Errorf("%s: verb %s subscribes to unknown subscription %q", md.Pos, n.Name, md.Name))
+					} else if _, ok := mdecl.Decl.(*Subscription); !ok {
+						merr = append(merr, fmt.Errorf("%s: verb %s subscribes to %q which is not a subscription", md.Pos, n.Name, md.Name))
+					}
+					if _, ok := n.Response.(*Unit); !ok {
+						merr = append(merr, fmt.Errorf("%s: subscriber verb %s(%s) %s must return Unit", md.Pos, n.Name, n.Request, n.Response))
+					}
+				}
+			}
+
+		case *Topic:
+			if !ValidateName(n.Name) {
+				merr = append(merr, fmt.Errorf("%s: topic name %q is invalid", n.Pos, n.Name))
+			}
+			if _, ok := n.Event.(*DataRef); !ok {
+				merr = append(merr, fmt.Errorf("%s: topic %s event type must be a data structure, not %s", n.Pos, n.Name, n.Event))
+			}
+
+		case *Subscription:
+			if !ValidateName(n.Name) {
+				merr = append(merr, fmt.Errorf("%s: subscription name %q is invalid", n.Pos, n.Name))
+			}
 
 		case *Data:
 			if !ValidateName(n.Name) {
@@ -265,15 +508,17 @@ func ValidateModule(module *Module) error {
 				merr = append(merr, fmt.Errorf("%s: data structure name %q is a reserved word", n.Pos, n.Name))
 			}
 			for _, md := range n.Metadata {
-				if md, ok := md.(*MetadataCalls); ok {
-					merr = append(merr, fmt.Errorf("%s: metadata %q is not valid on data structures", md.Pos, strings.TrimSpace(md.String())))
+				switch md := md.(type) {
+				case *MetadataCalls, *MetadataCron, *MetadataSubscriber, *MetadataRetry, *MetadataTimeout, *MetadataRateLimit:
+					merr = append(merr, fmt.Errorf("%s: metadata %q is not valid on data structures", md.Position(), strings.TrimSpace(md.String())))
+				default:
 				}
 			}
 
 		case *Array, *Bool, *Database, *Field, *Float, *Int,
 			*Time, *Map, *Module, *Schema, *String, *Bytes,
-			*MetadataCalls, *MetadataDatabases, *MetadataIngress, IngressPathComponent,
-			*IngressPathLiteral, *IngressPathParameter, *Optional,
+			*MetadataCalls, *MetadataDatabases, *MetadataIngress, *MetadataCron, *MetadataSubscriber, *MetadataRetry, *MetadataTimeout, *MetadataRateLimit, IngressPathComponent,
+			*IngressPathLiteral, *IngressPathParameter, *IngressCORS, *IngressCache, *IngressRateLimit, *Optional,
 			*SourceRef, *SinkRef, *Unit, *Any, *TypeParameter, *Enum, *EnumVariant, *IntValue, *StringValue:
 
 		case Type, Metadata, Decl, Value: // Union types.
@@ -197,6 +197,7 @@ data class HttpRequest<Body>(
   val query: Map<String, List<String>>,
   val headers: Map<String, List<String>>,
   val body: Body,
+  val principal: String? = null,
 )
 
 /**
@@ -210,6 +211,24 @@ data class HttpResponse<Body, Error>(
 )
 
 class Empty
+/**
+ * Request passed to the authorizer verb of an ingress route.
+ */
+data class AuthRequest(
+  val method: String,
+  val path: String,
+  val headers: Map<String, List<String>>,
+  val credentials: String? = null,
+)
+
+/**
+ * Response from the authorizer verb of an ingress route.
+ */
+data class AuthResponse(
+  val allowed: Boolean,
+  val principal: String? = null,
+)
+
 `
 	assertExpectedSchema(t, sch, "builtin/Builtin.kt", expected)
 }
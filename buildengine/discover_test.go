@@ -20,7 +20,7 @@ func TestDiscoverModules(t *testing.T) {
 			Language:  "go",
 			Realm:     "home",
 			Module:    "alpha",
-			Deploy:    []string{"main"},
+			Deploy:    []string{"main", "migrations"},
 			DeployDir: "_ftl",
 			Schema:    "schema.pb",
 			Watch:     []string{"**/*.go", "go.mod", "go.sum"},
@@ -30,7 +30,7 @@ func TestDiscoverModules(t *testing.T) {
 			Language:  "go",
 			Realm:     "home",
 			Module:    "another",
-			Deploy:    []string{"main"},
+			Deploy:    []string{"main", "migrations"},
 			DeployDir: "_ftl",
 			Schema:    "schema.pb",
 			Watch:     []string{"**/*.go", "go.mod", "go.sum"},
@@ -60,7 +60,7 @@ func TestDiscoverModules(t *testing.T) {
 			Language:  "go",
 			Realm:     "home",
 			Module:    "other",
-			Deploy:    []string{"main"},
+			Deploy:    []string{"main", "migrations"},
 			DeployDir: "_ftl",
 			Schema:    "schema.pb",
 			Watch:     []string{"**/*.go", "go.mod", "go.sum"},
@@ -32,8 +32,9 @@ FTL - Towards a 𝝺-calculus for large-scale systems
 The Runner is the component of FTL that coordinates with the Controller to spawn
 and route to user code.
 	`), kong.Vars{
-		"version":       ftl.Version,
-		"deploymentdir": filepath.Join(cacheDir, "ftl-runner", "${runner}", "deployments"),
+		"version":          ftl.Version,
+		"deploymentdir":    filepath.Join(cacheDir, "ftl-runner", "${runner}", "deployments"),
+		"artefactcachedir": filepath.Join(cacheDir, "ftl-runner", "artefacts"),
 	})
 	// Substitute in the runner key into the deployment directory.
 	cli.RunnerConfig.DeploymentDir = os.Expand(cli.RunnerConfig.DeploymentDir, func(key string) string {
@@ -42,10 +42,30 @@ func (c *callCmd) Run(ctx context.Context, client ftlv1connect.VerbServiceClient
 		if resp.Error.Stack != nil && logger.GetLevel() <= log.Debug {
 			fmt.Println(*resp.Error.Stack)
 		}
-		return fmt.Errorf("verb error: %s", resp.Error.Message)
+		err := fmt.Errorf("verb error: %s", resp.Error.Message)
+		if resp.Error.Code != nil {
+			if resp.Error.Details != nil {
+				err = fmt.Errorf("%w: %s", err, resp.Error.Details)
+			}
+			var code connect.Code
+			if code.UnmarshalText([]byte(*resp.Error.Code)) == nil {
+				// Typed errors exit with the number of their Connect code, eg. 5 for not_found.
+				return exitCodeError{code: int(code), err: err}
+			}
+		}
+		return err
 
 	case *ftlv1.CallResponse_Body:
 		fmt.Println(string(resp.Body))
 	}
 	return nil
 }
+
+// exitCodeError is returned by commands that exit with a specific status.
+type exitCodeError struct {
+	code int
+	err  error
+}
+
+func (e exitCodeError) Error() string { return e.err.Error() }
+func (e exitCodeError) Unwrap() error { return e.err }
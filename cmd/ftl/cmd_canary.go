@@ -0,0 +1,45 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"connectrpc.com/connect"
+
+	ftlv1 "github.com/TBD54566975/ftl/backend/protos/xyz/block/ftl/v1"
+	"github.com/TBD54566975/ftl/backend/protos/xyz/block/ftl/v1/ftlv1connect"
+	"github.com/TBD54566975/ftl/internal/model"
+)
+
+type canaryCmd struct {
+	Deployment model.DeploymentName `arg:"" help:"Canary deployment to update."`
+	Weight     string               `arg:"" help:"Percentage of the module's traffic to route to the canary, eg. 25%. 100% promotes the canary."`
+	Force      bool                 `help:"Promote the canary even if it makes breaking changes to declarations used by other modules."`
+}
+
+func (c *canaryCmd) Run(ctx context.Context, client ftlv1connect.ControllerServiceClient) error {
+	weight, err := parseCanaryWeight(c.Weight)
+	if err != nil {
+		return err
+	}
+	_, err = client.SetCanaryWeight(ctx, connect.NewRequest(&ftlv1.SetCanaryWeightRequest{
+		DeploymentName: c.Deployment.String(),
+		Weight:         weight,
+		Force:          c.Force,
+	}))
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+// parseCanaryWeight parses a percentage such as "10%" or "10".
+func parseCanaryWeight(s string) (int32, error) {
+	weight, err := strconv.ParseInt(strings.TrimSuffix(strings.TrimSpace(s), "%"), 10, 32)
+	if err != nil || weight < 0 || weight > 100 {
+		return 0, fmt.Errorf("invalid canary weight %q, must be a percentage between 0%% and 100%%", s)
+	}
+	return int32(weight), nil
+}
@@ -26,11 +26,22 @@ type deployCmd struct {
 	Replicas  int32  `short:"n" help:"Number of replicas to deploy." default:"1"`
 	ModuleDir string `arg:"" help:"Directory containing ftl.toml" type:"existingdir" default:"."`
 	Wait      bool   `help:"Only complete the deploy command when sufficient runners have been provisioned, i.e. the deployment is online and reachable." default:"false"`
+	Force     bool   `help:"Deploy even if the module makes breaking changes to declarations used by other modules."`
+	Canary    string `help:"Deploy alongside the module's existing deployment, routing this percentage of traffic to the new deployment (eg. 10%)." placeholder:"PERCENT"`
 }
 
 func (d *deployCmd) Run(ctx context.Context, client ftlv1connect.ControllerServiceClient) error {
 	logger := log.FromContext(ctx)
 
+	var weight int32
+	if d.Canary != "" {
+		var err error
+		weight, err = parseCanaryWeight(d.Canary)
+		if err != nil {
+			return err
+		}
+	}
+
 	// Load the TOML file.
 	config, err := moduleconfig.LoadModuleConfig(d.ModuleDir)
 	if err != nil {
@@ -87,17 +98,31 @@ func (d *deployCmd) Run(ctx context.Context, client ftlv1connect.ControllerServi
 		logger.Debugf("Uploaded %s as %s:%s", relToCWD(file.localPath), sha256.FromBytes(resp.Msg.Digest), file.Path)
 	}
 
+	memory, err := config.Limits.MemoryBytes()
+	if err != nil {
+		return err
+	}
 	resp, err := client.CreateDeployment(ctx, connect.NewRequest(&ftlv1.CreateDeploymentRequest{
 		Schema: module,
 		Artefacts: slices.Map(maps.Values(filesByHash), func(a deploymentArtefact) *ftlv1.DeploymentArtefact {
 			return a.DeploymentArtefact
 		}),
+		Force: d.Force,
+		Limits: &ftlv1.DeploymentLimits{
+			Cpu:         config.Limits.CPU,
+			MemoryBytes: memory,
+			OpenFiles:   config.Limits.OpenFiles,
+		},
 	}))
 	if err != nil {
 		return err
 	}
 
-	_, err = client.ReplaceDeploy(ctx, connect.NewRequest(&ftlv1.ReplaceDeployRequest{DeploymentName: resp.Msg.GetDeploymentName(), MinReplicas: d.Replicas}))
+	if d.Canary != "" {
+		_, err = client.CanaryDeploy(ctx, connect.NewRequest(&ftlv1.CanaryDeployRequest{DeploymentName: resp.Msg.GetDeploymentName(), MinReplicas: d.Replicas, Weight: weight, Force: d.Force}))
+	} else {
+		_, err = client.ReplaceDeploy(ctx, connect.NewRequest(&ftlv1.ReplaceDeployRequest{DeploymentName: resp.Msg.GetDeploymentName(), MinReplicas: d.Replicas, Force: d.Force}))
+	}
 	if err != nil {
 		return err
 	}
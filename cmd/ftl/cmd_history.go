@@ -0,0 +1,65 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/hexops/gotextdiff"
+	"github.com/hexops/gotextdiff/myers"
+	"github.com/hexops/gotextdiff/span"
+
+	ftlv1 "github.com/TBD54566975/ftl/backend/protos/xyz/block/ftl/v1"
+	"github.com/TBD54566975/ftl/backend/protos/xyz/block/ftl/v1/ftlv1connect"
+	"github.com/TBD54566975/ftl/backend/schema"
+)
+
+type historyCmd struct {
+	Module string `arg:"" help:"Module to list deployments of."`
+	Diff   bool   `help:"Show schema changes between deployments." default:"true" negatable:""`
+}
+
+func (h *historyCmd) Run(ctx context.Context, client ftlv1connect.ControllerServiceClient) error {
+	resp, err := client.GetDeploymentHistory(ctx, connect.NewRequest(&ftlv1.GetDeploymentHistoryRequest{Module: h.Module}))
+	if err != nil {
+		return err
+	}
+	deployments := resp.Msg.Deployments
+	format := "%-40s %-25s %s\n"
+	fmt.Printf(format, "DEPLOYMENT", "CREATED", "STATE")
+	for i, deployment := range deployments {
+		state := "inactive"
+		switch {
+		case deployment.MinReplicas > 0 && deployment.Canary:
+			state = fmt.Sprintf("canary (%d replicas)", deployment.MinReplicas)
+		case deployment.MinReplicas > 0:
+			state = fmt.Sprintf("active (%d replicas)", deployment.MinReplicas)
+		}
+		fmt.Printf(format, deployment.DeploymentName, deployment.CreatedAt.AsTime().Format(time.RFC3339), state)
+		if !h.Diff || i == len(deployments)-1 {
+			continue
+		}
+		diff, err := schemaDiff(deployments[i+1], deployment)
+		if err != nil {
+			return err
+		}
+		fmt.Print(diff)
+	}
+	return nil
+}
+
+// schemaDiff returns a unified diff of the module schemas of two deployments.
+func schemaDiff(from, to *ftlv1.GetDeploymentHistoryResponse_Deployment) (string, error) {
+	fromSchema, err := schema.ModuleFromProto(from.Schema)
+	if err != nil {
+		return "", fmt.Errorf("%s: invalid schema: %w", from.DeploymentName, err)
+	}
+	toSchema, err := schema.ModuleFromProto(to.Schema)
+	if err != nil {
+		return "", fmt.Errorf("%s: invalid schema: %w", to.DeploymentName, err)
+	}
+	before, after := fromSchema.String()+"\n", toSchema.String()+"\n"
+	edits := myers.ComputeEdits(span.URIFromPath(from.DeploymentName), before, after)
+	return fmt.Sprint(gotextdiff.ToUnified(from.DeploymentName, to.DeploymentName, before, edits)), nil
+}
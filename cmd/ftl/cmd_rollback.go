@@ -0,0 +1,32 @@
+package main
+
+import (
+	"context"
+
+	"connectrpc.com/connect"
+
+	ftlv1 "github.com/TBD54566975/ftl/backend/protos/xyz/block/ftl/v1"
+	"github.com/TBD54566975/ftl/backend/protos/xyz/block/ftl/v1/ftlv1connect"
+	"github.com/TBD54566975/ftl/internal/log"
+	"github.com/TBD54566975/ftl/internal/model"
+)
+
+type rollbackCmd struct {
+	Module     string                `arg:"" help:"Module to roll back."`
+	Deployment *model.DeploymentName `arg:"" optional:"" help:"Deployment to roll back to (defaults to the previous deployment)."`
+	Force      bool                  `help:"Roll back even if it makes breaking changes to declarations used by other modules."`
+}
+
+func (r *rollbackCmd) Run(ctx context.Context, client ftlv1connect.ControllerServiceClient) error {
+	req := &ftlv1.RollbackRequest{Module: r.Module, Force: r.Force}
+	if r.Deployment != nil {
+		name := r.Deployment.String()
+		req.DeploymentName = &name
+	}
+	resp, err := client.Rollback(ctx, connect.NewRequest(req))
+	if err != nil {
+		return err
+	}
+	log.FromContext(ctx).Infof("Rolled back %s to %s", r.Module, resp.Msg.DeploymentName)
+	return nil
+}
@@ -2,7 +2,9 @@ package main
 
 type schemaCmd struct {
 	Get      getSchemaCmd      `default:"" cmd:"" help:"Retrieve the cluster FTL schema."`
-	Protobuf schemaProtobufCmd `cmd:"" help:"Generate protobuf schema mirroring the FTL schema structure."`
+	Protobuf schemaProtobufCmd `cmd:"" help:"Generate protobuf schema mirroring the FTL schema structure, or data structures in the cluster schema."`
 	Generate schemaGenerateCmd `cmd:"" help:"Stream the schema from the cluster and generate files from the template."`
 	Import   schemaImportCmd   `cmd:"" help:"Import messages to the FTL schema."`
+	Diff     schemaDiffCmd     `cmd:"" help:"Show changes between a local module's schema and its deployed schema."`
+	OpenAPI  schemaOpenAPICmd  `cmd:"" name:"openapi" help:"Generate an OpenAPI document describing HTTP ingress verbs."`
 }
@@ -0,0 +1,72 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"connectrpc.com/connect"
+	"google.golang.org/protobuf/proto"
+
+	ftlv1 "github.com/TBD54566975/ftl/backend/protos/xyz/block/ftl/v1"
+	"github.com/TBD54566975/ftl/backend/protos/xyz/block/ftl/v1/ftlv1connect"
+	schemapb "github.com/TBD54566975/ftl/backend/protos/xyz/block/ftl/v1/schema"
+	"github.com/TBD54566975/ftl/backend/schema"
+	"github.com/TBD54566975/ftl/common/moduleconfig"
+)
+
+type schemaDiffCmd struct {
+	ModuleDir string `arg:"" help:"Directory containing ftl.toml" type:"existingdir" default:"."`
+}
+
+func (s *schemaDiffCmd) Run(ctx context.Context, client ftlv1connect.ControllerServiceClient) error {
+	config, err := moduleconfig.LoadModuleConfig(s.ModuleDir)
+	if err != nil {
+		return err
+	}
+	build := buildCmd{Dirs: []string{s.ModuleDir}}
+	if err := build.Run(ctx); err != nil {
+		return err
+	}
+	content, err := os.ReadFile(filepath.Join(s.ModuleDir, config.DeployDir, config.Schema))
+	if err != nil {
+		return err
+	}
+	pb := &schemapb.Module{}
+	if err := proto.Unmarshal(content, pb); err != nil {
+		return fmt.Errorf("failed to load protobuf schema from %q: %w", config.Schema, err)
+	}
+	module, err := schema.ModuleFromProto(pb)
+	if err != nil {
+		return fmt.Errorf("%s: %w", "invalid module schema", err)
+	}
+
+	resp, err := client.GetSchema(ctx, connect.NewRequest(&ftlv1.GetSchemaRequest{}))
+	if err != nil {
+		return err
+	}
+	active, err := schema.FromProto(resp.Msg.Schema)
+	if err != nil {
+		return fmt.Errorf("%s: %w", "invalid cluster schema", err)
+	}
+	existing, ok := active.Module(module.Name).Get()
+	if !ok {
+		existing = &schema.Module{Name: module.Name}
+	}
+
+	changes := schema.Diff(existing, module)
+	if len(changes) == 0 {
+		fmt.Println("No changes")
+		return nil
+	}
+	consumed := schema.ConsumedDecls(existing, active.Modules)
+	for _, change := range changes {
+		if change.Breaking && consumed[change.Decl.Name] {
+			fmt.Printf("%s (used by other modules)\n", change)
+		} else {
+			fmt.Println(change)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,36 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"os"
+
+	"connectrpc.com/connect"
+
+	"github.com/TBD54566975/ftl/backend/controller/ingress"
+	ftlv1 "github.com/TBD54566975/ftl/backend/protos/xyz/block/ftl/v1"
+	"github.com/TBD54566975/ftl/backend/protos/xyz/block/ftl/v1/ftlv1connect"
+	"github.com/TBD54566975/ftl/backend/schema"
+)
+
+type schemaOpenAPICmd struct{}
+
+func (s *schemaOpenAPICmd) Run(ctx context.Context, client ftlv1connect.ControllerServiceClient, endpoint *url.URL) error {
+	resp, err := client.GetSchema(ctx, connect.NewRequest(&ftlv1.GetSchemaRequest{}))
+	if err != nil {
+		return err
+	}
+	sch, err := schema.FromProto(resp.Msg.Schema)
+	if err != nil {
+		return fmt.Errorf("%s: %w", "invalid schema", err)
+	}
+	doc, err := ingress.OpenAPI(sch, endpoint.JoinPath("ingress").String())
+	if err != nil {
+		return err
+	}
+	encoder := json.NewEncoder(os.Stdout)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(doc)
+}
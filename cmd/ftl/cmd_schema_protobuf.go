@@ -1,14 +1,45 @@
 package main
 
 import (
+	"context"
 	"fmt"
 
+	"connectrpc.com/connect"
+
+	ftlv1 "github.com/TBD54566975/ftl/backend/protos/xyz/block/ftl/v1"
+	"github.com/TBD54566975/ftl/backend/protos/xyz/block/ftl/v1/ftlv1connect"
 	"github.com/TBD54566975/ftl/backend/schema"
 )
 
-type schemaProtobufCmd struct{}
+type schemaProtobufCmd struct {
+	Data []string `arg:"" optional:"" help:"Generate messages mirroring these data structures (module.Data) in the cluster schema instead."`
+}
 
-func (c *schemaProtobufCmd) Run() error { //nolint:unparam
-	fmt.Println(schema.ProtobufSchema())
+func (c *schemaProtobufCmd) Run(ctx context.Context, client ftlv1connect.ControllerServiceClient) error {
+	if len(c.Data) == 0 {
+		fmt.Println(schema.ProtobufSchema())
+		return nil
+	}
+	refs := make([]*schema.DataRef, len(c.Data))
+	for i, data := range c.Data {
+		ref, err := schema.ParseDataRef(data)
+		if err != nil {
+			return fmt.Errorf("invalid data reference %q: %w", data, err)
+		}
+		refs[i] = ref
+	}
+	resp, err := client.GetSchema(ctx, connect.NewRequest(&ftlv1.GetSchemaRequest{}))
+	if err != nil {
+		return err
+	}
+	sch, err := schema.FromProto(resp.Msg.Schema)
+	if err != nil {
+		return fmt.Errorf("%s: %w", "invalid schema", err)
+	}
+	out, err := schema.DataProtobufSchema(sch, refs...)
+	if err != nil {
+		return err
+	}
+	fmt.Print(out)
 	return nil
 }
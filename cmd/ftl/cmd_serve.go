@@ -31,12 +31,12 @@ import (
 
 type serveCmd struct {
 	Bind           *url.URL      `help:"Starting endpoint to bind to and advertise to. Each controller and runner will increment the port by 1" default:"http://localhost:8892"`
-	AllowOrigins   []*url.URL    `help:"Allow CORS requests to ingress endpoints from these origins." env:"FTL_CONTROLLER_ALLOW_ORIGIN"`
 	NoConsole      bool          `help:"Disable the console."`
 	DBPort         int           `help:"Port to use for the database." default:"54320"`
 	Recreate       bool          `help:"Recreate the database even if it already exists." default:"false"`
 	Controllers    int           `short:"c" help:"Number of controllers to start." default:"1"`
 	Runners        int           `short:"r" help:"Number of runners to start." default:"0"`
+	RunnerCapacity int           `help:"Maximum number of deployments each runner can host." default:"1"`
 	Background     bool          `help:"Run in the background." default:"false"`
 	Stop           bool          `help:"Stop the running FTL instance. Can be used to --background to restart the server" default:"false"`
 	StartupTimeout time.Duration `help:"Timeout for the server to start up." default:"20s"`
@@ -88,7 +88,7 @@ func (s *serveCmd) Run(ctx context.Context) error {
 		controllerAddresses = append(controllerAddresses, bindAllocator.Next())
 	}
 
-	runnerScaling, err := localscaling.NewLocalScaling(bindAllocator, controllerAddresses)
+	runnerScaling, err := localscaling.NewLocalScaling(bindAllocator, controllerAddresses, s.RunnerCapacity)
 	if err != nil {
 		return err
 	}
@@ -96,10 +96,9 @@ func (s *serveCmd) Run(ctx context.Context) error {
 	for i := 0; i < s.Controllers; i++ {
 		i := i
 		config := controller.Config{
-			Bind:         controllerAddresses[i],
-			DSN:          dsn,
-			AllowOrigins: s.AllowOrigins,
-			NoConsole:    s.NoConsole,
+			Bind:      controllerAddresses[i],
+			DSN:       dsn,
+			NoConsole: s.NoConsole,
 		}
 		if err := kong.ApplyDefaults(&config); err != nil {
 			return err
@@ -2,6 +2,7 @@ package main
 
 import (
 	"context"
+	"errors"
 	"net/url"
 	"os"
 	"os/signal"
@@ -36,6 +37,9 @@ type CLI struct {
 	Schema   schemaCmd   `cmd:"" help:"FTL schema commands."`
 	Build    buildCmd    `cmd:"" help:"Build an FTL module."`
 	Deploy   deployCmd   `cmd:"" help:"Create a new deployment."`
+	Canary   canaryCmd   `cmd:"" help:"Change the share of traffic routed to a canary deployment."`
+	Rollback rollbackCmd `cmd:"" help:"Roll a module back to a previous deployment."`
+	History  historyCmd  `cmd:"" help:"List the deployments of a module."`
 	Download downloadCmd `cmd:"" help:"Download a deployment."`
 	Secret   secretCmd   `cmd:"" help:"Manage secrets."`
 	Config   configCmd   `cmd:"" help:"Manage configuration."`
@@ -96,5 +100,10 @@ func main() {
 	kctx.BindTo(ctx, (*context.Context)(nil))
 
 	err := kctx.Run(ctx)
+	var exitErr exitCodeError
+	if errors.As(err, &exitErr) {
+		kctx.Errorf("%s", err)
+		kctx.Exit(exitErr.code)
+	}
 	kctx.FatalIfErrorf(err)
 }
@@ -2,7 +2,9 @@ package moduleconfig
 
 import (
 	"fmt"
+	"math"
 	"path/filepath"
+	"strconv"
 	"strings"
 
 	"github.com/BurntSushi/toml"
@@ -14,6 +16,25 @@ type ModuleGoConfig struct{}
 // ModuleKotlinConfig is language-specific configuration for Kotlin modules.
 type ModuleKotlinConfig struct{}
 
+// ModuleLimits are the resource limits applied to each process of a
+// deployment of the module. Zero values are unlimited.
+type ModuleLimits struct {
+	// CPU is the number of CPU cores, which may be fractional.
+	CPU float64 `toml:"cpu"`
+	// Memory is the maximum memory size, eg. "512MiB".
+	Memory string `toml:"memory"`
+	// OpenFiles is the maximum number of open file descriptors.
+	OpenFiles int64 `toml:"open-files"`
+}
+
+// MemoryBytes returns the memory limit in bytes.
+func (m ModuleLimits) MemoryBytes() (int64, error) {
+	if m.Memory == "" {
+		return 0, nil
+	}
+	return parseByteSize(m.Memory)
+}
+
 // ModuleConfig is the configuration for an FTL module.
 //
 // Module config files are currently TOML.
@@ -34,6 +55,8 @@ type ModuleConfig struct {
 	Schema string `toml:"schema"`
 	// Watch is the list of files to watch for changes.
 	Watch []string `toml:"watch"`
+	// Limits on the resources used by deployments of the module.
+	Limits ModuleLimits `toml:"limits,optional"`
 
 	Go     ModuleGoConfig     `toml:"go,optional"`
 	Kotlin ModuleKotlinConfig `toml:"kotlin,optional"`
@@ -81,7 +104,7 @@ func setConfigDefaults(moduleDir string, config *ModuleConfig) error {
 			config.DeployDir = "_ftl"
 		}
 		if len(config.Deploy) == 0 {
-			config.Deploy = []string{"main"}
+			config.Deploy = []string{"main", "migrations"}
 		}
 		if len(config.Watch) == 0 {
 			config.Watch = []string{"**/*.go", "go.mod", "go.sum"}
@@ -102,9 +125,43 @@ func setConfigDefaults(moduleDir string, config *ModuleConfig) error {
 			return fmt.Errorf("watch files must be relative to the module directory")
 		}
 	}
+	if config.Limits.CPU < 0 || config.Limits.OpenFiles < 0 {
+		return fmt.Errorf("limits must not be negative")
+	}
+	if _, err := config.Limits.MemoryBytes(); err != nil {
+		return fmt.Errorf("limits: %w", err)
+	}
 	return nil
 }
 
+var byteSizeUnits = []struct {
+	suffix     string
+	multiplier int64
+}{
+	{"KiB", 1 << 10}, {"MiB", 1 << 20}, {"GiB", 1 << 30},
+	{"KB", 1000}, {"MB", 1000 * 1000}, {"GB", 1000 * 1000 * 1000},
+	{"B", 1},
+}
+
+// parseByteSize parses a size such as "512MiB" or "1GB" into bytes.
+func parseByteSize(size string) (int64, error) {
+	number, multiplier := size, int64(1)
+	for _, unit := range byteSizeUnits {
+		if strings.HasSuffix(size, unit.suffix) {
+			number, multiplier = strings.TrimSpace(strings.TrimSuffix(size, unit.suffix)), unit.multiplier
+			break
+		}
+	}
+	n, err := strconv.ParseInt(number, 10, 64)
+	if err != nil || n < 0 {
+		return 0, fmt.Errorf("invalid memory size %q", size)
+	}
+	if n > math.MaxInt64/multiplier {
+		return 0, fmt.Errorf("memory size %q is too large", size)
+	}
+	return n * multiplier, nil
+}
+
 func isBeneath(moduleDir, path string) bool {
 	resolved := filepath.Clean(filepath.Join(moduleDir, path))
 	return strings.HasPrefix(resolved, strings.TrimSuffix(moduleDir, "/")+"/")
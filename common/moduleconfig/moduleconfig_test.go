@@ -0,0 +1,28 @@
+package moduleconfig
+
+import (
+	"testing"
+
+	"github.com/alecthomas/assert/v2"
+)
+
+func TestParseByteSize(t *testing.T) {
+	for _, test := range []struct {
+		size     string
+		expected int64
+	}{
+		{"1024", 1024},
+		{"64B", 64},
+		{"512MiB", 512 << 20},
+		{"1 GiB", 1 << 30},
+		{"2MB", 2000000},
+	} {
+		actual, err := parseByteSize(test.size)
+		assert.NoError(t, err, test.size)
+		assert.Equal(t, test.expected, actual, test.size)
+	}
+	for _, size := range []string{"", "MiB", "-1MiB", "1.5GiB", "1TiB", "9223372036854775807KiB", "10000000000GiB"} {
+		_, err := parseByteSize(size)
+		assert.Error(t, err, size)
+	}
+}
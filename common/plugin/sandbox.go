@@ -0,0 +1,92 @@
+package plugin
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// ErrOOMKilled is the cause of a plugin's context being cancelled if the
+// plugin was killed for exceeding its memory limit.
+var ErrOOMKilled = errors.New("killed for exceeding its memory limit")
+
+// Limits on the resources a plugin may use. Zero values are unlimited.
+type Limits struct {
+	// CPU is the number of CPU cores, which may be fractional.
+	CPU         float64
+	MemoryBytes int64
+	OpenFiles   int64
+}
+
+// WithLimits limits the resources the plugin may use.
+//
+// On Linux the open file limit is applied with setrlimit. CPU and memory limits
+// are enforced through a cgroup v2 created for the plugin, and so are only
+// applied if a parent cgroup is provided with [WithCgroupParent].
+func WithLimits(limits Limits) Option {
+	return func(po *pluginOptions) error {
+		po.limits = limits
+		return nil
+	}
+}
+
+// WithCgroupParent creates a cgroup v2 called name for the plugin beneath
+// parent, in which CPU and memory limits are enforced.
+//
+// The name must be unique among the plugins sharing parent, as any existing
+// cgroup with the name is replaced. The cpu and memory controllers will be
+// enabled in the parent, which must not itself contain any processes.
+func WithCgroupParent(parent, name string) Option {
+	return func(po *pluginOptions) error {
+		po.cgroupParent = parent
+		po.cgroupName = name
+		return nil
+	}
+}
+
+// WithInheritedEnvars restricts the environment variables the plugin inherits
+// to those named, rather than the entire environment.
+//
+// A trailing "*" matches any variable with the preceding prefix. Variables
+// passed explicitly with [WithEnvars] are always included.
+func WithInheritedEnvars(names ...string) Option {
+	return func(po *pluginOptions) error {
+		po.inheritEnvars = names
+		po.filterEnvars = true
+		return nil
+	}
+}
+
+// WithPrivateTempDir gives the plugin its own temporary directory, which is
+// emptied each time the plugin is spawned.
+func WithPrivateTempDir() Option {
+	return func(po *pluginOptions) error {
+		po.privateTempDir = true
+		return nil
+	}
+}
+
+// filterEnviron returns the variables in environ matching names.
+func filterEnviron(environ []string, names []string) []string {
+	out := make([]string, 0, len(names))
+	for _, envar := range environ {
+		key, _, _ := strings.Cut(envar, "=")
+		for _, name := range names {
+			if prefix, ok := strings.CutSuffix(name, "*"); ok && strings.HasPrefix(key, prefix) || key == name {
+				out = append(out, envar)
+				break
+			}
+		}
+	}
+	return out
+}
+
+// makeTempDir creates an empty temporary directory in workingDir.
+func makeTempDir(workingDir string) (string, error) {
+	dir := filepath.Join(workingDir, "tmp")
+	if err := os.RemoveAll(dir); err != nil {
+		return "", err
+	}
+	return dir, os.Mkdir(dir, 0700)
+}
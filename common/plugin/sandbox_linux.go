@@ -0,0 +1,134 @@
+package plugin
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"golang.org/x/sys/unix"
+
+	"github.com/TBD54566975/ftl/internal/exec"
+)
+
+// Period over which a cgroup's CPU quota is allocated.
+const cpuPeriod = 100000
+
+// sandbox enforces the resource limits of a single plugin process.
+type sandbox struct {
+	limits    Limits
+	cgroupDir string
+	cgroup    *os.File
+}
+
+// newSandbox prepares cmd to start inside a new cgroup beneath parent, if
+// parent is set.
+func newSandbox(cmd *exec.Cmd, limits Limits, parent, name string) (*sandbox, error) {
+	s := &sandbox{limits: limits}
+	if parent == "" {
+		return s, nil
+	}
+	err := os.WriteFile(filepath.Join(parent, "cgroup.subtree_control"), []byte("+cpu +memory"), 0600)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", "failed to enable cgroup controllers", err)
+	}
+	s.cgroupDir = filepath.Join(parent, name)
+	// Remove the cgroup of a previous process, resetting its OOM count.
+	if err := os.Remove(s.cgroupDir); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return nil, fmt.Errorf("%s: %w", "failed to remove stale cgroup", err)
+	}
+	if err := os.Mkdir(s.cgroupDir, 0700); err != nil {
+		return nil, fmt.Errorf("%s: %w", "failed to create cgroup", err)
+	}
+	if limits.CPU > 0 {
+		quota := max(int64(limits.CPU*cpuPeriod), 1000)
+		if err := s.write("cpu.max", fmt.Sprintf("%d %d", quota, cpuPeriod)); err != nil {
+			return nil, s.close(err)
+		}
+	}
+	if limits.MemoryBytes > 0 {
+		if err := s.write("memory.max", strconv.FormatInt(limits.MemoryBytes, 10)); err != nil {
+			return nil, s.close(err)
+		}
+		// Disable swap so that the limit is reached rather than thrashing.
+		if err := s.write("memory.swap.max", "0"); err != nil && !errors.Is(err, os.ErrNotExist) {
+			return nil, s.close(err)
+		}
+	}
+	s.cgroup, err = os.Open(s.cgroupDir)
+	if err != nil {
+		return nil, s.close(err)
+	}
+	cmd.SysProcAttr.UseCgroupFD = true
+	cmd.SysProcAttr.CgroupFD = int(s.cgroup.Fd())
+	return s, nil
+}
+
+// unenforced returns a description of the limits that can't be enforced.
+func (s *sandbox) unenforced() string {
+	if s.cgroupDir == "" && (s.limits.CPU > 0 || s.limits.MemoryBytes > 0) {
+		return "CPU and memory limits are not enforced without a cgroup"
+	}
+	return ""
+}
+
+// started applies the limits that can only be applied to a running process.
+//
+// The open file limit is applied immediately after the process starts, so it
+// briefly runs without one.
+func (s *sandbox) started(pid int) error {
+	if s.cgroup != nil {
+		_ = s.cgroup.Close()
+		s.cgroup = nil
+	}
+	if s.limits.OpenFiles > 0 {
+		limit := uint64(s.limits.OpenFiles)
+		err := unix.Prlimit(pid, unix.RLIMIT_NOFILE, &unix.Rlimit{Cur: limit, Max: limit}, nil)
+		if err != nil {
+			return fmt.Errorf("%s: %w", "failed to limit open files", err)
+		}
+	}
+	return nil
+}
+
+// exited cleans up after the process exits, returning true if it was killed
+// for exceeding its memory limit.
+func (s *sandbox) exited() bool {
+	if s.cgroupDir == "" {
+		return false
+	}
+	events, err := os.ReadFile(filepath.Join(s.cgroupDir, "memory.events"))
+	_ = s.close(nil)
+	if err != nil {
+		return false
+	}
+	scanner := bufio.NewScanner(bytes.NewReader(events))
+	for scanner.Scan() {
+		if count, ok := strings.CutPrefix(scanner.Text(), "oom_kill "); ok {
+			return count != "0"
+		}
+	}
+	return false
+}
+
+func (s *sandbox) write(file, value string) error {
+	err := os.WriteFile(filepath.Join(s.cgroupDir, file), []byte(value), 0600)
+	if err != nil {
+		return fmt.Errorf("failed to set cgroup %s: %w", file, err)
+	}
+	return nil
+}
+
+// close releases the cgroup, returning err.
+func (s *sandbox) close(err error) error {
+	if s.cgroup != nil {
+		_ = s.cgroup.Close()
+		s.cgroup = nil
+	}
+	_ = os.Remove(s.cgroupDir)
+	return err
+}
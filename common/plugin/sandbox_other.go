@@ -0,0 +1,28 @@
+//go:build !linux
+
+package plugin
+
+import (
+	"github.com/TBD54566975/ftl/internal/exec"
+)
+
+// sandbox is a no-op on platforms other than Linux, where resource limits are
+// not enforced.
+type sandbox struct {
+	limits Limits
+}
+
+func newSandbox(cmd *exec.Cmd, limits Limits, parent, name string) (*sandbox, error) {
+	return &sandbox{limits: limits}, nil
+}
+
+func (s *sandbox) unenforced() string {
+	if s.limits != (Limits{}) {
+		return "resource limits are only enforced on Linux"
+	}
+	return ""
+}
+
+func (s *sandbox) started(pid int) error { return nil }
+
+func (s *sandbox) exited() bool { return false }
@@ -0,0 +1,13 @@
+package plugin
+
+import (
+	"testing"
+
+	"github.com/alecthomas/assert/v2"
+)
+
+func TestFilterEnviron(t *testing.T) {
+	environ := []string{"PATH=/bin", "SECRET=hunter2", "LC_ALL=C", "LC_CTYPE=UTF-8", "PATHEXT=.exe", "LOG_LEVEL=debug"}
+	actual := filterEnviron(environ, []string{"PATH", "LC_*", "LOG_*"})
+	assert.Equal(t, []string{"PATH=/bin", "LC_ALL=C", "LC_CTYPE=UTF-8", "LOG_LEVEL=debug"}, actual)
+}
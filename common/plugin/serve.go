@@ -74,6 +74,17 @@ func RegisterAdditionalHandler[Impl any](path string, handler http.Handler) Star
 	}
 }
 
+// Maximum time a [Shutdowner] is given to shut down, which is less than the
+// time a spawned plugin is given to exit before it is killed.
+const shutdownTimeout = 5 * time.Second
+
+// Shutdowner is implemented by plugin services that need to clean up before
+// the plugin exits.
+type Shutdowner interface {
+	// Shutdown is called when the plugin receives SIGINT or SIGTERM.
+	Shutdown(ctx context.Context) error
+}
+
 // Constructor is a function that creates a new plugin server implementation.
 type Constructor[Impl any, Config any] func(context.Context, Config) (context.Context, Impl, error)
 
@@ -82,6 +93,9 @@ type Constructor[Impl any, Config any] func(context.Context, Config) (context.Co
 //
 // This function does not return.
 //
+// If the service implements [Shutdowner], it is shut down before the plugin
+// exits on SIGINT or SIGTERM.
+//
 // "Config" is Kong configuration to pass to "create".
 // "create" is called to create the implementation of the service.
 // "register" is called to register the service with the gRPC server and is typically a generated function.
@@ -121,16 +135,10 @@ func Start[Impl any, Iface any, Config any](
 
 	logger.Tracef("Starting on %s", cli.Bind)
 
-	// Signal handling.
+	// Signals received while the service is being created are handled once it
+	// has been created.
 	sigch := make(chan os.Signal, 1)
 	signal.Notify(sigch, syscall.SIGINT, syscall.SIGTERM)
-	go func() {
-		sig := <-sigch
-		logger.Debugf("Terminated by signal %s", sig)
-		cancel()
-		_ = syscall.Kill(-syscall.Getpid(), sig.(syscall.Signal)) //nolint:forcetypeassert
-		os.Exit(0)
-	}()
 
 	ctx, svc, err := create(ctx, config)
 	kctx.FatalIfErrorf(err)
@@ -140,6 +148,22 @@ func Start[Impl any, Iface any, Config any](
 		panic(fmt.Sprintf("%s does not implement %s", reflect.TypeOf(svc), reflect.TypeOf(iface)))
 	}
 
+	// Signal handling.
+	go func() {
+		sig := <-sigch
+		logger.Debugf("Terminated by signal %s", sig)
+		if shutdowner, ok := any(svc).(Shutdowner); ok {
+			shutdownCtx, cancelShutdown := context.WithTimeout(context.WithoutCancel(ctx), shutdownTimeout)
+			if err := shutdowner.Shutdown(shutdownCtx); err != nil {
+				logger.Errorf(err, "Shutdown failed")
+			}
+			cancelShutdown()
+		}
+		cancel()
+		_ = syscall.Kill(-syscall.Getpid(), sig.(syscall.Signal)) //nolint:forcetypeassert
+		os.Exit(0)
+	}()
+
 	l, err := net.Listen("tcp", cli.Bind.Host)
 	kctx.FatalIfErrorf(err)
 
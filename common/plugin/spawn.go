@@ -1,9 +1,11 @@
 package plugin
 
 import (
+	"bytes"
 	"context"
 	"errors"
 	"fmt"
+	"io"
 	"net/url"
 	"os"
 	"path/filepath"
@@ -22,6 +24,9 @@ import (
 
 const pluginRetryDelay = time.Millisecond * 100
 
+// Number of lines of stderr retained for diagnosing plugins that exit.
+const stderrTailLines = 50
+
 // PingableClient is a gRPC client that can be pinged.
 type PingableClient interface {
 	Ping(ctx context.Context, req *connect.Request[ftlv1.PingRequest]) (*connect.Response[ftlv1.PingResponse], error)
@@ -31,6 +36,12 @@ type pluginOptions struct {
 	envars            []string
 	additionalClients []func(baseURL string, opts ...connect.ClientOption)
 	startTimeout      time.Duration
+	limits            Limits
+	cgroupParent      string
+	cgroupName        string
+	inheritEnvars     []string
+	filterEnvars      bool
+	privateTempDir    bool
 }
 
 // Option used when creating a plugin.
@@ -68,6 +79,8 @@ type Plugin[Client PingableClient] struct {
 	Cmd      *exec.Cmd
 	Endpoint *url.URL // The endpoint the plugin is listening on.
 	Client   Client
+	// The last lines written to stderr by the plugin.
+	Stderr *exec.CircularBuffer
 }
 
 // Spawn a new sub-process plugin.
@@ -83,6 +96,9 @@ type Plugin[Client PingableClient] struct {
 //
 //	FTL_BIND - the endpoint URI to listen on
 //	FTL_WORKING_DIR - the path to a working directory that the plugin can write state to, if required.
+//
+// If the plugin is killed for exceeding its memory limit, the cause of
+// "cmdCtx" will wrap [ErrOOMKilled].
 func Spawn[Client PingableClient](
 	ctx context.Context,
 	defaultLevel log.Level,
@@ -125,29 +141,74 @@ func Spawn[Client PingableClient](
 	cmd := exec.Command(ctx, defaultLevel, dir, exe)
 
 	// Send the plugin's stderr to the logger.
-	cmd.Stderr = nil
-	pipe, err := cmd.StderrPipe()
+	//
+	// An os.Pipe is used rather than cmd.StderrPipe() so that output written
+	// just before the plugin exits is not discarded when it is waited on.
+	pipe, stderrWriter, err := os.Pipe()
 	if err != nil {
 		return nil, nil, err
 	}
+	cmd.Stderr = stderrWriter
+	if opts.filterEnvars {
+		cmd.Env = filterEnviron(cmd.Env, opts.inheritEnvars)
+	}
+	if opts.privateTempDir {
+		tempDir, err := makeTempDir(workingDir)
+		if err != nil {
+			_ = pipe.Close()
+			_ = stderrWriter.Close()
+			return nil, nil, fmt.Errorf("%s: %w", "failed to create temporary directory", err)
+		}
+		cmd.Env = append(cmd.Env, "TMPDIR="+tempDir)
+	}
 	cmd.Env = append(cmd.Env, "FTL_BIND="+pluginEndpoint.String())
 	cmd.Env = append(cmd.Env, "FTL_WORKING_DIR="+workingDir)
 	cmd.Env = append(cmd.Env, opts.envars...)
-	if err = cmd.Start(); err != nil {
+	sandbox, err := newSandbox(cmd, opts.limits, opts.cgroupParent, opts.cgroupName)
+	if err != nil {
+		_ = pipe.Close()
+		_ = stderrWriter.Close()
+		return nil, nil, err
+	}
+	if warning := sandbox.unenforced(); warning != "" {
+		logger.Warnf("Resource limits are not fully enforced: %s", warning)
+	}
+	err = cmd.Start()
+	_ = stderrWriter.Close()
+	if err != nil {
+		_ = pipe.Close()
+		sandbox.exited()
 		return nil, nil, err
 	}
-	// Cancel the context if the command exits - this will terminate the Dial immediately.
-	var cancelWithCause context.CancelCauseFunc
-	cmdCtx, cancelWithCause = context.WithCancelCause(ctx)
-	go func() { cancelWithCause(cmd.Wait()) }()
 
+	stderr := exec.NewCircularBuffer(stderrTailLines)
+	stderrDone := make(chan struct{})
 	go func() {
-		err := log.JSONStreamer(pipe, logger, log.Error)
+		defer close(stderrDone)
+		defer pipe.Close()
+		err := log.JSONStreamer(io.TeeReader(pipe, &lineWriter{buffer: stderr}), logger, log.Error)
 		if err != nil {
 			logger.Errorf(err, "Error streaming plugin logs.")
 		}
 	}()
 
+	// Cancel the context if the command exits - this will terminate the Dial immediately.
+	var cancelWithCause context.CancelCauseFunc
+	cmdCtx, cancelWithCause = context.WithCancelCause(ctx)
+	go func() {
+		err := cmd.Wait()
+		// Give the remaining output a chance to be read, so that it is
+		// available once cmdCtx is cancelled.
+		select {
+		case <-stderrDone:
+		case <-time.After(time.Second):
+		}
+		if sandbox.exited() {
+			err = fmt.Errorf("%w: %w", ErrOOMKilled, err)
+		}
+		cancelWithCause(err)
+	}()
+
 	defer func() {
 		if err != nil {
 			logger.Warnf("Plugin failed to start, terminating pid %d", cmd.Process.Pid)
@@ -155,6 +216,11 @@ func Spawn[Client PingableClient](
 		}
 	}()
 
+	err = sandbox.started(cmd.Process.Pid)
+	if err != nil {
+		return nil, nil, err
+	}
+
 	// Write the PID file.
 	err = os.WriteFile(pidFile, []byte(strconv.Itoa(cmd.Process.Pid)), 0600)
 	if err != nil {
@@ -197,6 +263,25 @@ func Spawn[Client PingableClient](
 	}
 
 	logger.Debugf("Online")
-	plugin = &Plugin[Client]{Cmd: cmd, Endpoint: pluginEndpoint, Client: client}
+	plugin = &Plugin[Client]{Cmd: cmd, Endpoint: pluginEndpoint, Client: client, Stderr: stderr}
 	return plugin, cmdCtx, nil
 }
+
+// lineWriter writes complete lines to a CircularBuffer.
+type lineWriter struct {
+	buffer  *exec.CircularBuffer
+	partial []byte
+}
+
+func (l *lineWriter) Write(p []byte) (int, error) {
+	l.partial = append(l.partial, p...)
+	for {
+		i := bytes.IndexByte(l.partial, '\n')
+		if i < 0 {
+			break
+		}
+		_ = l.buffer.Write(string(l.partial[:i+1]))
+		l.partial = l.partial[i+1:]
+	}
+	return len(p), nil
+}
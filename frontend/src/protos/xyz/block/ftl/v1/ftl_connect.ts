@@ -3,7 +3,7 @@
 /* eslint-disable */
 // @ts-nocheck
 
-import { CallRequest, CallResponse, CreateDeploymentRequest, CreateDeploymentResponse, DeployRequest, DeployResponse, GetArtefactDiffsRequest, GetArtefactDiffsResponse, GetDeploymentArtefactsRequest, GetDeploymentArtefactsResponse, GetDeploymentRequest, GetDeploymentResponse, GetSchemaRequest, GetSchemaResponse, PingRequest, PingResponse, ProcessListRequest, ProcessListResponse, PullSchemaRequest, PullSchemaResponse, RegisterRunnerRequest, RegisterRunnerResponse, ReplaceDeployRequest, ReplaceDeployResponse, ReserveRequest, ReserveResponse, StatusRequest, StatusResponse, StreamDeploymentLogsRequest, StreamDeploymentLogsResponse, TerminateRequest, UpdateDeployRequest, UpdateDeployResponse, UploadArtefactRequest, UploadArtefactResponse } from "./ftl_pb.js";
+import { CallRequest, CallResponse, CanaryDeployRequest, CanaryDeployResponse, CreateDeploymentRequest, CreateDeploymentResponse, DeployRequest, DeployResponse, GetArtefactDiffsRequest, GetArtefactDiffsResponse, GetDeploymentArtefactsRequest, GetDeploymentArtefactsResponse, GetDeploymentHistoryRequest, GetDeploymentHistoryResponse, GetDeploymentRequest, GetDeploymentResponse, GetSchemaRequest, GetSchemaResponse, PingRequest, PingResponse, ProcessListRequest, ProcessListResponse, PublishEventRequest, PublishEventResponse, PullSchemaRequest, PullSchemaResponse, RegisterRunnerRequest, RegisterRunnerResponse, ReplaceDeployRequest, ReplaceDeployResponse, ReserveRequest, ReserveResponse, RollbackRequest, RollbackResponse, SetCanaryWeightRequest, SetCanaryWeightResponse, StatusRequest, StatusResponse, StreamDeploymentLogsRequest, StreamDeploymentLogsResponse, TerminateRequest, UpdateDeployRequest, UpdateDeployResponse, UploadArtefactRequest, UploadArtefactResponse } from "./ftl_pb.js";
 import { MethodIdempotency, MethodKind } from "@bufbuild/protobuf";
 
 /**
@@ -37,6 +37,20 @@ export const VerbService = {
       O: CallResponse,
       kind: MethodKind.Unary,
     },
+    /**
+     * Issue a call to a streaming Verb, receiving each message it sends.
+     *
+     * A stream ends either when the Verb returns, or with a final error
+     * response.
+     *
+     * @generated from rpc xyz.block.ftl.v1.VerbService.CallStream
+     */
+    callStream: {
+      name: "CallStream",
+      I: CallRequest,
+      O: CallResponse,
+      kind: MethodKind.ServerStreaming,
+    },
   }
 } as const;
 
@@ -175,6 +189,56 @@ export const ControllerService = {
       O: ReplaceDeployResponse,
       kind: MethodKind.Unary,
     },
+    /**
+     * Deploy a new deployment alongside the existing deployment of its module.
+     *
+     * The new deployment receives a weighted share of the module's traffic, and
+     * is rolled back automatically if its error rate is too high.
+     *
+     * @generated from rpc xyz.block.ftl.v1.ControllerService.CanaryDeploy
+     */
+    canaryDeploy: {
+      name: "CanaryDeploy",
+      I: CanaryDeployRequest,
+      O: CanaryDeployResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * Change the share of its module's traffic routed to a canary deployment.
+     *
+     * A weight of 100 promotes the canary, replacing the existing deployment.
+     *
+     * @generated from rpc xyz.block.ftl.v1.ControllerService.SetCanaryWeight
+     */
+    setCanaryWeight: {
+      name: "SetCanaryWeight",
+      I: SetCanaryWeightRequest,
+      O: SetCanaryWeightResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * Replace the active deployment of a module with one of its previous
+     * deployments.
+     *
+     * @generated from rpc xyz.block.ftl.v1.ControllerService.Rollback
+     */
+    rollback: {
+      name: "Rollback",
+      I: RollbackRequest,
+      O: RollbackResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * List the current and previous deployments of a module.
+     *
+     * @generated from rpc xyz.block.ftl.v1.ControllerService.GetDeploymentHistory
+     */
+    getDeploymentHistory: {
+      name: "GetDeploymentHistory",
+      I: GetDeploymentHistoryRequest,
+      O: GetDeploymentHistoryResponse,
+      kind: MethodKind.Unary,
+    },
     /**
      * Stream logs from a deployment
      *
@@ -211,6 +275,19 @@ export const ControllerService = {
       O: PullSchemaResponse,
       kind: MethodKind.ServerStreaming,
     },
+    /**
+     * Publish an event to a topic.
+     *
+     * The event is durably enqueued for each subscription to the topic.
+     *
+     * @generated from rpc xyz.block.ftl.v1.ControllerService.PublishEvent
+     */
+    publishEvent: {
+      name: "PublishEvent",
+      I: PublishEventRequest,
+      O: PublishEventResponse,
+      kind: MethodKind.Unary,
+    },
   }
 } as const;
 
@@ -4,8 +4,8 @@
 // @ts-nocheck
 
 import type { BinaryReadOptions, FieldList, JsonReadOptions, JsonValue, PartialMessage, PlainMessage } from "@bufbuild/protobuf";
-import { Message, proto3, Struct, Timestamp } from "@bufbuild/protobuf";
-import { Module, Schema, VerbRef } from "./schema/schema_pb.js";
+import { Message, proto3, protoInt64, Struct, Timestamp } from "@bufbuild/protobuf";
+import { Module, Schema, TopicRef, VerbRef } from "./schema/schema_pb.js";
 
 /**
  * @generated from enum xyz.block.ftl.v1.DeploymentChangeType
@@ -353,12 +353,24 @@ export class CallResponse_Error extends Message<CallResponse_Error> {
   message = "";
 
   /**
-   * TODO: Richer error type.
-   *
    * @generated from field: optional string stack = 2;
    */
   stack?: string;
 
+  /**
+   * Connect code name of a typed error returned by the Verb, eg. "not_found".
+   *
+   * @generated from field: optional string code = 3;
+   */
+  code?: string;
+
+  /**
+   * JSON encoded details of the error, if any.
+   *
+   * @generated from field: optional bytes details = 4;
+   */
+  details?: Uint8Array;
+
   constructor(data?: PartialMessage<CallResponse_Error>) {
     super();
     proto3.util.initPartial(data, this);
@@ -369,6 +381,8 @@ export class CallResponse_Error extends Message<CallResponse_Error> {
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
     { no: 1, name: "message", kind: "scalar", T: 9 /* ScalarType.STRING */ },
     { no: 2, name: "stack", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+    { no: 3, name: "code", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+    { no: 4, name: "details", kind: "scalar", T: 12 /* ScalarType.BYTES */, opt: true },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CallResponse_Error {
@@ -456,6 +470,80 @@ export class GetSchemaResponse extends Message<GetSchemaResponse> {
   }
 }
 
+/**
+ * @generated from message xyz.block.ftl.v1.PublishEventRequest
+ */
+export class PublishEventRequest extends Message<PublishEventRequest> {
+  /**
+   * @generated from field: xyz.block.ftl.v1.schema.TopicRef topic = 1;
+   */
+  topic?: TopicRef;
+
+  /**
+   * @generated from field: bytes body = 2;
+   */
+  body = new Uint8Array(0);
+
+  constructor(data?: PartialMessage<PublishEventRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "xyz.block.ftl.v1.PublishEventRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "topic", kind: "message", T: TopicRef },
+    { no: 2, name: "body", kind: "scalar", T: 12 /* ScalarType.BYTES */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): PublishEventRequest {
+    return new PublishEventRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): PublishEventRequest {
+    return new PublishEventRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): PublishEventRequest {
+    return new PublishEventRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: PublishEventRequest | PlainMessage<PublishEventRequest> | undefined, b: PublishEventRequest | PlainMessage<PublishEventRequest> | undefined): boolean {
+    return proto3.util.equals(PublishEventRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message xyz.block.ftl.v1.PublishEventResponse
+ */
+export class PublishEventResponse extends Message<PublishEventResponse> {
+  constructor(data?: PartialMessage<PublishEventResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "xyz.block.ftl.v1.PublishEventResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): PublishEventResponse {
+    return new PublishEventResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): PublishEventResponse {
+    return new PublishEventResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): PublishEventResponse {
+    return new PublishEventResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: PublishEventResponse | PlainMessage<PublishEventResponse> | undefined, b: PublishEventResponse | PlainMessage<PublishEventResponse> | undefined): boolean {
+    return proto3.util.equals(PublishEventResponse, a, b);
+  }
+}
+
 /**
  * @generated from message xyz.block.ftl.v1.PullSchemaRequest
  */
@@ -758,6 +846,60 @@ export class DeploymentArtefact extends Message<DeploymentArtefact> {
   }
 }
 
+/**
+ * Resource limits applied to each process of a deployment. Zero values are
+ * unlimited.
+ *
+ * @generated from message xyz.block.ftl.v1.DeploymentLimits
+ */
+export class DeploymentLimits extends Message<DeploymentLimits> {
+  /**
+   * Number of CPU cores, which may be fractional.
+   *
+   * @generated from field: double cpu = 1;
+   */
+  cpu = 0;
+
+  /**
+   * @generated from field: int64 memory_bytes = 2;
+   */
+  memoryBytes = protoInt64.zero;
+
+  /**
+   * @generated from field: int64 open_files = 3;
+   */
+  openFiles = protoInt64.zero;
+
+  constructor(data?: PartialMessage<DeploymentLimits>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "xyz.block.ftl.v1.DeploymentLimits";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "cpu", kind: "scalar", T: 1 /* ScalarType.DOUBLE */ },
+    { no: 2, name: "memory_bytes", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 3, name: "open_files", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): DeploymentLimits {
+    return new DeploymentLimits().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): DeploymentLimits {
+    return new DeploymentLimits().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): DeploymentLimits {
+    return new DeploymentLimits().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: DeploymentLimits | PlainMessage<DeploymentLimits> | undefined, b: DeploymentLimits | PlainMessage<DeploymentLimits> | undefined): boolean {
+    return proto3.util.equals(DeploymentLimits, a, b);
+  }
+}
+
 /**
  * @generated from message xyz.block.ftl.v1.CreateDeploymentRequest
  */
@@ -779,6 +921,19 @@ export class CreateDeploymentRequest extends Message<CreateDeploymentRequest> {
    */
   labels?: Struct;
 
+  /**
+   * Create the deployment even if it makes breaking changes to declarations
+   * consumed by other modules.
+   *
+   * @generated from field: bool force = 4;
+   */
+  force = false;
+
+  /**
+   * @generated from field: xyz.block.ftl.v1.DeploymentLimits limits = 5;
+   */
+  limits?: DeploymentLimits;
+
   constructor(data?: PartialMessage<CreateDeploymentRequest>) {
     super();
     proto3.util.initPartial(data, this);
@@ -790,6 +945,8 @@ export class CreateDeploymentRequest extends Message<CreateDeploymentRequest> {
     { no: 1, name: "schema", kind: "message", T: Module },
     { no: 2, name: "artefacts", kind: "message", T: DeploymentArtefact, repeated: true },
     { no: 3, name: "labels", kind: "message", T: Struct, opt: true },
+    { no: 4, name: "force", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 5, name: "limits", kind: "message", T: DeploymentLimits },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CreateDeploymentRequest {
@@ -991,6 +1148,11 @@ export class GetDeploymentResponse extends Message<GetDeploymentResponse> {
    */
   artefacts: DeploymentArtefact[] = [];
 
+  /**
+   * @generated from field: xyz.block.ftl.v1.DeploymentLimits limits = 3;
+   */
+  limits?: DeploymentLimits;
+
   constructor(data?: PartialMessage<GetDeploymentResponse>) {
     super();
     proto3.util.initPartial(data, this);
@@ -1001,6 +1163,7 @@ export class GetDeploymentResponse extends Message<GetDeploymentResponse> {
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
     { no: 1, name: "schema", kind: "message", T: Module },
     { no: 2, name: "artefacts", kind: "message", T: DeploymentArtefact, repeated: true },
+    { no: 3, name: "limits", kind: "message", T: DeploymentLimits },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetDeploymentResponse {
@@ -1036,11 +1199,6 @@ export class RegisterRunnerRequest extends Message<RegisterRunnerRequest> {
    */
   endpoint = "";
 
-  /**
-   * @generated from field: optional string deployment = 3;
-   */
-  deployment?: string;
-
   /**
    * @generated from field: xyz.block.ftl.v1.RunnerState state = 4;
    */
@@ -1052,11 +1210,25 @@ export class RegisterRunnerRequest extends Message<RegisterRunnerRequest> {
   labels?: Struct;
 
   /**
-   * If present, the reason the Runner is transitioning from ASSIGNED to IDLE.
+   * True if any of the Runner's deployments are crash-looping.
    *
-   * @generated from field: optional string error = 7;
+   * @generated from field: bool unhealthy = 8;
    */
-  error?: string;
+  unhealthy = false;
+
+  /**
+   * Deployments the Runner is hosting.
+   *
+   * @generated from field: repeated string deployments = 9;
+   */
+  deployments: string[] = [];
+
+  /**
+   * Maximum number of deployments the Runner can host.
+   *
+   * @generated from field: int32 capacity = 10;
+   */
+  capacity = 0;
 
   constructor(data?: PartialMessage<RegisterRunnerRequest>) {
     super();
@@ -1068,10 +1240,11 @@ export class RegisterRunnerRequest extends Message<RegisterRunnerRequest> {
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
     { no: 1, name: "key", kind: "scalar", T: 9 /* ScalarType.STRING */ },
     { no: 2, name: "endpoint", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 3, name: "deployment", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
     { no: 4, name: "state", kind: "enum", T: proto3.getEnumType(RunnerState) },
     { no: 5, name: "labels", kind: "message", T: Struct },
-    { no: 7, name: "error", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+    { no: 8, name: "unhealthy", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 9, name: "deployments", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+    { no: 10, name: "capacity", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RegisterRunnerRequest {
@@ -1210,6 +1383,14 @@ export class ReplaceDeployRequest extends Message<ReplaceDeployRequest> {
    */
   minReplicas = 0;
 
+  /**
+   * Proceed even if the deployment makes breaking changes to declarations
+   * consumed by other modules.
+   *
+   * @generated from field: bool force = 3;
+   */
+  force = false;
+
   constructor(data?: PartialMessage<ReplaceDeployRequest>) {
     super();
     proto3.util.initPartial(data, this);
@@ -1220,6 +1401,7 @@ export class ReplaceDeployRequest extends Message<ReplaceDeployRequest> {
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
     { no: 1, name: "deployment_name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
     { no: 2, name: "min_replicas", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 3, name: "force", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ReplaceDeployRequest {
@@ -1270,6 +1452,411 @@ export class ReplaceDeployResponse extends Message<ReplaceDeployResponse> {
   }
 }
 
+/**
+ * @generated from message xyz.block.ftl.v1.CanaryDeployRequest
+ */
+export class CanaryDeployRequest extends Message<CanaryDeployRequest> {
+  /**
+   * @generated from field: string deployment_name = 1;
+   */
+  deploymentName = "";
+
+  /**
+   * @generated from field: int32 min_replicas = 2;
+   */
+  minReplicas = 0;
+
+  /**
+   * Percentage of the module's traffic to route to the canary.
+   *
+   * @generated from field: int32 weight = 3;
+   */
+  weight = 0;
+
+  /**
+   * Proceed even if the deployment makes breaking changes to declarations
+   * consumed by other modules.
+   *
+   * @generated from field: bool force = 4;
+   */
+  force = false;
+
+  constructor(data?: PartialMessage<CanaryDeployRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "xyz.block.ftl.v1.CanaryDeployRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "deployment_name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "min_replicas", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 3, name: "weight", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 4, name: "force", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CanaryDeployRequest {
+    return new CanaryDeployRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CanaryDeployRequest {
+    return new CanaryDeployRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CanaryDeployRequest {
+    return new CanaryDeployRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: CanaryDeployRequest | PlainMessage<CanaryDeployRequest> | undefined, b: CanaryDeployRequest | PlainMessage<CanaryDeployRequest> | undefined): boolean {
+    return proto3.util.equals(CanaryDeployRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message xyz.block.ftl.v1.CanaryDeployResponse
+ */
+export class CanaryDeployResponse extends Message<CanaryDeployResponse> {
+  constructor(data?: PartialMessage<CanaryDeployResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "xyz.block.ftl.v1.CanaryDeployResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CanaryDeployResponse {
+    return new CanaryDeployResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CanaryDeployResponse {
+    return new CanaryDeployResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CanaryDeployResponse {
+    return new CanaryDeployResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: CanaryDeployResponse | PlainMessage<CanaryDeployResponse> | undefined, b: CanaryDeployResponse | PlainMessage<CanaryDeployResponse> | undefined): boolean {
+    return proto3.util.equals(CanaryDeployResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message xyz.block.ftl.v1.SetCanaryWeightRequest
+ */
+export class SetCanaryWeightRequest extends Message<SetCanaryWeightRequest> {
+  /**
+   * @generated from field: string deployment_name = 1;
+   */
+  deploymentName = "";
+
+  /**
+   * Percentage of the module's traffic to route to the canary.
+   *
+   * @generated from field: int32 weight = 2;
+   */
+  weight = 0;
+
+  /**
+   * Proceed even if the deployment makes breaking changes to declarations
+   * consumed by other modules.
+   *
+   * @generated from field: bool force = 3;
+   */
+  force = false;
+
+  constructor(data?: PartialMessage<SetCanaryWeightRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "xyz.block.ftl.v1.SetCanaryWeightRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "deployment_name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "weight", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 3, name: "force", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SetCanaryWeightRequest {
+    return new SetCanaryWeightRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SetCanaryWeightRequest {
+    return new SetCanaryWeightRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SetCanaryWeightRequest {
+    return new SetCanaryWeightRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: SetCanaryWeightRequest | PlainMessage<SetCanaryWeightRequest> | undefined, b: SetCanaryWeightRequest | PlainMessage<SetCanaryWeightRequest> | undefined): boolean {
+    return proto3.util.equals(SetCanaryWeightRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message xyz.block.ftl.v1.SetCanaryWeightResponse
+ */
+export class SetCanaryWeightResponse extends Message<SetCanaryWeightResponse> {
+  constructor(data?: PartialMessage<SetCanaryWeightResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "xyz.block.ftl.v1.SetCanaryWeightResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SetCanaryWeightResponse {
+    return new SetCanaryWeightResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SetCanaryWeightResponse {
+    return new SetCanaryWeightResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SetCanaryWeightResponse {
+    return new SetCanaryWeightResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: SetCanaryWeightResponse | PlainMessage<SetCanaryWeightResponse> | undefined, b: SetCanaryWeightResponse | PlainMessage<SetCanaryWeightResponse> | undefined): boolean {
+    return proto3.util.equals(SetCanaryWeightResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message xyz.block.ftl.v1.RollbackRequest
+ */
+export class RollbackRequest extends Message<RollbackRequest> {
+  /**
+   * @generated from field: string module = 1;
+   */
+  module = "";
+
+  /**
+   * Deployment to roll back to, defaulting to the deployment preceding the
+   * module's active deployment.
+   *
+   * @generated from field: optional string deployment_name = 2;
+   */
+  deploymentName?: string;
+
+  /**
+   * Proceed even if the deployment makes breaking changes to declarations
+   * consumed by other modules.
+   *
+   * @generated from field: bool force = 3;
+   */
+  force = false;
+
+  constructor(data?: PartialMessage<RollbackRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "xyz.block.ftl.v1.RollbackRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "module", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "deployment_name", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+    { no: 3, name: "force", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RollbackRequest {
+    return new RollbackRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RollbackRequest {
+    return new RollbackRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RollbackRequest {
+    return new RollbackRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: RollbackRequest | PlainMessage<RollbackRequest> | undefined, b: RollbackRequest | PlainMessage<RollbackRequest> | undefined): boolean {
+    return proto3.util.equals(RollbackRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message xyz.block.ftl.v1.RollbackResponse
+ */
+export class RollbackResponse extends Message<RollbackResponse> {
+  /**
+   * @generated from field: string deployment_name = 1;
+   */
+  deploymentName = "";
+
+  constructor(data?: PartialMessage<RollbackResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "xyz.block.ftl.v1.RollbackResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "deployment_name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RollbackResponse {
+    return new RollbackResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RollbackResponse {
+    return new RollbackResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RollbackResponse {
+    return new RollbackResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: RollbackResponse | PlainMessage<RollbackResponse> | undefined, b: RollbackResponse | PlainMessage<RollbackResponse> | undefined): boolean {
+    return proto3.util.equals(RollbackResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message xyz.block.ftl.v1.GetDeploymentHistoryRequest
+ */
+export class GetDeploymentHistoryRequest extends Message<GetDeploymentHistoryRequest> {
+  /**
+   * @generated from field: string module = 1;
+   */
+  module = "";
+
+  constructor(data?: PartialMessage<GetDeploymentHistoryRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "xyz.block.ftl.v1.GetDeploymentHistoryRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "module", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetDeploymentHistoryRequest {
+    return new GetDeploymentHistoryRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetDeploymentHistoryRequest {
+    return new GetDeploymentHistoryRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetDeploymentHistoryRequest {
+    return new GetDeploymentHistoryRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetDeploymentHistoryRequest | PlainMessage<GetDeploymentHistoryRequest> | undefined, b: GetDeploymentHistoryRequest | PlainMessage<GetDeploymentHistoryRequest> | undefined): boolean {
+    return proto3.util.equals(GetDeploymentHistoryRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message xyz.block.ftl.v1.GetDeploymentHistoryResponse
+ */
+export class GetDeploymentHistoryResponse extends Message<GetDeploymentHistoryResponse> {
+  /**
+   * Deployments of the module, most recent first.
+   *
+   * @generated from field: repeated xyz.block.ftl.v1.GetDeploymentHistoryResponse.Deployment deployments = 1;
+   */
+  deployments: GetDeploymentHistoryResponse_Deployment[] = [];
+
+  constructor(data?: PartialMessage<GetDeploymentHistoryResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "xyz.block.ftl.v1.GetDeploymentHistoryResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "deployments", kind: "message", T: GetDeploymentHistoryResponse_Deployment, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetDeploymentHistoryResponse {
+    return new GetDeploymentHistoryResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetDeploymentHistoryResponse {
+    return new GetDeploymentHistoryResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetDeploymentHistoryResponse {
+    return new GetDeploymentHistoryResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetDeploymentHistoryResponse | PlainMessage<GetDeploymentHistoryResponse> | undefined, b: GetDeploymentHistoryResponse | PlainMessage<GetDeploymentHistoryResponse> | undefined): boolean {
+    return proto3.util.equals(GetDeploymentHistoryResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message xyz.block.ftl.v1.GetDeploymentHistoryResponse.Deployment
+ */
+export class GetDeploymentHistoryResponse_Deployment extends Message<GetDeploymentHistoryResponse_Deployment> {
+  /**
+   * @generated from field: string deployment_name = 1;
+   */
+  deploymentName = "";
+
+  /**
+   * @generated from field: google.protobuf.Timestamp created_at = 2;
+   */
+  createdAt?: Timestamp;
+
+  /**
+   * @generated from field: int32 min_replicas = 3;
+   */
+  minReplicas = 0;
+
+  /**
+   * @generated from field: bool canary = 4;
+   */
+  canary = false;
+
+  /**
+   * @generated from field: xyz.block.ftl.v1.schema.Module schema = 5;
+   */
+  schema?: Module;
+
+  constructor(data?: PartialMessage<GetDeploymentHistoryResponse_Deployment>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "xyz.block.ftl.v1.GetDeploymentHistoryResponse.Deployment";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "deployment_name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "created_at", kind: "message", T: Timestamp },
+    { no: 3, name: "min_replicas", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 4, name: "canary", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 5, name: "schema", kind: "message", T: Module },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetDeploymentHistoryResponse_Deployment {
+    return new GetDeploymentHistoryResponse_Deployment().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetDeploymentHistoryResponse_Deployment {
+    return new GetDeploymentHistoryResponse_Deployment().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetDeploymentHistoryResponse_Deployment {
+    return new GetDeploymentHistoryResponse_Deployment().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetDeploymentHistoryResponse_Deployment | PlainMessage<GetDeploymentHistoryResponse_Deployment> | undefined, b: GetDeploymentHistoryResponse_Deployment | PlainMessage<GetDeploymentHistoryResponse_Deployment> | undefined): boolean {
+    return proto3.util.equals(GetDeploymentHistoryResponse_Deployment, a, b);
+  }
+}
+
 /**
  * @generated from message xyz.block.ftl.v1.StreamDeploymentLogsRequest
  */
@@ -1564,14 +2151,19 @@ export class StatusResponse_Runner extends Message<StatusResponse_Runner> {
   state = RunnerState.RUNNER_IDLE;
 
   /**
-   * @generated from field: optional string deployment = 5;
+   * @generated from field: google.protobuf.Struct labels = 6;
    */
-  deployment?: string;
+  labels?: Struct;
 
   /**
-   * @generated from field: google.protobuf.Struct labels = 6;
+   * @generated from field: repeated string deployments = 7;
    */
-  labels?: Struct;
+  deployments: string[] = [];
+
+  /**
+   * @generated from field: int32 capacity = 8;
+   */
+  capacity = 0;
 
   constructor(data?: PartialMessage<StatusResponse_Runner>) {
     super();
@@ -1585,8 +2177,9 @@ export class StatusResponse_Runner extends Message<StatusResponse_Runner> {
     { no: 2, name: "languages", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
     { no: 3, name: "endpoint", kind: "scalar", T: 9 /* ScalarType.STRING */ },
     { no: 4, name: "state", kind: "enum", T: proto3.getEnumType(RunnerState) },
-    { no: 5, name: "deployment", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
     { no: 6, name: "labels", kind: "message", T: Struct },
+    { no: 7, name: "deployments", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+    { no: 8, name: "capacity", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): StatusResponse_Runner {
@@ -1970,6 +2563,14 @@ export class DeployRequest extends Message<DeployRequest> {
    */
   deploymentName = "";
 
+  /**
+   * DSNs of the Postgres databases provisioned for the deployment, keyed by
+   * database name.
+   *
+   * @generated from field: map<string, string> database_dsns = 2;
+   */
+  databaseDsns: { [key: string]: string } = {};
+
   constructor(data?: PartialMessage<DeployRequest>) {
     super();
     proto3.util.initPartial(data, this);
@@ -1979,6 +2580,7 @@ export class DeployRequest extends Message<DeployRequest> {
   static readonly typeName = "xyz.block.ftl.v1.DeployRequest";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
     { no: 1, name: "deployment_name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "database_dsns", kind: "map", K: 9 /* ScalarType.STRING */, V: {kind: "scalar", T: 9 /* ScalarType.STRING */} },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): DeployRequest {
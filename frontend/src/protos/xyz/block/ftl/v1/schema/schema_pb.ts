@@ -156,6 +156,55 @@ export class SourceRef extends Message<SourceRef> {
   }
 }
 
+/**
+ * @generated from message xyz.block.ftl.v1.schema.TopicRef
+ */
+export class TopicRef extends Message<TopicRef> {
+  /**
+   * @generated from field: optional xyz.block.ftl.v1.schema.Position pos = 1;
+   */
+  pos?: Position;
+
+  /**
+   * @generated from field: string name = 2;
+   */
+  name = "";
+
+  /**
+   * @generated from field: string module = 3;
+   */
+  module = "";
+
+  constructor(data?: PartialMessage<TopicRef>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "xyz.block.ftl.v1.schema.TopicRef";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "pos", kind: "message", T: Position, opt: true },
+    { no: 2, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "module", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): TopicRef {
+    return new TopicRef().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): TopicRef {
+    return new TopicRef().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): TopicRef {
+    return new TopicRef().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: TopicRef | PlainMessage<TopicRef> | undefined, b: TopicRef | PlainMessage<TopicRef> | undefined): boolean {
+    return proto3.util.equals(TopicRef, a, b);
+  }
+}
+
 /**
  * @generated from message xyz.block.ftl.v1.schema.VerbRef
  */
@@ -561,6 +610,18 @@ export class Decl extends Message<Decl> {
      */
     value: Enum;
     case: "enum";
+  } | {
+    /**
+     * @generated from field: xyz.block.ftl.v1.schema.Topic topic = 5;
+     */
+    value: Topic;
+    case: "topic";
+  } | {
+    /**
+     * @generated from field: xyz.block.ftl.v1.schema.Subscription subscription = 6;
+     */
+    value: Subscription;
+    case: "subscription";
   } | { case: undefined; value?: undefined } = { case: undefined };
 
   constructor(data?: PartialMessage<Decl>) {
@@ -575,6 +636,8 @@ export class Decl extends Message<Decl> {
     { no: 2, name: "verb", kind: "message", T: Verb, oneof: "value" },
     { no: 3, name: "database", kind: "message", T: Database, oneof: "value" },
     { no: 4, name: "enum", kind: "message", T: Enum, oneof: "value" },
+    { no: 5, name: "topic", kind: "message", T: Topic, oneof: "value" },
+    { no: 6, name: "subscription", kind: "message", T: Subscription, oneof: "value" },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): Decl {
@@ -802,6 +865,195 @@ export class Float extends Message<Float> {
   }
 }
 
+/**
+ * @generated from message xyz.block.ftl.v1.schema.IngressCORS
+ */
+export class IngressCORS extends Message<IngressCORS> {
+  /**
+   * @generated from field: optional xyz.block.ftl.v1.schema.Position pos = 1;
+   */
+  pos?: Position;
+
+  /**
+   * @generated from field: repeated string origins = 2;
+   */
+  origins: string[] = [];
+
+  /**
+   * @generated from field: repeated string methods = 3;
+   */
+  methods: string[] = [];
+
+  /**
+   * @generated from field: repeated string headers = 4;
+   */
+  headers: string[] = [];
+
+  /**
+   * @generated from field: bool credentials = 5;
+   */
+  credentials = false;
+
+  /**
+   * @generated from field: string maxAge = 6;
+   */
+  maxAge = "";
+
+  constructor(data?: PartialMessage<IngressCORS>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "xyz.block.ftl.v1.schema.IngressCORS";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "pos", kind: "message", T: Position, opt: true },
+    { no: 2, name: "origins", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+    { no: 3, name: "methods", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+    { no: 4, name: "headers", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+    { no: 5, name: "credentials", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 6, name: "maxAge", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): IngressCORS {
+    return new IngressCORS().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): IngressCORS {
+    return new IngressCORS().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): IngressCORS {
+    return new IngressCORS().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: IngressCORS | PlainMessage<IngressCORS> | undefined, b: IngressCORS | PlainMessage<IngressCORS> | undefined): boolean {
+    return proto3.util.equals(IngressCORS, a, b);
+  }
+}
+
+/**
+ * @generated from message xyz.block.ftl.v1.schema.IngressCache
+ */
+export class IngressCache extends Message<IngressCache> {
+  /**
+   * @generated from field: optional xyz.block.ftl.v1.schema.Position pos = 1;
+   */
+  pos?: Position;
+
+  /**
+   * @generated from field: string ttl = 2;
+   */
+  ttl = "";
+
+  /**
+   * @generated from field: repeated string vary = 3;
+   */
+  vary: string[] = [];
+
+  /**
+   * @generated from field: string staleWhileRevalidate = 4;
+   */
+  staleWhileRevalidate = "";
+
+  constructor(data?: PartialMessage<IngressCache>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "xyz.block.ftl.v1.schema.IngressCache";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "pos", kind: "message", T: Position, opt: true },
+    { no: 2, name: "ttl", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "vary", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+    { no: 4, name: "staleWhileRevalidate", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): IngressCache {
+    return new IngressCache().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): IngressCache {
+    return new IngressCache().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): IngressCache {
+    return new IngressCache().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: IngressCache | PlainMessage<IngressCache> | undefined, b: IngressCache | PlainMessage<IngressCache> | undefined): boolean {
+    return proto3.util.equals(IngressCache, a, b);
+  }
+}
+
+/**
+ * @generated from message xyz.block.ftl.v1.schema.IngressRateLimit
+ */
+export class IngressRateLimit extends Message<IngressRateLimit> {
+  /**
+   * @generated from field: optional xyz.block.ftl.v1.schema.Position pos = 1;
+   */
+  pos?: Position;
+
+  /**
+   * @generated from field: int64 rate = 2;
+   */
+  rate = protoInt64.zero;
+
+  /**
+   * @generated from field: string interval = 3;
+   */
+  interval = "";
+
+  /**
+   * @generated from field: int64 burst = 4;
+   */
+  burst = protoInt64.zero;
+
+  /**
+   * @generated from field: string key = 5;
+   */
+  key = "";
+
+  /**
+   * @generated from field: string header = 6;
+   */
+  header = "";
+
+  constructor(data?: PartialMessage<IngressRateLimit>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "xyz.block.ftl.v1.schema.IngressRateLimit";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "pos", kind: "message", T: Position, opt: true },
+    { no: 2, name: "rate", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 3, name: "interval", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "burst", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 5, name: "key", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 6, name: "header", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): IngressRateLimit {
+    return new IngressRateLimit().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): IngressRateLimit {
+    return new IngressRateLimit().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): IngressRateLimit {
+    return new IngressRateLimit().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: IngressRateLimit | PlainMessage<IngressRateLimit> | undefined, b: IngressRateLimit | PlainMessage<IngressRateLimit> | undefined): boolean {
+    return proto3.util.equals(IngressRateLimit, a, b);
+  }
+}
+
 /**
  * @generated from message xyz.block.ftl.v1.schema.IngressPathComponent
  */
@@ -909,6 +1161,11 @@ export class IngressPathParameter extends Message<IngressPathParameter> {
    */
   name = "";
 
+  /**
+   * @generated from field: bool catchAll = 3;
+   */
+  catchAll = false;
+
   constructor(data?: PartialMessage<IngressPathParameter>) {
     super();
     proto3.util.initPartial(data, this);
@@ -919,6 +1176,7 @@ export class IngressPathParameter extends Message<IngressPathParameter> {
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
     { no: 1, name: "pos", kind: "message", T: Position, opt: true },
     { no: 2, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "catchAll", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): IngressPathParameter {
@@ -1092,6 +1350,36 @@ export class Metadata extends Message<Metadata> {
      */
     value: MetadataDatabases;
     case: "databases";
+  } | {
+    /**
+     * @generated from field: xyz.block.ftl.v1.schema.MetadataCron cron = 4;
+     */
+    value: MetadataCron;
+    case: "cron";
+  } | {
+    /**
+     * @generated from field: xyz.block.ftl.v1.schema.MetadataSubscriber subscriber = 5;
+     */
+    value: MetadataSubscriber;
+    case: "subscriber";
+  } | {
+    /**
+     * @generated from field: xyz.block.ftl.v1.schema.MetadataRetry retry = 6;
+     */
+    value: MetadataRetry;
+    case: "retry";
+  } | {
+    /**
+     * @generated from field: xyz.block.ftl.v1.schema.MetadataTimeout timeout = 7;
+     */
+    value: MetadataTimeout;
+    case: "timeout";
+  } | {
+    /**
+     * @generated from field: xyz.block.ftl.v1.schema.MetadataRateLimit rateLimit = 8;
+     */
+    value: MetadataRateLimit;
+    case: "rateLimit";
   } | { case: undefined; value?: undefined } = { case: undefined };
 
   constructor(data?: PartialMessage<Metadata>) {
@@ -1105,6 +1393,11 @@ export class Metadata extends Message<Metadata> {
     { no: 1, name: "calls", kind: "message", T: MetadataCalls, oneof: "value" },
     { no: 2, name: "ingress", kind: "message", T: MetadataIngress, oneof: "value" },
     { no: 3, name: "databases", kind: "message", T: MetadataDatabases, oneof: "value" },
+    { no: 4, name: "cron", kind: "message", T: MetadataCron, oneof: "value" },
+    { no: 5, name: "subscriber", kind: "message", T: MetadataSubscriber, oneof: "value" },
+    { no: 6, name: "retry", kind: "message", T: MetadataRetry, oneof: "value" },
+    { no: 7, name: "timeout", kind: "message", T: MetadataTimeout, oneof: "value" },
+    { no: 8, name: "rateLimit", kind: "message", T: MetadataRateLimit, oneof: "value" },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): Metadata {
@@ -1167,6 +1460,49 @@ export class MetadataCalls extends Message<MetadataCalls> {
   }
 }
 
+/**
+ * @generated from message xyz.block.ftl.v1.schema.MetadataCron
+ */
+export class MetadataCron extends Message<MetadataCron> {
+  /**
+   * @generated from field: optional xyz.block.ftl.v1.schema.Position pos = 1;
+   */
+  pos?: Position;
+
+  /**
+   * @generated from field: string cron = 2;
+   */
+  cron = "";
+
+  constructor(data?: PartialMessage<MetadataCron>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "xyz.block.ftl.v1.schema.MetadataCron";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "pos", kind: "message", T: Position, opt: true },
+    { no: 2, name: "cron", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): MetadataCron {
+    return new MetadataCron().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): MetadataCron {
+    return new MetadataCron().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): MetadataCron {
+    return new MetadataCron().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: MetadataCron | PlainMessage<MetadataCron> | undefined, b: MetadataCron | PlainMessage<MetadataCron> | undefined): boolean {
+    return proto3.util.equals(MetadataCron, a, b);
+  }
+}
+
 /**
  * @generated from message xyz.block.ftl.v1.schema.MetadataDatabases
  */
@@ -1234,6 +1570,31 @@ export class MetadataIngress extends Message<MetadataIngress> {
    */
   path: IngressPathComponent[] = [];
 
+  /**
+   * @generated from field: string auth = 5;
+   */
+  auth = "";
+
+  /**
+   * @generated from field: optional xyz.block.ftl.v1.schema.VerbRef authorizer = 6;
+   */
+  authorizer?: VerbRef;
+
+  /**
+   * @generated from field: optional xyz.block.ftl.v1.schema.IngressCORS cors = 7;
+   */
+  cors?: IngressCORS;
+
+  /**
+   * @generated from field: optional xyz.block.ftl.v1.schema.IngressCache cache = 8;
+   */
+  cache?: IngressCache;
+
+  /**
+   * @generated from field: optional xyz.block.ftl.v1.schema.IngressRateLimit rateLimit = 9;
+   */
+  rateLimit?: IngressRateLimit;
+
   constructor(data?: PartialMessage<MetadataIngress>) {
     super();
     proto3.util.initPartial(data, this);
@@ -1246,6 +1607,11 @@ export class MetadataIngress extends Message<MetadataIngress> {
     { no: 2, name: "type", kind: "scalar", T: 9 /* ScalarType.STRING */ },
     { no: 3, name: "method", kind: "scalar", T: 9 /* ScalarType.STRING */ },
     { no: 4, name: "path", kind: "message", T: IngressPathComponent, repeated: true },
+    { no: 5, name: "auth", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 6, name: "authorizer", kind: "message", T: VerbRef, opt: true },
+    { no: 7, name: "cors", kind: "message", T: IngressCORS, opt: true },
+    { no: 8, name: "cache", kind: "message", T: IngressCache, opt: true },
+    { no: 9, name: "rateLimit", kind: "message", T: IngressRateLimit, opt: true },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): MetadataIngress {
@@ -1265,6 +1631,214 @@ export class MetadataIngress extends Message<MetadataIngress> {
   }
 }
 
+/**
+ * @generated from message xyz.block.ftl.v1.schema.MetadataRateLimit
+ */
+export class MetadataRateLimit extends Message<MetadataRateLimit> {
+  /**
+   * @generated from field: optional xyz.block.ftl.v1.schema.Position pos = 1;
+   */
+  pos?: Position;
+
+  /**
+   * @generated from field: int64 rate = 2;
+   */
+  rate = protoInt64.zero;
+
+  /**
+   * @generated from field: string interval = 3;
+   */
+  interval = "";
+
+  /**
+   * @generated from field: int64 burst = 4;
+   */
+  burst = protoInt64.zero;
+
+  /**
+   * @generated from field: string key = 5;
+   */
+  key = "";
+
+  /**
+   * @generated from field: string header = 6;
+   */
+  header = "";
+
+  constructor(data?: PartialMessage<MetadataRateLimit>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "xyz.block.ftl.v1.schema.MetadataRateLimit";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "pos", kind: "message", T: Position, opt: true },
+    { no: 2, name: "rate", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 3, name: "interval", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "burst", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 5, name: "key", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 6, name: "header", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): MetadataRateLimit {
+    return new MetadataRateLimit().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): MetadataRateLimit {
+    return new MetadataRateLimit().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): MetadataRateLimit {
+    return new MetadataRateLimit().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: MetadataRateLimit | PlainMessage<MetadataRateLimit> | undefined, b: MetadataRateLimit | PlainMessage<MetadataRateLimit> | undefined): boolean {
+    return proto3.util.equals(MetadataRateLimit, a, b);
+  }
+}
+
+/**
+ * @generated from message xyz.block.ftl.v1.schema.MetadataRetry
+ */
+export class MetadataRetry extends Message<MetadataRetry> {
+  /**
+   * @generated from field: optional xyz.block.ftl.v1.schema.Position pos = 1;
+   */
+  pos?: Position;
+
+  /**
+   * @generated from field: int64 count = 2;
+   */
+  count = protoInt64.zero;
+
+  /**
+   * @generated from field: string minBackoff = 3;
+   */
+  minBackoff = "";
+
+  /**
+   * @generated from field: string maxBackoff = 4;
+   */
+  maxBackoff = "";
+
+  constructor(data?: PartialMessage<MetadataRetry>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "xyz.block.ftl.v1.schema.MetadataRetry";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "pos", kind: "message", T: Position, opt: true },
+    { no: 2, name: "count", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 3, name: "minBackoff", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "maxBackoff", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): MetadataRetry {
+    return new MetadataRetry().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): MetadataRetry {
+    return new MetadataRetry().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): MetadataRetry {
+    return new MetadataRetry().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: MetadataRetry | PlainMessage<MetadataRetry> | undefined, b: MetadataRetry | PlainMessage<MetadataRetry> | undefined): boolean {
+    return proto3.util.equals(MetadataRetry, a, b);
+  }
+}
+
+/**
+ * @generated from message xyz.block.ftl.v1.schema.MetadataSubscriber
+ */
+export class MetadataSubscriber extends Message<MetadataSubscriber> {
+  /**
+   * @generated from field: optional xyz.block.ftl.v1.schema.Position pos = 1;
+   */
+  pos?: Position;
+
+  /**
+   * @generated from field: string name = 2;
+   */
+  name = "";
+
+  constructor(data?: PartialMessage<MetadataSubscriber>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "xyz.block.ftl.v1.schema.MetadataSubscriber";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "pos", kind: "message", T: Position, opt: true },
+    { no: 2, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): MetadataSubscriber {
+    return new MetadataSubscriber().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): MetadataSubscriber {
+    return new MetadataSubscriber().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): MetadataSubscriber {
+    return new MetadataSubscriber().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: MetadataSubscriber | PlainMessage<MetadataSubscriber> | undefined, b: MetadataSubscriber | PlainMessage<MetadataSubscriber> | undefined): boolean {
+    return proto3.util.equals(MetadataSubscriber, a, b);
+  }
+}
+
+/**
+ * @generated from message xyz.block.ftl.v1.schema.MetadataTimeout
+ */
+export class MetadataTimeout extends Message<MetadataTimeout> {
+  /**
+   * @generated from field: optional xyz.block.ftl.v1.schema.Position pos = 1;
+   */
+  pos?: Position;
+
+  /**
+   * @generated from field: string timeout = 2;
+   */
+  timeout = "";
+
+  constructor(data?: PartialMessage<MetadataTimeout>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "xyz.block.ftl.v1.schema.MetadataTimeout";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "pos", kind: "message", T: Position, opt: true },
+    { no: 2, name: "timeout", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): MetadataTimeout {
+    return new MetadataTimeout().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): MetadataTimeout {
+    return new MetadataTimeout().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): MetadataTimeout {
+    return new MetadataTimeout().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: MetadataTimeout | PlainMessage<MetadataTimeout> | undefined, b: MetadataTimeout | PlainMessage<MetadataTimeout> | undefined): boolean {
+    return proto3.util.equals(MetadataTimeout, a, b);
+  }
+}
+
 /**
  * @generated from message xyz.block.ftl.v1.schema.Module
  */
@@ -1547,6 +2121,61 @@ export class StringValue extends Message<StringValue> {
   }
 }
 
+/**
+ * @generated from message xyz.block.ftl.v1.schema.Subscription
+ */
+export class Subscription extends Message<Subscription> {
+  /**
+   * @generated from field: optional xyz.block.ftl.v1.schema.Position pos = 1;
+   */
+  pos?: Position;
+
+  /**
+   * @generated from field: repeated string comments = 2;
+   */
+  comments: string[] = [];
+
+  /**
+   * @generated from field: string name = 3;
+   */
+  name = "";
+
+  /**
+   * @generated from field: xyz.block.ftl.v1.schema.TopicRef topic = 4;
+   */
+  topic?: TopicRef;
+
+  constructor(data?: PartialMessage<Subscription>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "xyz.block.ftl.v1.schema.Subscription";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "pos", kind: "message", T: Position, opt: true },
+    { no: 2, name: "comments", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+    { no: 3, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "topic", kind: "message", T: TopicRef },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): Subscription {
+    return new Subscription().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): Subscription {
+    return new Subscription().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): Subscription {
+    return new Subscription().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: Subscription | PlainMessage<Subscription> | undefined, b: Subscription | PlainMessage<Subscription> | undefined): boolean {
+    return proto3.util.equals(Subscription, a, b);
+  }
+}
+
 /**
  * @generated from message xyz.block.ftl.v1.schema.Time
  */
@@ -1584,6 +2213,61 @@ export class Time extends Message<Time> {
   }
 }
 
+/**
+ * @generated from message xyz.block.ftl.v1.schema.Topic
+ */
+export class Topic extends Message<Topic> {
+  /**
+   * @generated from field: optional xyz.block.ftl.v1.schema.Position pos = 1;
+   */
+  pos?: Position;
+
+  /**
+   * @generated from field: repeated string comments = 2;
+   */
+  comments: string[] = [];
+
+  /**
+   * @generated from field: string name = 3;
+   */
+  name = "";
+
+  /**
+   * @generated from field: xyz.block.ftl.v1.schema.Type event = 4;
+   */
+  event?: Type;
+
+  constructor(data?: PartialMessage<Topic>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "xyz.block.ftl.v1.schema.Topic";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "pos", kind: "message", T: Position, opt: true },
+    { no: 2, name: "comments", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+    { no: 3, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "event", kind: "message", T: Type },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): Topic {
+    return new Topic().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): Topic {
+    return new Topic().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): Topic {
+    return new Topic().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: Topic | PlainMessage<Topic> | undefined, b: Topic | PlainMessage<Topic> | undefined): boolean {
+    return proto3.util.equals(Topic, a, b);
+  }
+}
+
 /**
  * @generated from message xyz.block.ftl.v1.schema.Type
  */
@@ -1663,6 +2347,12 @@ export class Type extends Message<Type> {
      */
     value: Optional;
     case: "optional";
+  } | {
+    /**
+     * @generated from field: xyz.block.ftl.v1.schema.EnumRef enumRef = 13;
+     */
+    value: EnumRef;
+    case: "enumRef";
   } | { case: undefined; value?: undefined } = { case: undefined };
 
   constructor(data?: PartialMessage<Type>) {
@@ -1685,6 +2375,7 @@ export class Type extends Message<Type> {
     { no: 10, name: "unit", kind: "message", T: Unit, oneof: "value" },
     { no: 11, name: "dataRef", kind: "message", T: DataRef, oneof: "value" },
     { no: 12, name: "optional", kind: "message", T: Optional, oneof: "value" },
+    { no: 13, name: "enumRef", kind: "message", T: EnumRef, oneof: "value" },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): Type {
@@ -14,7 +14,15 @@ import (
 func main() {
   verbConstructor := server.NewUserVerbServer("{{.Name}}",
 {{- range .Verbs}}
+{{- if .Stream}}
+    server.HandleStream({{$.Name}}.{{.Name}}),
+{{- else if .Empty}}
+    server.HandleEmpty({{$.Name}}.{{.Name}}),
+{{- else if .Sink}}
+    server.HandleSink({{$.Name}}.{{.Name}}),
+{{- else}}
     server.Handle({{$.Name}}.{{.Name}}),
+{{- end}}
 {{- end}}
   )
   plugin.Start(context.Background(), "{{.Name}}", verbConstructor, ftlv1connect.VerbServiceName, ftlv1connect.NewVerbServiceHandler)
@@ -2,7 +2,9 @@ package compile
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"io/fs"
 	"maps"
 	"net"
 	"os"
@@ -35,6 +37,12 @@ type externalModuleContext struct {
 
 type goVerb struct {
 	Name string
+	// Empty is true if the Verb neither accepts input nor returns output.
+	Empty bool
+	// Sink is true if the Verb accepts input but does not return output.
+	Sink bool
+	// Stream is true if the Verb sends its output to an ftl.Stream.
+	Stream bool
 }
 
 type mainModuleContext struct {
@@ -58,6 +66,10 @@ func (b externalModuleContext) NonMainModules() []*schema.Module {
 
 const buildDirName = "_ftl"
 
+// migrationsDirName is the directory, relative to both the module and build
+// directories, containing SQL migrations for each of the module's databases.
+const migrationsDirName = "migrations"
+
 // Build the given module.
 func Build(ctx context.Context, moduleDir string, sch *schema.Schema) error {
 	replacements, goModVersion, err := updateGoModule(filepath.Join(moduleDir, "go.mod"))
@@ -122,7 +134,18 @@ func Build(ctx context.Context, moduleDir string, sch *schema.Schema) error {
 			if !ok {
 				return fmt.Errorf("missing native name for verb %s", verb.Name)
 			}
-			goVerbs = append(goVerbs, goVerb{Name: nativeName})
+			_, reqIsUnit := verb.Request.(*schema.Unit)
+			_, respIsUnit := verb.Response.(*schema.Unit)
+			streaming := false
+			if ingress, ok := verb.GetMetadataIngress().Get(); ok {
+				streaming = ingress.IsStreaming()
+			}
+			goVerbs = append(goVerbs, goVerb{
+				Name:   nativeName,
+				Empty:  reqIsUnit && respIsUnit && !streaming,
+				Sink:   !reqIsUnit && respIsUnit && !streaming,
+				Stream: streaming,
+			})
 		}
 	}
 	if err := internal.ScaffoldZip(buildTemplateFiles(), moduleDir, mainModuleContext{
@@ -141,7 +164,51 @@ func Build(ctx context.Context, moduleDir string, sch *schema.Schema) error {
 		return fmt.Errorf("failed to tidy go.mod: %w", err)
 	}
 
-	return exec.Command(ctx, log.Debug, mainDir, "go", "build", "-o", "../../main", ".").RunBuffered(ctx)
+	if err := exec.Command(ctx, log.Debug, mainDir, "go", "build", "-o", "../../main", ".").RunBuffered(ctx); err != nil {
+		return err
+	}
+
+	logger.Debugf("Copying database migrations")
+	return copyMigrations(moduleDir, buildDir)
+}
+
+// copyMigrations copies the SQL migrations in the module's "migrations"
+// directory into the build directory so they are shipped with the deployment.
+//
+// The migrations directory is always created, even if empty, as it is one of
+// the deployed paths.
+func copyMigrations(moduleDir, buildDir string) error {
+	src := filepath.Join(moduleDir, migrationsDirName)
+	dst := filepath.Join(buildDir, migrationsDirName)
+	if err := os.RemoveAll(dst); err != nil {
+		return fmt.Errorf("failed to clean migrations: %w", err)
+	}
+	if err := os.MkdirAll(dst, 0700); err != nil {
+		return fmt.Errorf("failed to create migrations directory: %w", err)
+	}
+	if _, err := os.Stat(src); errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+	return filepath.WalkDir(src, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() || filepath.Ext(path) != ".sql" {
+			return nil
+		}
+		rel, err := filepath.Rel(src, path)
+		if err != nil {
+			return err
+		}
+		content, err := os.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		if err := os.MkdirAll(filepath.Join(dst, filepath.Dir(rel)), 0700); err != nil {
+			return err
+		}
+		return os.WriteFile(filepath.Join(dst, rel), content, 0600)
+	})
 }
 
 func online() bool {
@@ -180,10 +247,16 @@ var scaffoldFuncs = scaffolder.FuncMap{
 					}
 				}
 
+			case *schema.EnumRef:
+				if n.Module == "" || n.Module == m.Name {
+					break
+				}
+				imports[path.Join("ftl", n.Module)] = "ftl" + n.Module
+
 			case *schema.Time:
 				imports["time"] = "stdtime"
 
-			case *schema.Optional, *schema.Unit:
+			case *schema.Optional, *schema.Unit, *schema.Topic:
 				imports["github.com/TBD54566975/ftl/go-runtime/ftl"] = ""
 
 			default:
@@ -217,6 +290,12 @@ func genType(module *schema.Module, t schema.Type) string {
 		}
 		return desc
 
+	case *schema.EnumRef:
+		if (module != nil && t.Module == module.Name) || t.Module == "" {
+			return t.Name
+		}
+		return "ftl" + t.Module + "." + t.Name
+
 	case *schema.VerbRef:
 		if module != nil && t.Module == module.Name {
 			return t.Name
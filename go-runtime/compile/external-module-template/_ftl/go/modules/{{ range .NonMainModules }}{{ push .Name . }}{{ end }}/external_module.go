@@ -32,5 +32,21 @@ type {{.Name|title}}
 func {{.Name|title}}(context.Context, {{type $ .Request}}) ({{type $ .Response}}, error) {
   panic("Verb stubs should not be called directly, instead use github.com/TBD54566975/ftl/runtime-go/ftl.Call()")
 }
+{{- else if is "Enum" .}}
+{{.Comments|comment }}
+{{if .Comments}}//
+{{end -}}
+//ftl:enum
+type {{.Name|title}} {{type $ .Type}}
+
+const (
+  {{- $enum := .}}
+  {{- range .Variants}}
+  {{.Name|title}} {{$enum.Name|title}} = {{.Value}}
+  {{- end}}
+)
+{{- else if is "Topic" .}}
+{{.Comments|comment }}
+var {{.Name|title}} = ftl.Topic[{{type $ .Event}}]("{{.Name}}")
 {{- end}}
 {{- end}}
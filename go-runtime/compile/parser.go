@@ -40,6 +40,52 @@ type directiveIngress struct {
 func (*directiveIngress) directive()       {}
 func (d *directiveIngress) String() string { return fmt.Sprintf("ftl:ingress %s", d.Type) }
 
+type directiveCron struct {
+	schema.MetadataCron
+}
+
+func (*directiveCron) directive()       {}
+func (d *directiveCron) String() string { return fmt.Sprintf("ftl:cron %q", d.Cron) }
+
+type directiveSubscribe struct {
+	schema.MetadataSubscriber
+}
+
+func (*directiveSubscribe) directive()       {}
+func (d *directiveSubscribe) String() string { return fmt.Sprintf("ftl:subscribe %s", d.Name) }
+
+type directiveRetry struct {
+	schema.MetadataRetry
+}
+
+func (*directiveRetry) directive()       {}
+func (d *directiveRetry) String() string { return fmt.Sprintf("ftl:retry %d", d.Count) }
+
+type directiveTimeout struct {
+	schema.MetadataTimeout
+}
+
+func (*directiveTimeout) directive()       {}
+func (d *directiveTimeout) String() string { return fmt.Sprintf("ftl:timeout %s", d.Timeout) }
+
+type directiveRateLimit struct {
+	schema.MetadataRateLimit
+}
+
+func (*directiveRateLimit) directive() {}
+func (d *directiveRateLimit) String() string {
+	return fmt.Sprintf("ftl:ratelimit %d/%s", d.Rate, d.Interval)
+}
+
+type directiveEnum struct {
+	Pos lexer.Position
+
+	Enum bool `parser:"@'enum'"`
+}
+
+func (*directiveEnum) directive()       {}
+func (d *directiveEnum) String() string { return "ftl:enum" }
+
 type directiveModule struct {
 	Pos lexer.Position
 
@@ -54,7 +100,7 @@ var directiveParser = participle.MustBuild[directiveWrapper](
 	participle.Elide("Whitespace"),
 	participle.Unquote(),
 	participle.UseLookahead(2),
-	participle.Union[directive](&directiveVerb{}, &directiveIngress{}, &directiveModule{}),
+	participle.Union[directive](&directiveVerb{}, &directiveIngress{}, &directiveCron{}, &directiveSubscribe{}, &directiveRetry{}, &directiveTimeout{}, &directiveRateLimit{}, &directiveEnum{}, &directiveModule{}),
 	participle.Union[schema.IngressPathComponent](&schema.IngressPathLiteral{}, &schema.IngressPathParameter{}),
 )
 
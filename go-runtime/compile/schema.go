@@ -4,10 +4,13 @@ import (
 	"errors"
 	"fmt"
 	"go/ast"
+	"go/constant"
+	"go/parser"
 	"go/token"
 	"go/types"
 	"path"
 	"reflect"
+	"strconv"
 	"strings"
 	"sync"
 
@@ -27,7 +30,12 @@ var (
 	errorIFaceType = once(func() *types.Interface {
 		return mustLoadRef("builtin", "error").Type().Underlying().(*types.Interface) //nolint:forcetypeassert
 	})
-	ftlCallFuncPath = "github.com/TBD54566975/ftl/go-runtime/ftl.Call"
+	ftlCallFuncPath         = "github.com/TBD54566975/ftl/go-runtime/ftl.Call"
+	ftlCallSinkFuncPath     = "github.com/TBD54566975/ftl/go-runtime/ftl.CallSink"
+	ftlStreamTypePath       = "github.com/TBD54566975/ftl/go-runtime/ftl.Stream"
+	ftlTopicFuncPath        = "github.com/TBD54566975/ftl/go-runtime/ftl.Topic"
+	ftlSubscriptionFuncPath = "github.com/TBD54566975/ftl/go-runtime/ftl.Subscription"
+	ftlPostgresDBFuncPath   = "github.com/TBD54566975/ftl/go-runtime/ftl.PostgresDatabase"
 
 	aliasFieldTag = "json"
 )
@@ -51,13 +59,28 @@ func ExtractModuleSchema(dir string) (NativeNames, *schema.Module, error) {
 	nativeNames := NativeNames{}
 	module := &schema.Module{}
 	merr := []error{}
+	// Enums are extracted up front so that they can be referenced from any
+	// package in the module.
+	enums := map[types.Object]*schema.Enum{}
+	for _, pkg := range pkgs {
+		pctx := &parseContext{pkg: pkg, pkgs: pkgs, module: module, nativeNames: nativeNames, enums: enums}
+		if err := extractEnums(pctx); err != nil {
+			return nil, nil, err
+		}
+	}
 	for _, pkg := range pkgs {
 		if len(pkg.Errors) > 0 {
 			for _, perr := range pkg.Errors {
 				merr = append(merr, fmt.Errorf("%s: %w", pkg.PkgPath, perr))
 			}
 		}
-		pctx := &parseContext{pkg: pkg, pkgs: pkgs, module: module, nativeNames: NativeNames{}}
+		pctx := &parseContext{pkg: pkg, pkgs: pkgs, module: module, nativeNames: NativeNames{}, enums: enums}
+		if err := extractPubSub(pctx); err != nil {
+			return nil, nil, err
+		}
+		if err := extractDatabases(pctx); err != nil {
+			return nil, nil, err
+		}
 		for _, file := range pkg.Syntax {
 			var verb *schema.Verb
 			err := goast.Visit(file, func(node ast.Node, next func() error) (err error) {
@@ -119,7 +142,7 @@ func visitCallExpr(pctx *parseContext, verb *schema.Verb, node *ast.CallExpr) er
 	if fn == nil {
 		return nil
 	}
-	if fn.FullName() != ftlCallFuncPath {
+	if fn.FullName() != ftlCallFuncPath && fn.FullName() != ftlCallSinkFuncPath {
 		return nil
 	}
 	if len(node.Args) != 3 {
@@ -169,6 +192,263 @@ func visitFile(pctx *parseContext, node *ast.File) error {
 	return nil
 }
 
+// Extract enums declared with //ftl:enum on a named string or int type.
+//
+// The variants of an enum are the constants declared with the enum's type.
+func extractEnums(pctx *parseContext) error {
+	for _, file := range pctx.pkg.Syntax {
+		for _, decl := range file.Decls {
+			gen, ok := decl.(*ast.GenDecl)
+			if !ok || gen.Tok != token.TYPE {
+				continue
+			}
+			for _, spec := range gen.Specs {
+				tspec, ok := spec.(*ast.TypeSpec)
+				if !ok {
+					continue
+				}
+				doc := tspec.Doc
+				if doc == nil && len(gen.Specs) == 1 {
+					doc = gen.Doc
+				}
+				directives, err := parseDirectives(fset, doc)
+				if err != nil {
+					return err
+				}
+				isEnum := false
+				for _, dir := range directives {
+					if _, ok := dir.(*directiveEnum); ok {
+						isEnum = true
+					}
+				}
+				if !isEnum {
+					continue
+				}
+				if err := visitEnum(pctx, tspec, doc); err != nil {
+					return fmt.Errorf("%s: %w", fset.Position(tspec.Pos()).String(), err)
+				}
+			}
+		}
+	}
+	return nil
+}
+
+func visitEnum(pctx *parseContext, tspec *ast.TypeSpec, doc *ast.CommentGroup) error {
+	obj := pctx.pkg.TypesInfo.Defs[tspec.Name]
+	named, ok := obj.Type().(*types.Named)
+	if !ok {
+		return fmt.Errorf("enum %s must be a named type", tspec.Name.Name)
+	}
+	enum := &schema.Enum{
+		Pos:      goPosToSchemaPos(tspec.Pos()),
+		Comments: visitComments(doc),
+		Name:     strcase.ToUpperCamel(tspec.Name.Name),
+	}
+	basic, ok := named.Underlying().(*types.Basic)
+	switch {
+	case ok && basic.Kind() == types.String:
+		enum.Type = &schema.String{Pos: enum.Pos}
+	case ok && (basic.Kind() == types.Int || basic.Kind() == types.Int64):
+		enum.Type = &schema.Int{Pos: enum.Pos}
+	default:
+		return fmt.Errorf("enum %s must be a string or int but is %s", tspec.Name.Name, named.Underlying())
+	}
+	for _, file := range pctx.pkg.Syntax {
+		for _, decl := range file.Decls {
+			gen, ok := decl.(*ast.GenDecl)
+			if !ok || gen.Tok != token.CONST {
+				continue
+			}
+			for _, spec := range gen.Specs {
+				vspec, ok := spec.(*ast.ValueSpec)
+				if !ok {
+					continue
+				}
+				for _, name := range vspec.Names {
+					c, ok := pctx.pkg.TypesInfo.Defs[name].(*types.Const)
+					if !ok || name.Name == "_" || !types.Identical(c.Type(), named) {
+						continue
+					}
+					variant := &schema.EnumVariant{Pos: goPosToSchemaPos(name.Pos()), Name: name.Name}
+					switch c.Val().Kind() {
+					case constant.String:
+						variant.Value = &schema.StringValue{Pos: variant.Pos, Value: constant.StringVal(c.Val())}
+					case constant.Int:
+						value, exact := constant.Int64Val(c.Val())
+						if !exact {
+							return fmt.Errorf("enum variant %s value %s is out of range", name.Name, c.Val())
+						}
+						variant.Value = &schema.IntValue{Pos: variant.Pos, Value: int(value)}
+					default:
+						return fmt.Errorf("enum variant %s has unsupported value %s", name.Name, c.Val())
+					}
+					enum.Variants = append(enum.Variants, variant)
+				}
+			}
+		}
+	}
+	if len(enum.Variants) == 0 {
+		return fmt.Errorf("enum %s must have at least one variant declared as a constant of type %s", tspec.Name.Name, tspec.Name.Name)
+	}
+	pctx.enums[obj] = enum
+	pctx.nativeNames[enum] = tspec.Name.Name
+	pctx.module.Decls = append(pctx.module.Decls, enum)
+	return nil
+}
+
+// Extract topics and subscriptions declared with ftl.Topic() and
+// ftl.Subscription() in package level variables.
+//
+// Topics are extracted first so that subscriptions can refer to topics
+// declared in any file of the package.
+func extractPubSub(pctx *parseContext) error {
+	topics := map[types.Object]string{}
+	err := visitPackageVarCalls(pctx, ftlTopicFuncPath, func(name *ast.Ident, doc *ast.CommentGroup, call *ast.CallExpr) error {
+		topic, err := visitTopic(pctx, doc, call)
+		if err != nil {
+			return err
+		}
+		topics[pctx.pkg.TypesInfo.Defs[name]] = topic.Name
+		pctx.nativeNames[topic] = name.Name
+		pctx.module.Decls = append(pctx.module.Decls, topic)
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	return visitPackageVarCalls(pctx, ftlSubscriptionFuncPath, func(name *ast.Ident, doc *ast.CommentGroup, call *ast.CallExpr) error {
+		subscription, err := visitSubscription(pctx, doc, call, topics)
+		if err != nil {
+			return err
+		}
+		pctx.nativeNames[subscription] = name.Name
+		pctx.module.Decls = append(pctx.module.Decls, subscription)
+		return nil
+	})
+}
+
+// Extract Postgres databases declared with ftl.PostgresDatabase() in package
+// level variables.
+func extractDatabases(pctx *parseContext) error {
+	return visitPackageVarCalls(pctx, ftlPostgresDBFuncPath, func(name *ast.Ident, doc *ast.CommentGroup, call *ast.CallExpr) error {
+		if len(call.Args) != 1 {
+			return errors.New("database must have exactly one argument")
+		}
+		dbName, err := stringLiteral(call.Args[0])
+		if err != nil {
+			return fmt.Errorf("database name %w", err)
+		}
+		database := &schema.Database{
+			Pos:      goPosToSchemaPos(call.Pos()),
+			Comments: visitComments(doc),
+			Name:     dbName,
+		}
+		pctx.nativeNames[database] = name.Name
+		pctx.module.Decls = append(pctx.module.Decls, database)
+		return nil
+	})
+}
+
+// Call fn for each package level variable initialised by a call to the
+// function at funcPath.
+func visitPackageVarCalls(pctx *parseContext, funcPath string, fn func(name *ast.Ident, doc *ast.CommentGroup, call *ast.CallExpr) error) error {
+	for _, file := range pctx.pkg.Syntax {
+		for _, decl := range file.Decls {
+			gen, ok := decl.(*ast.GenDecl)
+			if !ok || gen.Tok != token.VAR {
+				continue
+			}
+			for _, spec := range gen.Specs {
+				vspec, ok := spec.(*ast.ValueSpec)
+				if !ok || len(vspec.Names) != len(vspec.Values) {
+					continue
+				}
+				doc := vspec.Doc
+				if doc == nil && len(gen.Specs) == 1 {
+					doc = gen.Doc
+				}
+				for i, value := range vspec.Values {
+					call, ok := value.(*ast.CallExpr)
+					if !ok {
+						continue
+					}
+					_, callee := deref[*types.Func](pctx.pkg, call.Fun)
+					if callee == nil || callee.FullName() != funcPath {
+						continue
+					}
+					if err := fn(vspec.Names[i], doc, call); err != nil {
+						return fmt.Errorf("%s: %w", fset.Position(call.Pos()).String(), err)
+					}
+				}
+			}
+		}
+	}
+	return nil
+}
+
+func visitTopic(pctx *parseContext, doc *ast.CommentGroup, call *ast.CallExpr) (*schema.Topic, error) {
+	if len(call.Args) != 1 {
+		return nil, errors.New("topic must have exactly one argument")
+	}
+	name, err := stringLiteral(call.Args[0])
+	if err != nil {
+		return nil, fmt.Errorf("topic name %w", err)
+	}
+	handle, ok := pctx.pkg.TypesInfo.TypeOf(call).(*types.Named)
+	if !ok || handle.TypeArgs().Len() != 1 {
+		return nil, fmt.Errorf("could not determine the event type of topic %s", name)
+	}
+	event, err := visitType(pctx, call, handle.TypeArgs().At(0))
+	if err != nil {
+		return nil, err
+	}
+	return &schema.Topic{
+		Pos:      goPosToSchemaPos(call.Pos()),
+		Comments: visitComments(doc),
+		Name:     name,
+		Event:    event,
+	}, nil
+}
+
+func visitSubscription(pctx *parseContext, doc *ast.CommentGroup, call *ast.CallExpr, topics map[types.Object]string) (*schema.Subscription, error) {
+	if len(call.Args) != 2 {
+		return nil, errors.New("subscription must have exactly two arguments")
+	}
+	name, err := stringLiteral(call.Args[1])
+	if err != nil {
+		return nil, fmt.Errorf("subscription name %w", err)
+	}
+	_, topicVar := deref[*types.Var](pctx.pkg, call.Args[0])
+	if topicVar == nil {
+		return nil, fmt.Errorf("subscription %s topic must be a package level variable declared with ftl.Topic()", name)
+	}
+	topic := &schema.TopicRef{Pos: goPosToSchemaPos(call.Args[0].Pos())}
+	if topicName, ok := topics[topicVar]; ok {
+		topic.Name = topicName
+	} else if topicVar.Pkg() != nil && topicVar.Pkg() != pctx.pkg.Types {
+		// Topics in external modules are generated with the title cased
+		// topic name.
+		topic.Module = topicVar.Pkg().Name()
+		topic.Name = strcase.ToLowerCamel(topicVar.Name())
+	} else {
+		return nil, fmt.Errorf("subscription %s topic must be a package level variable declared with ftl.Topic()", name)
+	}
+	return &schema.Subscription{
+		Pos:      goPosToSchemaPos(call.Pos()),
+		Comments: visitComments(doc),
+		Name:     name,
+		Topic:    topic,
+	}, nil
+}
+
+func stringLiteral(node ast.Expr) (string, error) {
+	lit, ok := node.(*ast.BasicLit)
+	if !ok || lit.Kind != token.STRING {
+		return "", errors.New("must be a string literal")
+	}
+	return strconv.Unquote(lit.Value)
+}
+
 func isType[T types.Type](t types.Type) bool {
 	if _, ok := t.(*types.Named); ok {
 		t = t.Underlying()
@@ -177,45 +457,58 @@ func isType[T types.Type](t types.Type) bool {
 	return ok
 }
 
-func checkSignature(sig *types.Signature) (req, resp *types.Var, err error) {
+// checkSignature checks the signature of a Verb, returning the types of its
+// request and response if it has them.
+//
+// The response of a streaming Verb is the type of the messages it sends to
+// its ftl.Stream.
+func checkSignature(sig *types.Signature) (req, resp types.Type, streaming bool, err error) {
 	params := sig.Params()
 	results := sig.Results()
 
-	if params.Len() > 2 {
-		return nil, nil, fmt.Errorf("must have at most two parameters (context.Context, struct)")
+	if params.Len() > 3 {
+		return nil, nil, false, fmt.Errorf("must have at most three parameters (context.Context, struct, ftl.Stream)")
 	}
 	if params.Len() == 0 {
-		return nil, nil, fmt.Errorf("first parameter must be context.Context")
+		return nil, nil, false, fmt.Errorf("first parameter must be context.Context")
 	}
 	if !types.AssertableTo(contextIfaceType(), params.At(0).Type()) {
-		return nil, nil, fmt.Errorf("first parameter must be of type context.Context but is %s", params.At(0).Type())
+		return nil, nil, false, fmt.Errorf("first parameter must be of type context.Context but is %s", params.At(0).Type())
 	}
-	if params.Len() == 2 {
+	if params.Len() >= 2 {
 		if !isType[*types.Struct](params.At(1).Type()) {
-			return nil, nil, fmt.Errorf("second parameter must be a struct but is %s", params.At(1).Type())
+			return nil, nil, false, fmt.Errorf("second parameter must be a struct but is %s", params.At(1).Type())
+		}
+		req = params.At(1).Type()
+	}
+	if params.Len() == 3 {
+		named, ok := params.At(2).Type().(*types.Named)
+		if !ok || named.Obj().Pkg() == nil || named.Obj().Pkg().Path()+"."+named.Obj().Name() != ftlStreamTypePath {
+			return nil, nil, false, fmt.Errorf("third parameter must be an ftl.Stream but is %s", params.At(2).Type())
+		}
+		if results.Len() != 1 {
+			return nil, nil, false, fmt.Errorf("streaming Verbs must only return an error")
 		}
-		req = params.At(1)
+		streaming = true
+		resp = named.TypeArgs().At(0)
 	}
 
 	if results.Len() > 2 {
-		return nil, nil, fmt.Errorf("must have at most two results (struct, error)")
+		return nil, nil, false, fmt.Errorf("must have at most two results (struct, error)")
 	}
 	if results.Len() == 0 {
-		return nil, nil, fmt.Errorf("must at least return an error")
+		return nil, nil, false, fmt.Errorf("must at least return an error")
 	}
 	if !types.AssertableTo(errorIFaceType(), results.At(results.Len()-1).Type()) {
-		return nil, nil, fmt.Errorf("must return an error but is %s", results.At(0).Type())
+		return nil, nil, false, fmt.Errorf("must return an error but is %s", results.At(0).Type())
 	}
 	if results.Len() == 2 {
 		if !isType[*types.Struct](results.At(0).Type()) {
-			return nil, nil, fmt.Errorf("first result must be a struct but is %s", results.At(0).Type())
+			return nil, nil, false, fmt.Errorf("first result must be a struct but is %s", results.At(0).Type())
 		}
-		resp = results.At(0)
+		resp = results.At(0).Type()
 	}
-	if params.Len() == 1 && results.Len() == 1 {
-		return nil, nil, fmt.Errorf("must either accept an input or return a result, but does neither")
-	}
-	return req, resp, nil
+	return req, resp, streaming, nil
 }
 
 func goPosToSchemaPos(pos token.Pos) schema.Position {
@@ -233,6 +526,8 @@ func visitFuncDecl(pctx *parseContext, node *ast.FuncDecl) (verb *schema.Verb, e
 	}
 	var metadata []schema.Metadata
 	isVerb := false
+	isCron := false
+	isStreaming := false
 	for _, dir := range directives {
 		switch dir := dir.(type) {
 		case *directiveModule:
@@ -245,13 +540,61 @@ func visitFuncDecl(pctx *parseContext, node *ast.FuncDecl) (verb *schema.Verb, e
 			if typ == "" {
 				typ = "http"
 			}
+			isStreaming = dir.IsStreaming()
 			metadata = append(metadata, &schema.MetadataIngress{
-				Pos:    dir.Pos,
-				Type:   typ,
-				Method: dir.Method,
-				Path:   dir.Path,
+				Pos:        dir.Pos,
+				Type:       typ,
+				Method:     dir.Method,
+				Path:       dir.Path,
+				Auth:       dir.Auth,
+				Authorizer: dir.Authorizer,
+				CORS:       dir.CORS,
+				Cache:      dir.Cache,
+				RateLimit:  dir.RateLimit,
+			})
+
+		case *directiveCron:
+			isVerb = true
+			isCron = true
+			metadata = append(metadata, &schema.MetadataCron{
+				Pos:  dir.Pos,
+				Cron: dir.Cron,
+			})
+
+		case *directiveSubscribe:
+			isVerb = true
+			metadata = append(metadata, &schema.MetadataSubscriber{
+				Pos:  dir.Pos,
+				Name: dir.Name,
+			})
+
+		case *directiveRetry:
+			metadata = append(metadata, &schema.MetadataRetry{
+				Pos:        dir.Pos,
+				Count:      dir.Count,
+				MinBackoff: dir.MinBackoff,
+				MaxBackoff: dir.MaxBackoff,
 			})
 
+		case *directiveTimeout:
+			metadata = append(metadata, &schema.MetadataTimeout{
+				Pos:     dir.Pos,
+				Timeout: dir.Timeout,
+			})
+
+		case *directiveRateLimit:
+			metadata = append(metadata, &schema.MetadataRateLimit{
+				Pos:      dir.Pos,
+				Rate:     dir.Rate,
+				Interval: dir.Interval,
+				Burst:    dir.Burst,
+				Key:      dir.Key,
+				Header:   dir.Header,
+			})
+
+		case *directiveEnum:
+			return nil, fmt.Errorf("%s: can only be applied to a type declaration", dir)
+
 		default:
 			panic(fmt.Sprintf("unsupported directive %T", dir))
 		}
@@ -264,15 +607,22 @@ func visitFuncDecl(pctx *parseContext, node *ast.FuncDecl) (verb *schema.Verb, e
 	if sig.Recv() != nil {
 		return nil, fmt.Errorf("ftl:verb cannot be a method")
 	}
-	params := sig.Params()
-	results := sig.Results()
-	reqt, respt, err := checkSignature(sig)
+	reqt, respt, streaming, err := checkSignature(sig)
 	if err != nil {
 		return nil, err
 	}
+	if reqt == nil && respt == nil && !isCron {
+		return nil, fmt.Errorf("must either accept an input or return a result, but does neither")
+	}
+	if streaming != isStreaming {
+		if streaming {
+			return nil, fmt.Errorf("ftl.Stream parameters are only supported by sse and websocket ingress Verbs")
+		}
+		return nil, fmt.Errorf("sse and websocket ingress Verbs must send their messages to an ftl.Stream")
+	}
 	var req schema.Type
 	if reqt != nil {
-		req, err = visitType(pctx, node, params.At(1).Type())
+		req, err = visitType(pctx, node, reqt)
 		if err != nil {
 			return nil, err
 		}
@@ -281,7 +631,7 @@ func visitFuncDecl(pctx *parseContext, node *ast.FuncDecl) (verb *schema.Verb, e
 	}
 	var resp schema.Type
 	if respt != nil {
-		resp, err = visitType(pctx, node, results.At(0).Type())
+		resp, err = visitType(pctx, node, respt)
 		if err != nil {
 			return nil, err
 		}
@@ -308,7 +658,8 @@ func parsePathComponents(path string, pos schema.Position) []schema.IngressPathC
 			continue
 		}
 		if strings.HasPrefix(part, "{") && strings.HasSuffix(part, "}") {
-			out = append(out, &schema.IngressPathParameter{Pos: pos, Name: part[1 : len(part)-1]})
+			name, catchAll := strings.CutSuffix(part[1:len(part)-1], "...")
+			out = append(out, &schema.IngressPathParameter{Pos: pos, Name: name, CatchAll: catchAll})
 		} else {
 			out = append(out, &schema.IngressPathLiteral{Pos: pos, Text: part})
 		}
@@ -442,6 +793,11 @@ func visitType(pctx *parseContext, node ast.Node, tnode types.Type) (schema.Type
 	}
 	switch underlying := tnode.Underlying().(type) {
 	case *types.Basic:
+		if named, ok := tnode.(*types.Named); ok {
+			if enumRef, ok := visitEnumRef(pctx, node, named); ok {
+				return enumRef, nil
+			}
+		}
 		switch underlying.Kind() {
 		case types.String:
 			return &schema.String{Pos: goPosToSchemaPos(node.Pos())}, nil
@@ -501,6 +857,72 @@ func visitType(pctx *parseContext, node ast.Node, tnode types.Type) (schema.Type
 	}
 }
 
+// visitEnumRef returns a reference to the enum if the named basic type is an
+// enum in this module, or is declared with an //ftl:enum directive in an
+// external module.
+func visitEnumRef(pctx *parseContext, node ast.Node, named *types.Named) (*schema.EnumRef, bool) {
+	if enum, ok := pctx.enums[named.Obj()]; ok {
+		return &schema.EnumRef{Pos: goPosToSchemaPos(node.Pos()), Name: enum.Name}, true
+	}
+	if named.Obj().Pkg() == nil {
+		return nil, false
+	}
+	nodePath := named.Obj().Pkg().Path()
+	base := path.Dir(pctx.pkg.PkgPath)
+	if strings.HasPrefix(nodePath, pctx.pkg.PkgPath) || !strings.HasPrefix(nodePath, base+"/") {
+		return nil, false
+	}
+	if !hasEnumDirective(named.Obj()) {
+		return nil, false
+	}
+	return &schema.EnumRef{
+		Pos:    goPosToSchemaPos(node.Pos()),
+		Module: path.Base(strings.TrimPrefix(nodePath, base+"/")),
+		Name:   named.Obj().Name(),
+	}, true
+}
+
+// hasEnumDirective returns true if the type declaration of obj, which may be
+// in another package, has an //ftl:enum directive.
+func hasEnumDirective(obj types.Object) bool {
+	position := fset.Position(obj.Pos())
+	if !position.IsValid() {
+		return false
+	}
+	declFset := token.NewFileSet()
+	file, err := parser.ParseFile(declFset, position.Filename, nil, parser.ParseComments)
+	if err != nil {
+		return false
+	}
+	for _, decl := range file.Decls {
+		gen, ok := decl.(*ast.GenDecl)
+		if !ok || gen.Tok != token.TYPE {
+			continue
+		}
+		for _, spec := range gen.Specs {
+			tspec, ok := spec.(*ast.TypeSpec)
+			if !ok || tspec.Name.Name != obj.Name() {
+				continue
+			}
+			doc := tspec.Doc
+			if doc == nil && len(gen.Specs) == 1 {
+				doc = gen.Doc
+			}
+			directives, err := parseDirectives(declFset, doc)
+			if err != nil {
+				return false
+			}
+			for _, dir := range directives {
+				if _, ok := dir.(*directiveEnum); ok {
+					return true
+				}
+			}
+			return false
+		}
+	}
+	return false
+}
+
 func visitMap(pctx *parseContext, node ast.Node, tnode *types.Map) (*schema.Map, error) {
 	key, err := visitType(pctx, node, tnode.Key())
 	if err != nil {
@@ -564,6 +986,9 @@ func deref[T types.Object](pkg *packages.Package, node ast.Expr) (string, T) {
 		obj, _ = pkg.TypesInfo.Uses[node].(T)
 		return "", obj
 
+	case *ast.IndexExpr:
+		return deref[T](pkg, node.X)
+
 	case *ast.SelectorExpr:
 		x, ok := node.X.(*ast.Ident)
 		if !ok {
@@ -582,6 +1007,7 @@ type parseContext struct {
 	pkgs        []*packages.Package
 	module      *schema.Module
 	nativeNames NativeNames
+	enums       map[types.Object]*schema.Enum
 }
 
 // pathEnclosingInterval returns the PackageInfo and ast.Node that
@@ -18,6 +18,24 @@ func TestExtractModuleSchema(t *testing.T) {
 	assert.NoError(t, err)
 	actual = schema.Normalise(actual)
 	expected := `module one {
+  enum Size(Int) {
+    Small(0)
+    Large(1)
+  }
+
+  data Event {
+    message String
+  }
+
+  topic events one.Event
+
+  subscription eventConsumers one.events
+
+  subscription userUpdates two.users
+
+  // DB stores the module's state.
+  database testdb
+
   data Nested {
   }
 
@@ -33,15 +51,31 @@ func TestExtractModuleSchema(t *testing.T) {
     time Time
     user two.User alias json "u"
     bytes Bytes
+    size one.Size
+    color two.Color
+    userId String
   }
 
   data Resp {
   }
 
   verb verb(one.Req) one.Resp
+      retry 3 backoff 1s..30s
+      timeout 5s
+      ratelimit 10/1s by ip
+
+
+  verb tick(Unit) Unit
+      cron "*/5 * * * *"
+
+  verb consume(one.Event) Unit
+      subscribe eventConsumers
+
+  verb streamEvents(one.Nested) one.Event
+      ingress sse GET /events
 }
 `
-	assert.Equal(t, expected, actual.String())
+	assert.Equal(t, normaliseString(expected), normaliseString(actual.String()))
 }
 
 func TestExtractModuleSchemaTwo(t *testing.T) {
@@ -49,6 +83,19 @@ func TestExtractModuleSchemaTwo(t *testing.T) {
 	assert.NoError(t, err)
 	actual = schema.Normalise(actual)
 	expected := `module two {
+  // A colour.
+  enum Color(String) {
+    Red("Red")
+    Blue("Blue")
+  }
+
+  data User {
+    name String
+  }
+
+  // Published when a user is updated.
+  topic users two.User
+
   data Payload<T> {
     body T
   }
@@ -71,6 +118,21 @@ func TestParseDirectives(t *testing.T) {
 	}{
 		{name: "Module", input: "ftl:module foo", expected: &directiveModule{Name: "foo"}},
 		{name: "Verb", input: "ftl:verb", expected: &directiveVerb{Verb: true}},
+		{name: "Cron", input: `ftl:cron "*/5 * * * *"`, expected: &directiveCron{
+			MetadataCron: schema.MetadataCron{Cron: "*/5 * * * *"},
+		}},
+		{name: "Subscribe", input: `ftl:subscribe articles`, expected: &directiveSubscribe{
+			MetadataSubscriber: schema.MetadataSubscriber{Name: "articles"},
+		}},
+		{name: "Retry", input: `ftl:retry 3 backoff 1s..30s`, expected: &directiveRetry{
+			MetadataRetry: schema.MetadataRetry{Count: 3, MinBackoff: "1s", MaxBackoff: "30s"},
+		}},
+		{name: "Timeout", input: `ftl:timeout 5s`, expected: &directiveTimeout{
+			MetadataTimeout: schema.MetadataTimeout{Timeout: "5s"},
+		}},
+		{name: "RateLimit", input: `ftl:ratelimit 100/1m burst 20 by header "X-Api-Key"`, expected: &directiveRateLimit{
+			MetadataRateLimit: schema.MetadataRateLimit{Rate: 100, Interval: "1m", Burst: 20, Key: "header", Header: "X-Api-Key"},
+		}},
 		{name: "Ingress", input: `ftl:ingress GET /foo`, expected: &directiveIngress{
 			MetadataIngress: schema.MetadataIngress{
 				Method: "GET",
@@ -81,6 +143,42 @@ func TestParseDirectives(t *testing.T) {
 				},
 			},
 		}},
+		{name: "IngressAuth", input: `ftl:ingress GET /me auth=bearer authorizer=auth.check`, expected: &directiveIngress{
+			MetadataIngress: schema.MetadataIngress{
+				Method:     "GET",
+				Path:       []schema.IngressPathComponent{&schema.IngressPathLiteral{Text: "me"}},
+				Auth:       "bearer",
+				Authorizer: &schema.VerbRef{Module: "auth", Name: "check"},
+			},
+		}},
+		{name: "IngressCORS", input: `ftl:ingress GET /me cors(origins=["https://example.com"], credentials)`, expected: &directiveIngress{
+			MetadataIngress: schema.MetadataIngress{
+				Method: "GET",
+				Path:   []schema.IngressPathComponent{&schema.IngressPathLiteral{Text: "me"}},
+				CORS:   schema.IngressCORS{Origins: []string{"https://example.com"}, Credentials: true},
+			},
+		}},
+		{name: "IngressSSE", input: `ftl:ingress sse GET /prices`, expected: &directiveIngress{
+			MetadataIngress: schema.MetadataIngress{
+				Type:   "sse",
+				Method: "GET",
+				Path:   []schema.IngressPathComponent{&schema.IngressPathLiteral{Text: "prices"}},
+			},
+		}},
+		{name: "IngressCache", input: `ftl:ingress GET /me cache(ttl=5m, vary=["Accept-Language"], stale=1m)`, expected: &directiveIngress{
+			MetadataIngress: schema.MetadataIngress{
+				Method: "GET",
+				Path:   []schema.IngressPathComponent{&schema.IngressPathLiteral{Text: "me"}},
+				Cache:  schema.IngressCache{TTL: "5m", Vary: []string{"Accept-Language"}, StaleWhileRevalidate: "1m"},
+			},
+		}},
+		{name: "IngressRateLimit", input: `ftl:ingress POST /login ratelimit(5/1m, by=ip)`, expected: &directiveIngress{
+			MetadataIngress: schema.MetadataIngress{
+				Method:    "POST",
+				Path:      []schema.IngressPathComponent{&schema.IngressPathLiteral{Text: "login"}},
+				RateLimit: schema.IngressRateLimit{Rate: 5, Interval: "1m", Key: "ip"},
+			},
+		}},
 		{name: "Ingress", input: `ftl:ingress GET /test_path/{something}/987-Your_File.txt%7E%21Misc%2A%28path%29info%40abc%3Fxyz`, expected: &directiveIngress{
 			MetadataIngress: schema.MetadataIngress{
 				Method: "GET",
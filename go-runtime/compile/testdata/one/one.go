@@ -12,6 +12,28 @@ import (
 type Nested struct {
 }
 
+//ftl:enum
+type Size int
+
+const (
+	Small Size = iota
+	Large
+)
+
+type Event struct {
+	Message string
+}
+
+var Events = ftl.Topic[Event]("events")
+
+// DB stores the module's state.
+var DB = ftl.PostgresDatabase("testdb")
+
+var (
+	_ = ftl.Subscription(Events, "eventConsumers")
+	_ = ftl.Subscription(two.Users, "userUpdates")
+)
+
 type Req struct {
 	Int      int
 	Int64    int64
@@ -24,10 +46,32 @@ type Req struct {
 	Time     time.Time
 	User     two.User `json:"u"`
 	Bytes    []byte
+	Size     Size
+	Color    two.Color
+	UserID   two.UserID
 }
 type Resp struct{}
 
 //ftl:verb
+//ftl:retry 3 backoff 1s..30s
+//ftl:timeout 5s
+//ftl:ratelimit 10/1s by ip
 func Verb(ctx context.Context, req Req) (Resp, error) {
 	return Resp{}, nil
 }
+
+//ftl:cron "*/5 * * * *"
+func Tick(ctx context.Context) error {
+	return nil
+}
+
+//ftl:subscribe eventConsumers
+func Consume(ctx context.Context, event Event) error {
+	return nil
+}
+
+//ftl:verb
+//ftl:ingress sse GET /events
+func StreamEvents(ctx context.Context, req Nested, stream ftl.Stream[Event]) error {
+	return stream.Send(Event{Message: "hello"})
+}
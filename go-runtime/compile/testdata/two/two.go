@@ -11,6 +11,21 @@ type User struct {
 	Name string
 }
 
+type UserID string
+
+// A colour.
+//
+//ftl:enum
+type Color string
+
+const (
+	Red  Color = "Red"
+	Blue Color = "Blue"
+)
+
+// Published when a user is updated.
+var Users = ftl.Topic[User]("users")
+
 type Payload[T any] struct {
 	Body T
 }
@@ -2,6 +2,8 @@ package ftl
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"reflect"
 	"runtime"
@@ -30,7 +32,7 @@ func Call[Req, Resp any](ctx context.Context, verb Verb[Req, Resp], req Req) (re
 	}
 	switch cresp := cresp.Msg.Response.(type) {
 	case *ftlv1.CallResponse_Error_:
-		return resp, fmt.Errorf("%s: %s", callee, cresp.Error.Message)
+		return resp, fmt.Errorf("%s: %w", callee, errorFromProto(cresp.Error))
 
 	case *ftlv1.CallResponse_Body:
 		err = encoding.Unmarshal(cresp.Body, &resp)
@@ -44,9 +46,42 @@ func Call[Req, Resp any](ctx context.Context, verb Verb[Req, Resp], req Req) (re
 	}
 }
 
-// Call a Sink through the FTL controller.
-// func CallSink[Req any](ctx context.Context, sink Sink[Req]) error {
-// }
+// CallSink calls a Sink through the FTL controller.
+func CallSink[Req any](ctx context.Context, sink Sink[Req], req Req) error {
+	callee := VerbRef(SinkToRef(sink))
+	client := rpc.ClientFromContext[ftlv1connect.VerbServiceClient](ctx)
+	reqData, err := encoding.Marshal(req)
+	if err != nil {
+		return fmt.Errorf("%s: failed to marshal request: %w", callee, err)
+	}
+	cresp, err := client.Call(ctx, connect.NewRequest(&ftlv1.CallRequest{Verb: callee.ToProto(), Body: reqData}))
+	if err != nil {
+		return fmt.Errorf("%s: failed to call Verb: %w", callee, err)
+	}
+	switch cresp := cresp.Msg.Response.(type) {
+	case *ftlv1.CallResponse_Error_:
+		return fmt.Errorf("%s: %w", callee, errorFromProto(cresp.Error))
+
+	case *ftlv1.CallResponse_Body:
+		return nil
+
+	default:
+		panic(fmt.Sprintf("%s: invalid response type %T", callee, cresp))
+	}
+}
+
+// errorFromProto converts an error returned by a Verb into an error, which is
+// a *VerbError if the Verb returned a typed error.
+func errorFromProto(callErr *ftlv1.CallResponse_Error) error {
+	if callErr.Code == nil {
+		return errors.New(callErr.Message)
+	}
+	err := &VerbError{Code: ErrorCode(*callErr.Code), Message: callErr.Message}
+	if callErr.Details != nil {
+		err.Details = json.RawMessage(callErr.Details)
+	}
+	return err
+}
 
 // VerbToRef returns the FTL reference for a Verb.
 func VerbToRef[Req, Resp any](verb Verb[Req, Resp]) VerbRef {
@@ -59,6 +94,12 @@ func SinkToRef[Req any](sink Sink[Req]) SinkRef {
 	return SinkRef(goRefToFTLRef(ref))
 }
 
+// EmptyToRef returns the FTL reference for an Empty.
+func EmptyToRef(empty Empty) VerbRef {
+	ref := runtime.FuncForPC(reflect.ValueOf(empty).Pointer()).Name()
+	return goRefToFTLRef(ref)
+}
+
 func goRefToFTLRef(ref string) VerbRef {
 	parts := strings.Split(ref[strings.LastIndex(ref, "/")+1:], ".")
 	return VerbRef{parts[len(parts)-2], strcase.ToLowerCamel(parts[len(parts)-1])}
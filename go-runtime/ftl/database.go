@@ -10,6 +10,14 @@ import (
 )
 
 // PostgresDatabase returns a Postgres database connection for the named database.
+//
+// Databases must be declared in package level variables so that they can be
+// provisioned by FTL, eg.
+//
+//	var DB = ftl.PostgresDatabase("mydb")
+//
+// SQL migrations in the module's "migrations/<name>" directory are applied to
+// the database when the module is deployed.
 func PostgresDatabase(name string) *sql.DB {
 	module := strings.ToUpper(callerModule())
 	key := fmt.Sprintf("FTL_POSTGRES_DSN_%s_%s", module, strings.ToUpper(name))
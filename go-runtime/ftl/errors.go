@@ -0,0 +1,95 @@
+package ftl
+
+import (
+	"errors"
+	"fmt"
+)
+
+// ErrorCode classifies a VerbError.
+//
+// Codes are the names of the equivalent Connect error codes, and are mapped to
+// HTTP statuses by ingress and to exit statuses by "ftl call".
+type ErrorCode string
+
+const (
+	CodeInvalidArgument    ErrorCode = "invalid_argument"
+	CodeNotFound           ErrorCode = "not_found"
+	CodeAlreadyExists      ErrorCode = "already_exists"
+	CodePermissionDenied   ErrorCode = "permission_denied"
+	CodeUnauthenticated    ErrorCode = "unauthenticated"
+	CodeFailedPrecondition ErrorCode = "failed_precondition"
+	CodeResourceExhausted  ErrorCode = "resource_exhausted"
+	CodeUnimplemented      ErrorCode = "unimplemented"
+	CodeUnavailable        ErrorCode = "unavailable"
+	CodeInternal           ErrorCode = "internal"
+)
+
+// VerbError is a typed error returned by a Verb.
+//
+// Errors are carried through to callers of the Verb, so callers can use
+// errors.As to retrieve the code and details of the error.
+type VerbError struct {
+	Code    ErrorCode
+	Message string
+	// Details is encoded as JSON. It is typically an FTL data structure
+	// describing the error.
+	//
+	// Errors returned by ftl.Call carry their details as a json.RawMessage.
+	Details any
+}
+
+func (e *VerbError) Error() string { return e.Message }
+
+// NewVerbError creates a typed error with details.
+func NewVerbError(code ErrorCode, details any, format string, args ...any) *VerbError {
+	return &VerbError{Code: code, Message: fmt.Sprintf(format, args...), Details: details}
+}
+
+// InvalidArgument returns an error indicating that the request was invalid.
+func InvalidArgument(format string, args ...any) error {
+	return NewVerbError(CodeInvalidArgument, nil, format, args...)
+}
+
+// NotFound returns an error indicating that a requested entity was not found.
+func NotFound(format string, args ...any) error {
+	return NewVerbError(CodeNotFound, nil, format, args...)
+}
+
+// AlreadyExists returns an error indicating that an entity already exists.
+func AlreadyExists(format string, args ...any) error {
+	return NewVerbError(CodeAlreadyExists, nil, format, args...)
+}
+
+// PermissionDenied returns an error indicating that the caller is not
+// permitted to perform the request.
+func PermissionDenied(format string, args ...any) error {
+	return NewVerbError(CodePermissionDenied, nil, format, args...)
+}
+
+// Unauthenticated returns an error indicating that the caller could not be
+// authenticated.
+func Unauthenticated(format string, args ...any) error {
+	return NewVerbError(CodeUnauthenticated, nil, format, args...)
+}
+
+// FailedPrecondition returns an error indicating that the system is not in a
+// state required for the request.
+func FailedPrecondition(format string, args ...any) error {
+	return NewVerbError(CodeFailedPrecondition, nil, format, args...)
+}
+
+// Unavailable returns an error indicating that the request can be retried
+// later.
+func Unavailable(format string, args ...any) error {
+	return NewVerbError(CodeUnavailable, nil, format, args...)
+}
+
+// ErrorCodeOf returns the code of a typed error in the error chain of err, or
+// CodeInternal if there is none.
+func ErrorCodeOf(err error) ErrorCode {
+	var verbErr *VerbError
+	if errors.As(err, &verbErr) && verbErr.Code != "" {
+		return verbErr.Code
+	}
+	return CodeInternal
+}
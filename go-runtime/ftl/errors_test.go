@@ -0,0 +1,29 @@
+package ftl
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/alecthomas/assert/v2"
+
+	ftlv1 "github.com/TBD54566975/ftl/backend/protos/xyz/block/ftl/v1"
+)
+
+func TestErrorCodeOf(t *testing.T) {
+	assert.Equal(t, CodeNotFound, ErrorCodeOf(fmt.Errorf("lookup: %w", NotFound("no user %q", "bob"))))
+	assert.Equal(t, CodeInternal, ErrorCodeOf(errors.New("boom")))
+}
+
+func TestErrorFromProto(t *testing.T) {
+	code := "invalid_argument"
+	err := errorFromProto(&ftlv1.CallResponse_Error{Message: "bad name", Code: &code, Details: []byte(`{"field":"name"}`)})
+	var verbErr *VerbError
+	assert.True(t, errors.As(err, &verbErr))
+	assert.Equal(t, &VerbError{Code: CodeInvalidArgument, Message: "bad name", Details: json.RawMessage(`{"field":"name"}`)}, verbErr)
+
+	err = errorFromProto(&ftlv1.CallResponse_Error{Message: "boom"})
+	assert.False(t, errors.As(err, &verbErr))
+	assert.EqualError(t, err, "boom")
+}
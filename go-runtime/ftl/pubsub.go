@@ -0,0 +1,67 @@
+package ftl
+
+import (
+	"context"
+	"fmt"
+
+	"connectrpc.com/connect"
+
+	ftlv1 "github.com/TBD54566975/ftl/backend/protos/xyz/block/ftl/v1"
+	"github.com/TBD54566975/ftl/backend/protos/xyz/block/ftl/v1/ftlv1connect"
+	"github.com/TBD54566975/ftl/go-runtime/encoding"
+	"github.com/TBD54566975/ftl/internal/rpc"
+)
+
+// Topic declares a topic of events of type E in the current module.
+//
+// Topics must be declared as package level variables so that they are
+// included in the module schema, eg.
+//
+//	var Articles = ftl.Topic[ArticleEvent]("articles")
+func Topic[E any](name string) TopicHandle[E] {
+	return TopicHandle[E]{Ref: TopicRef{Module: callerModule(), Name: name}}
+}
+
+// TopicHandle is a typed handle to a Topic.
+type TopicHandle[E any] struct {
+	Ref TopicRef
+}
+
+func (t TopicHandle[E]) String() string { return t.Ref.String() }
+
+// Subscription declares a durable subscription to a topic in the current
+// module.
+//
+// Each event published to the topic is delivered at least once to the
+// Verbs subscribed to the subscription with //ftl:subscribe, eg.
+//
+//	var _ = ftl.Subscription(news.Articles, "indexArticles")
+//
+//	//ftl:subscribe indexArticles
+//	func Index(ctx context.Context, event news.ArticleEvent) error
+func Subscription[E any](topic TopicHandle[E], name string) SubscriptionHandle[E] {
+	return SubscriptionHandle[E]{Topic: topic, Name: name}
+}
+
+// SubscriptionHandle is a typed handle to a Subscription.
+type SubscriptionHandle[E any] struct {
+	Topic TopicHandle[E]
+	Name  string
+}
+
+// Publish an event to a topic through the FTL Controller.
+//
+// The event is durably enqueued for every subscription to the topic before
+// Publish returns.
+func Publish[E any](ctx context.Context, topic TopicHandle[E], event E) error {
+	client := rpc.ClientFromContext[ftlv1connect.ControllerServiceClient](ctx)
+	body, err := encoding.Marshal(event)
+	if err != nil {
+		return fmt.Errorf("%s: failed to marshal event: %w", topic, err)
+	}
+	_, err = client.PublishEvent(ctx, connect.NewRequest(&ftlv1.PublishEventRequest{Topic: topic.Ref.ToProto(), Body: body}))
+	if err != nil {
+		return fmt.Errorf("%s: failed to publish event: %w", topic, err)
+	}
+	return nil
+}
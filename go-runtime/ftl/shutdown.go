@@ -0,0 +1,41 @@
+package ftl
+
+import (
+	"context"
+	"errors"
+	"sync"
+)
+
+var (
+	shutdownLock  sync.Mutex
+	shutdownHooks []func(ctx context.Context) error
+)
+
+// OnShutdown registers a function to be called when the module is shutting
+// down, such as when its deployment is replaced or scaled down.
+//
+// Hooks are called in reverse order of registration once the module has
+// stopped receiving calls. The process is killed if they have not returned by
+// the time the context passed to them is done.
+func OnShutdown(hook func(ctx context.Context) error) {
+	shutdownLock.Lock()
+	defer shutdownLock.Unlock()
+	shutdownHooks = append(shutdownHooks, hook)
+}
+
+// RunShutdownHooks calls the functions registered with [OnShutdown].
+//
+// This function is intended to be used by the FTL runtime.
+func RunShutdownHooks(ctx context.Context) error {
+	shutdownLock.Lock()
+	hooks := shutdownHooks
+	shutdownHooks = nil
+	shutdownLock.Unlock()
+	var errs []error
+	for i := len(hooks) - 1; i >= 0; i-- {
+		if err := hooks[i](ctx); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
@@ -0,0 +1,28 @@
+package ftl
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/alecthomas/assert/v2"
+)
+
+func TestRunShutdownHooks(t *testing.T) {
+	var order []string
+	OnShutdown(func(ctx context.Context) error {
+		order = append(order, "first")
+		return errors.New("first failed")
+	})
+	OnShutdown(func(ctx context.Context) error {
+		order = append(order, "second")
+		return nil
+	})
+	err := RunShutdownHooks(context.Background())
+	assert.EqualError(t, err, "first failed")
+	assert.Equal(t, []string{"second", "first"}, order)
+
+	// Hooks are only run once.
+	assert.NoError(t, RunShutdownHooks(context.Background()))
+	assert.Equal(t, []string{"second", "first"}, order)
+}
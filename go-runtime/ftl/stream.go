@@ -0,0 +1,36 @@
+package ftl
+
+import (
+	"context"
+	"reflect"
+	"runtime"
+)
+
+// Stream sends messages to the client of a streaming Verb.
+//
+// Streaming Verbs are exposed with an sse or websocket ingress, and accept a
+// Stream as their last parameter, eg.
+//
+//	//ftl:verb
+//	//ftl:ingress sse GET /prices
+//	func Prices(ctx context.Context, req builtin.HttpRequest[ftl.Unit], stream ftl.Stream[Price]) error
+//
+// The stream is closed when the Verb returns.
+type Stream[M any] interface {
+	// Send a message to the client.
+	//
+	// Send blocks until the message has been handed on for delivery, so a
+	// slow client slows down the Verb rather than messages accumulating in
+	// memory. Once the client disconnects Send returns an error, and the
+	// Verb's context is cancelled.
+	Send(msg M) error
+}
+
+// StreamingVerb is a Verb that sends messages to a Stream.
+type StreamingVerb[Req, Msg any] func(context.Context, Req, Stream[Msg]) error
+
+// StreamingVerbToRef returns the FTL reference for a StreamingVerb.
+func StreamingVerbToRef[Req, Msg any](verb StreamingVerb[Req, Msg]) VerbRef {
+	ref := runtime.FuncForPC(reflect.ValueOf(verb).Pointer()).Name()
+	return goRefToFTLRef(ref)
+}
@@ -57,6 +57,9 @@ func (v *AbstractRef[Proto]) ToProto() *Proto {
 	case *schemapb.SourceRef:
 		return any(&schemapb.SourceRef{Module: v.Module, Name: v.Name}).(*Proto) //nolint:forcetypeassert
 
+	case *schemapb.TopicRef:
+		return any(&schemapb.TopicRef{Module: v.Module, Name: v.Name}).(*Proto) //nolint:forcetypeassert
+
 	default:
 		panic(fmt.Sprintf("unsupported proto type %T", (*Proto)(nil)))
 	}
@@ -84,6 +87,9 @@ type Source[Req any] func(context.Context, Req) error
 
 type SourceRef = AbstractRef[schemapb.SourceRef]
 
+// An Empty is a function that neither accepts input nor returns output.
+type Empty func(context.Context) error
+
 func ParseSourceRef(ref string) (SourceRef, error) { return ParseRef[schemapb.SourceRef](ref) }
 func SourceRefFromProto(p *schemapb.SourceRef) SourceRef {
 	return SourceRef{Module: p.Module, Name: p.Name}
@@ -94,3 +100,11 @@ type DataRef = AbstractRef[schemapb.DataRef]
 
 func ParseDataRef(ref string) (DataRef, error)     { return ParseRef[schemapb.DataRef](ref) }
 func DataRefFromProto(p *schemapb.DataRef) DataRef { return DataRef{Module: p.Module, Name: p.Name} }
+
+// TopicRef is a reference to a Topic.
+type TopicRef = AbstractRef[schemapb.TopicRef]
+
+func ParseTopicRef(ref string) (TopicRef, error) { return ParseRef[schemapb.TopicRef](ref) }
+func TopicRefFromProto(p *schemapb.TopicRef) TopicRef {
+	return TopicRef{Module: p.Module, Name: p.Name}
+}
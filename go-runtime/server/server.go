@@ -2,9 +2,11 @@ package server
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"net/url"
 	"runtime/debug"
+	"sync"
 
 	"connectrpc.com/connect"
 
@@ -33,8 +35,12 @@ type UserVerbConfig struct {
 func NewUserVerbServer(moduleName string, handlers ...Handler) plugin.Constructor[ftlv1connect.VerbServiceHandler, UserVerbConfig] {
 	return func(ctx context.Context, uc UserVerbConfig) (context.Context, ftlv1connect.VerbServiceHandler, error) {
 		verbServiceClient := rpc.Dial(ftlv1connect.NewVerbServiceClient, uc.FTLEndpoint.String(), log.Error)
-		// Add config manager to context.
 		ctx = rpc.ContextWithClient(ctx, verbServiceClient)
+		// The controller client is used to publish events.
+		controllerServiceClient := rpc.Dial(ftlv1connect.NewControllerServiceClient, uc.FTLEndpoint.String(), log.Error)
+		ctx = rpc.ContextWithClient(ctx, controllerServiceClient)
+
+		// Add config manager to context.
 		cm, err := cf.NewConfigurationManager(ctx, uc.Config)
 		if err != nil {
 			return nil, nil, err
@@ -61,6 +67,8 @@ func NewUserVerbServer(moduleName string, handlers ...Handler) plugin.Constructo
 type Handler struct {
 	ref ftl.VerbRef
 	fn  func(ctx context.Context, req []byte) ([]byte, error)
+	// stream is set instead of fn for streaming Verbs.
+	stream func(ctx context.Context, req []byte, send func(msg []byte) error) error
 }
 
 // Handle creates a Handler from a Verb.
@@ -92,42 +100,121 @@ func Handle[Req, Resp any](verb func(ctx context.Context, req Req) (Resp, error)
 	}
 }
 
+// HandleEmpty creates a Handler from a Verb that neither accepts input nor
+// returns output, such as a cron job.
+func HandleEmpty(verb func(ctx context.Context) error) Handler {
+	ref := ftl.EmptyToRef(verb)
+	return Handler{
+		ref: ref,
+		fn: func(ctx context.Context, reqdata []byte) ([]byte, error) {
+			// Call Verb.
+			if err := verb(ctx); err != nil {
+				return nil, fmt.Errorf("call to verb %s failed: %w", ref, err)
+			}
+			return encoding.Marshal(ftl.Unit{})
+		},
+	}
+}
+
+// HandleSink creates a Handler from a Verb that accepts input but does not
+// return output, such as a subscriber.
+func HandleSink[Req any](sink func(ctx context.Context, req Req) error) Handler {
+	ref := ftl.SinkToRef(sink)
+	return Handler{
+		ref: ftl.VerbRef(ref),
+		fn: func(ctx context.Context, reqdata []byte) ([]byte, error) {
+			// Decode request.
+			var req Req
+			err := encoding.Unmarshal(reqdata, &req)
+			if err != nil {
+				return nil, fmt.Errorf("invalid request to verb %s: %w", ref, err)
+			}
+
+			// Call Verb.
+			if err := sink(ctx, req); err != nil {
+				return nil, fmt.Errorf("call to verb %s failed: %w", ref, err)
+			}
+			return encoding.Marshal(ftl.Unit{})
+		},
+	}
+}
+
+// HandleStream creates a Handler from a Verb that sends a stream of messages,
+// such as an SSE or WebSocket ingress Verb.
+func HandleStream[Req, Msg any](verb ftl.StreamingVerb[Req, Msg]) Handler {
+	ref := ftl.StreamingVerbToRef(verb)
+	return Handler{
+		ref: ref,
+		stream: func(ctx context.Context, reqdata []byte, send func(msg []byte) error) error {
+			// Decode request.
+			var req Req
+			err := encoding.Unmarshal(reqdata, &req)
+			if err != nil {
+				return fmt.Errorf("invalid request to verb %s: %w", ref, err)
+			}
+
+			// Call Verb.
+			if err := verb(ctx, req, &stream[Msg]{ctx: ctx, send: send}); err != nil {
+				return fmt.Errorf("call to verb %s failed: %w", ref, err)
+			}
+			return nil
+		},
+	}
+}
+
+// stream is the ftl.Stream passed to streaming Verbs.
+type stream[Msg any] struct {
+	ctx  context.Context
+	lock sync.Mutex
+	send func(msg []byte) error
+}
+
+func (s *stream[Msg]) Send(msg Msg) error {
+	if err := s.ctx.Err(); err != nil {
+		return fmt.Errorf("stream closed: %w", err)
+	}
+	data, err := encoding.Marshal(msg)
+	if err != nil {
+		return err
+	}
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	return s.send(data)
+}
+
 var _ ftlv1connect.VerbServiceHandler = (*moduleServer)(nil)
+var _ plugin.Shutdowner = (*moduleServer)(nil)
 
 // This is the server that is compiled into the same binary as user-defined Verbs.
 type moduleServer struct {
 	handlers map[ftl.VerbRef]Handler
 }
 
+// Shutdown runs the hooks registered by the module with [ftl.OnShutdown].
+func (m *moduleServer) Shutdown(ctx context.Context) error {
+	return ftl.RunShutdownHooks(ctx)
+}
+
 func (m *moduleServer) Call(ctx context.Context, req *connect.Request[ftlv1.CallRequest]) (response *connect.Response[ftlv1.CallResponse], err error) {
-	logger := log.FromContext(ctx)
 	// Recover from panics and return an error ftlv1.CallResponse.
 	defer func() {
 		if r := recover(); r != nil {
-			var err error
-			if rerr, ok := r.(error); ok {
-				err = rerr
-			} else {
-				err = fmt.Errorf("%v", r)
-			}
-			stack := string(debug.Stack())
-			logger.Errorf(err, "panic in verb %s.%s", req.Msg.Verb.Module, req.Msg.Verb.Name)
-			response = connect.NewResponse(&ftlv1.CallResponse{Response: &ftlv1.CallResponse_Error_{Error: &ftlv1.CallResponse_Error{
-				Message: err.Error(),
-				Stack:   &stack,
-			}}})
+			response = connect.NewResponse(&ftlv1.CallResponse{Response: &ftlv1.CallResponse_Error_{Error: panicError(ctx, req.Msg, r)}})
 		}
 	}()
 	handler, ok := m.handlers[ftl.VerbRefFromProto(req.Msg.Verb)]
 	if !ok {
 		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("verb %q not found", req.Msg.Verb))
 	}
+	if handler.fn == nil {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("verb %q is a streaming verb", req.Msg.Verb))
+	}
 
 	respdata, err := handler.fn(ctx, req.Msg.Body)
 	if err != nil {
 		// This makes me slightly ill.
 		return connect.NewResponse(&ftlv1.CallResponse{
-			Response: &ftlv1.CallResponse_Error_{Error: &ftlv1.CallResponse_Error{Message: err.Error()}},
+			Response: &ftlv1.CallResponse_Error_{Error: callError(ctx, err)},
 		}), nil
 	}
 
@@ -136,6 +223,70 @@ func (m *moduleServer) Call(ctx context.Context, req *connect.Request[ftlv1.Call
 	}), nil
 }
 
+func (m *moduleServer) CallStream(ctx context.Context, req *connect.Request[ftlv1.CallRequest], stream *connect.ServerStream[ftlv1.CallResponse]) (err error) {
+	// Recover from panics and end the stream with an error.
+	defer func() {
+		if r := recover(); r != nil {
+			err = stream.Send(&ftlv1.CallResponse{Response: &ftlv1.CallResponse_Error_{Error: panicError(ctx, req.Msg, r)}})
+		}
+	}()
+	handler, ok := m.handlers[ftl.VerbRefFromProto(req.Msg.Verb)]
+	if !ok {
+		return connect.NewError(connect.CodeNotFound, fmt.Errorf("verb %q not found", req.Msg.Verb))
+	}
+	if handler.stream == nil {
+		return connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("verb %q is not a streaming verb", req.Msg.Verb))
+	}
+	err = handler.stream(ctx, req.Msg.Body, func(msg []byte) error {
+		return stream.Send(&ftlv1.CallResponse{Response: &ftlv1.CallResponse_Body{Body: msg}})
+	})
+	if err != nil {
+		if ctx.Err() != nil {
+			// The client has gone away, so there is no one to tell.
+			return connect.NewError(connect.CodeCanceled, err)
+		}
+		return stream.Send(&ftlv1.CallResponse{Response: &ftlv1.CallResponse_Error_{Error: callError(ctx, err)}})
+	}
+	return nil
+}
+
+// panicError converts a panic in a Verb into a CallResponse error.
+func panicError(ctx context.Context, req *ftlv1.CallRequest, r any) *ftlv1.CallResponse_Error {
+	var err error
+	if rerr, ok := r.(error); ok {
+		err = rerr
+	} else {
+		err = fmt.Errorf("%v", r)
+	}
+	stack := string(debug.Stack())
+	log.FromContext(ctx).Errorf(err, "panic in verb %s.%s", req.Verb.Module, req.Verb.Name)
+	return &ftlv1.CallResponse_Error{
+		Message: err.Error(),
+		Stack:   &stack,
+	}
+}
+
+// callError converts an error returned by a Verb into a CallResponse error,
+// including the code and details of typed errors.
+func callError(ctx context.Context, err error) *ftlv1.CallResponse_Error {
+	out := &ftlv1.CallResponse_Error{Message: err.Error()}
+	var verbErr *ftl.VerbError
+	if !errors.As(err, &verbErr) {
+		return out
+	}
+	code := string(ftl.ErrorCodeOf(err))
+	out.Code = &code
+	if verbErr.Details != nil {
+		details, err := encoding.Marshal(verbErr.Details)
+		if err != nil {
+			log.FromContext(ctx).Errorf(err, "failed to encode error details")
+		} else {
+			out.Details = details
+		}
+	}
+	return out
+}
+
 func (m *moduleServer) Ping(ctx context.Context, req *connect.Request[ftlv1.PingRequest]) (*connect.Response[ftlv1.PingResponse], error) {
 	return connect.NewResponse(&ftlv1.PingResponse{}), nil
 }
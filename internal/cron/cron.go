@@ -0,0 +1,209 @@
+// Package cron parses and evaluates standard five field cron expressions.
+//
+// The supported syntax is:
+//
+//	minute hour day-of-month month day-of-week
+//
+// Each field may be "*", a value, a range "a-b", a list "a,b,c", or any of
+// those followed by a step "/n". Day-of-week 0 and 7 are both Sunday.
+package cron
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+type field struct {
+	name     string
+	min, max int
+}
+
+var fields = []field{
+	{"minute", 0, 59},
+	{"hour", 0, 23},
+	{"day-of-month", 1, 31},
+	{"month", 1, 12},
+	{"day-of-week", 0, 7},
+}
+
+// Pattern is a parsed cron expression.
+type Pattern struct {
+	expr    string
+	minutes uint64
+	hours   uint64
+	dom     uint64
+	months  uint64
+	dow     uint64
+	// True if the corresponding field was "*" (possibly with a step).
+	domStar bool
+	dowStar bool
+}
+
+// Parse a five field cron expression.
+func Parse(expr string) (Pattern, error) {
+	parts := strings.Fields(expr)
+	if len(parts) != len(fields) {
+		return Pattern{}, fmt.Errorf("cron expression %q must have %d fields but has %d", expr, len(fields), len(parts))
+	}
+	sets := make([]uint64, len(fields))
+	for i, part := range parts {
+		set, err := parseField(part, fields[i])
+		if err != nil {
+			return Pattern{}, fmt.Errorf("invalid cron expression %q: %w", expr, err)
+		}
+		sets[i] = set
+	}
+	// Normalise Sunday.
+	if sets[4]&(1<<7) != 0 {
+		sets[4] = (sets[4] | 1) &^ (1 << 7)
+	}
+	domStar := strings.HasPrefix(parts[2], "*")
+	dowStar := strings.HasPrefix(parts[4], "*")
+	if !domStar && dowStar && !anyDayInMonths(sets[2], sets[3]) {
+		return Pattern{}, fmt.Errorf("invalid cron expression %q: day-of-month never occurs in month", expr)
+	}
+	return Pattern{
+		expr:    strings.Join(parts, " "),
+		minutes: sets[0],
+		hours:   sets[1],
+		dom:     sets[2],
+		months:  sets[3],
+		dow:     sets[4],
+		domStar: domStar,
+		dowStar: dowStar,
+	}, nil
+}
+
+// The number of days in each month, counting February in leap years.
+var daysInMonth = [13]int{0, 31, 29, 31, 30, 31, 30, 31, 31, 30, 31, 30, 31}
+
+// anyDayInMonths returns true if any of the days of the month occur in any of
+// the months.
+func anyDayInMonths(dom, months uint64) bool {
+	for month := 1; month <= 12; month++ {
+		if months&(1<<uint(month)) == 0 {
+			continue
+		}
+		if dom&(1<<uint(daysInMonth[month]+1)-1) != 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// MustParse is like [Parse] but panics on error.
+func MustParse(expr string) Pattern {
+	p, err := Parse(expr)
+	if err != nil {
+		panic(err)
+	}
+	return p
+}
+
+func (p Pattern) String() string { return p.expr }
+
+// Next returns the first time strictly after "t" that matches the pattern.
+//
+// The returned time has a resolution of one minute and is in the same
+// location as "t".
+func (p Pattern) Next(t time.Time) time.Time {
+	t = t.Truncate(time.Minute).Add(time.Minute)
+	// Any valid pattern matches at least once within eight years, the longest
+	// gap between leap years.
+	limit := t.AddDate(9, 0, 0)
+	for t.Before(limit) {
+		if p.months&(1<<uint(t.Month())) == 0 {
+			t = time.Date(t.Year(), t.Month()+1, 1, 0, 0, 0, 0, t.Location())
+			continue
+		}
+		if !p.matchesDay(t) {
+			t = time.Date(t.Year(), t.Month(), t.Day()+1, 0, 0, 0, 0, t.Location())
+			continue
+		}
+		if p.hours&(1<<uint(t.Hour())) == 0 {
+			t = time.Date(t.Year(), t.Month(), t.Day(), t.Hour()+1, 0, 0, 0, t.Location())
+			continue
+		}
+		if p.minutes&(1<<uint(t.Minute())) == 0 {
+			t = t.Add(time.Minute)
+			continue
+		}
+		return t
+	}
+	return time.Time{}
+}
+
+// Day-of-month and day-of-week are OR-ed together if both are restricted,
+// as per traditional cron semantics.
+func (p Pattern) matchesDay(t time.Time) bool {
+	dom := p.dom&(1<<uint(t.Day())) != 0
+	dow := p.dow&(1<<uint(t.Weekday())) != 0
+	switch {
+	case p.domStar && p.dowStar:
+		return true
+	case p.domStar:
+		return dow
+	case p.dowStar:
+		return dom
+	default:
+		return dom || dow
+	}
+}
+
+func parseField(text string, f field) (uint64, error) {
+	var set uint64
+	for _, item := range strings.Split(text, ",") {
+		rng, stepText, hasStep := strings.Cut(item, "/")
+		step := 1
+		if hasStep {
+			var err error
+			step, err = strconv.Atoi(stepText)
+			if err != nil || step <= 0 {
+				return 0, fmt.Errorf("%s: invalid step %q", f.name, stepText)
+			}
+		}
+		var lo, hi int
+		switch {
+		case rng == "*":
+			lo, hi = f.min, f.max
+		case strings.Contains(rng, "-"):
+			loText, hiText, _ := strings.Cut(rng, "-")
+			var err error
+			if lo, err = parseValue(loText, f); err != nil {
+				return 0, err
+			}
+			if hi, err = parseValue(hiText, f); err != nil {
+				return 0, err
+			}
+			if lo > hi {
+				return 0, fmt.Errorf("%s: invalid range %q", f.name, rng)
+			}
+		default:
+			var err error
+			if lo, err = parseValue(rng, f); err != nil {
+				return 0, err
+			}
+			hi = lo
+			if hasStep {
+				hi = f.max
+			}
+		}
+		for i := lo; i <= hi; i += step {
+			set |= 1 << uint(i)
+		}
+	}
+	return set, nil
+}
+
+func parseValue(text string, f field) (int, error) {
+	v, err := strconv.Atoi(text)
+	if err != nil {
+		return 0, fmt.Errorf("%s: invalid value %q", f.name, text)
+	}
+	if v < f.min || v > f.max {
+		return 0, fmt.Errorf("%s: value %d out of range %d-%d", f.name, v, f.min, f.max)
+	}
+	return v, nil
+}
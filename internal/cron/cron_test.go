@@ -0,0 +1,58 @@
+package cron
+
+import (
+	"testing"
+	"time"
+
+	"github.com/alecthomas/assert/v2"
+)
+
+func TestNext(t *testing.T) {
+	start := time.Date(2024, time.February, 28, 23, 58, 30, 0, time.UTC)
+	tests := []struct {
+		expr     string
+		expected time.Time
+	}{
+		{"* * * * *", time.Date(2024, time.February, 28, 23, 59, 0, 0, time.UTC)},
+		{"*/5 * * * *", time.Date(2024, time.February, 29, 0, 0, 0, 0, time.UTC)},
+		{"30 9 * * *", time.Date(2024, time.February, 29, 9, 30, 0, 0, time.UTC)},
+		{"0 0 1 * *", time.Date(2024, time.March, 1, 0, 0, 0, 0, time.UTC)},
+		{"0 12 * * 1-5", time.Date(2024, time.February, 29, 12, 0, 0, 0, time.UTC)},
+		{"0 0 * * 7", time.Date(2024, time.March, 3, 0, 0, 0, 0, time.UTC)},
+		{"15,45 */6 * 12 *", time.Date(2024, time.December, 1, 0, 15, 0, 0, time.UTC)},
+		{"0 0 29 2 *", time.Date(2024, time.February, 29, 0, 0, 0, 0, time.UTC)},
+		// Day-of-week makes an impossible day-of-month possible.
+		{"0 0 30 2 1", time.Date(2025, time.February, 3, 0, 0, 0, 0, time.UTC)},
+		// Day-of-month and day-of-week are OR-ed.
+		{"0 0 15 * 5", time.Date(2024, time.March, 1, 0, 0, 0, 0, time.UTC)},
+	}
+	for _, test := range tests {
+		t.Run(test.expr, func(t *testing.T) {
+			pattern, err := Parse(test.expr)
+			assert.NoError(t, err)
+			assert.Equal(t, test.expected, pattern.Next(start))
+		})
+	}
+}
+
+func TestParseErrors(t *testing.T) {
+	tests := []struct {
+		expr string
+		err  string
+	}{
+		{"* * * *", `cron expression "* * * *" must have 5 fields but has 4`},
+		{"60 * * * *", `invalid cron expression "60 * * * *": minute: value 60 out of range 0-59`},
+		{"* * 0 * *", `invalid cron expression "* * 0 * *": day-of-month: value 0 out of range 1-31`},
+		{"*/0 * * * *", `invalid cron expression "*/0 * * * *": minute: invalid step "0"`},
+		{"5-1 * * * *", `invalid cron expression "5-1 * * * *": minute: invalid range "5-1"`},
+		{"a * * * *", `invalid cron expression "a * * * *": minute: invalid value "a"`},
+		{"0 0 30 2 *", `invalid cron expression "0 0 30 2 *": day-of-month never occurs in month`},
+		{"0 0 31 4,6,9,11 *", `invalid cron expression "0 0 31 4,6,9,11 *": day-of-month never occurs in month`},
+	}
+	for _, test := range tests {
+		t.Run(test.expr, func(t *testing.T) {
+			_, err := Parse(test.expr)
+			assert.EqualError(t, err, test.err)
+		})
+	}
+}
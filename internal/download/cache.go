@@ -0,0 +1,318 @@
+package download
+
+import (
+	"context"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"hash"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"connectrpc.com/connect"
+
+	ftlv1 "github.com/TBD54566975/ftl/backend/protos/xyz/block/ftl/v1"
+	"github.com/TBD54566975/ftl/backend/protos/xyz/block/ftl/v1/ftlv1connect"
+	"github.com/TBD54566975/ftl/internal/log"
+	"github.com/TBD54566975/ftl/internal/model"
+	ftlsha256 "github.com/TBD54566975/ftl/internal/sha256"
+)
+
+// Partial downloads older than this are assumed to have been abandoned.
+const abandonedDownloadAge = time.Hour
+
+// Cache is a persistent cache of artefacts keyed by their SHA256 digest.
+//
+// Cached artefacts are hard-linked into deployment directories, so unchanged
+// artefacts are neither transferred nor copied again by later deployments.
+// The cache is bounded in size by evicting the least recently used artefacts,
+// tracked by their modification times so that use survives restarts.
+//
+// The cache directory can be shared by processes on the same host, as
+// artefacts are written atomically and a missing artefact is simply a miss.
+type Cache struct {
+	dir     string
+	maxSize int64
+	lock    sync.Mutex
+}
+
+// NewCache creates a Cache in dir that holds at most maxSize bytes.
+func NewCache(dir string, maxSize int64) (*Cache, error) {
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return nil, fmt.Errorf("failed to create artefact cache directory: %w", err)
+	}
+	return &Cache{dir: dir, maxSize: maxSize}, nil
+}
+
+func (c *Cache) path(digest ftlsha256.SHA256) string {
+	return filepath.Join(c.dir, digest.String())
+}
+
+// Artefacts downloads the artefacts of a deployment to dest, linking those
+// that are already cached rather than downloading them.
+//
+// artefacts is the full list of artefacts in the deployment.
+func (c *Cache) Artefacts(ctx context.Context, client ftlv1connect.ControllerServiceClient, name model.DeploymentName, artefacts []*ftlv1.DeploymentArtefact, dest string) error {
+	logger := log.FromContext(ctx)
+	start := time.Now()
+	have := []*ftlv1.DeploymentArtefact{}
+	for _, artefact := range artefacts {
+		ok, err := c.link(artefact, dest)
+		if err != nil {
+			return err
+		}
+		if ok {
+			have = append(have, artefact)
+		}
+	}
+	logger.Debugf("Linked %d of %d artefacts from cache", len(have), len(artefacts))
+
+	stream, err := client.GetDeploymentArtefacts(ctx, connect.NewRequest(&ftlv1.GetDeploymentArtefactsRequest{
+		DeploymentName: name.String(),
+		HaveArtefacts:  have,
+	}))
+	if err != nil {
+		return err
+	}
+	count := 0
+	var current *ftlv1.DeploymentArtefact
+	var w *cacheWriter
+	finish := func() error {
+		if w == nil {
+			return nil
+		}
+		if err := w.commit(); err != nil {
+			return err
+		}
+		w = nil
+		ok, err := c.link(current, dest)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			return fmt.Errorf("artefact %s was evicted before it could be linked", current.Path)
+		}
+		return nil
+	}
+	for stream.Receive() {
+		msg := stream.Msg()
+		artefact := msg.Artefact
+		if current == nil || current.Digest != artefact.Digest || current.Path != artefact.Path {
+			if err := finish(); err != nil {
+				return err
+			}
+			count++
+			if !filepath.IsLocal(artefact.Path) {
+				return fmt.Errorf("path %q is not local", artefact.Path)
+			}
+			digest, err := ftlsha256.ParseSHA256(artefact.Digest)
+			if err != nil {
+				return fmt.Errorf("invalid digest for artefact %s: %w", artefact.Path, err)
+			}
+			logger.Debugf("Downloading %s", filepath.Join(dest, artefact.Path))
+			w, err = c.create(digest)
+			if err != nil {
+				return err
+			}
+			current = artefact
+		}
+		if _, err := w.Write(msg.Chunk); err != nil {
+			w.abort()
+			return err
+		}
+	}
+	if err := stream.Err(); err != nil {
+		if w != nil {
+			w.abort()
+		}
+		return err
+	}
+	if err := finish(); err != nil {
+		return err
+	}
+	logger.Debugf("Downloaded %d artefacts in %s", count, time.Since(start))
+	return c.Evict()
+}
+
+// link an artefact from the cache into a deployment directory, returning
+// false if it is not cached.
+//
+// Cached artefacts are verified against their digest before use, and evicted
+// if they are corrupt.
+func (c *Cache) link(artefact *ftlv1.DeploymentArtefact, dest string) (bool, error) {
+	if !filepath.IsLocal(artefact.Path) {
+		return false, fmt.Errorf("path %q is not local", artefact.Path)
+	}
+	digest, err := ftlsha256.ParseSHA256(artefact.Digest)
+	if err != nil {
+		return false, fmt.Errorf("invalid digest for artefact %s: %w", artefact.Path, err)
+	}
+	src := c.path(digest)
+	actual, err := ftlsha256.SumFile(src)
+	if errors.Is(err, os.ErrNotExist) {
+		return false, nil
+	} else if err != nil {
+		return false, fmt.Errorf("failed to verify cached artefact %s: %w", digest, err)
+	}
+	if actual != digest {
+		_ = os.Remove(src)
+		return false, nil
+	}
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	target := filepath.Join(dest, artefact.Path)
+	if err := os.MkdirAll(filepath.Dir(target), 0700); err != nil {
+		return false, err
+	}
+	// Artefacts may also be in the deployment template.
+	if err := os.Remove(target); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return false, err
+	}
+	if err := os.Link(src, target); err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			// Evicted by another process since it was verified.
+			return false, nil
+		}
+		// Hard links are not possible across filesystems, so fall back to
+		// copying.
+		if err := copyFile(src, target); err != nil {
+			return false, fmt.Errorf("failed to link artefact %s: %w", artefact.Path, err)
+		}
+	}
+	// Cached artefacts are shared by all of their links, so they are never
+	// writable, and are executable in case any deployment needs them to be.
+	var mode os.FileMode = 0400
+	if artefact.Executable {
+		mode = 0500
+	}
+	if info, err := os.Stat(target); err == nil && info.Mode().Perm()|mode != info.Mode().Perm() {
+		if err := os.Chmod(target, info.Mode().Perm()|mode); err != nil {
+			return false, err
+		}
+	}
+	now := time.Now()
+	_ = os.Chtimes(src, now, now)
+	return true, nil
+}
+
+func copyFile(src, dest string) error {
+	r, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer r.Close() //nolint:gosec
+	w, err := os.OpenFile(dest, os.O_CREATE|os.O_WRONLY|os.O_EXCL, 0400)
+	if err != nil {
+		return err
+	}
+	if _, err := io.Copy(w, r); err != nil {
+		_ = w.Close()
+		return err
+	}
+	return w.Close()
+}
+
+// cacheWriter writes an artefact to a temporary file in the cache, which is
+// moved into place once its digest has been verified.
+type cacheWriter struct {
+	*os.File
+	cache  *Cache
+	digest ftlsha256.SHA256
+	hash   hash.Hash
+}
+
+func (c *Cache) create(digest ftlsha256.SHA256) (*cacheWriter, error) {
+	f, err := os.CreateTemp(c.dir, ".download-*")
+	if err != nil {
+		return nil, fmt.Errorf("failed to create cached artefact: %w", err)
+	}
+	return &cacheWriter{File: f, cache: c, digest: digest, hash: sha256.New()}, nil
+}
+
+func (w *cacheWriter) Write(p []byte) (int, error) {
+	_, _ = w.hash.Write(p)
+	return w.File.Write(p)
+}
+
+func (w *cacheWriter) abort() {
+	_ = w.File.Close()
+	_ = os.Remove(w.File.Name())
+}
+
+func (w *cacheWriter) commit() error {
+	if err := w.File.Close(); err != nil {
+		_ = os.Remove(w.File.Name())
+		return err
+	}
+	if actual := ftlsha256.FromBytes(w.hash.Sum(nil)); actual != w.digest {
+		_ = os.Remove(w.File.Name())
+		return fmt.Errorf("artefact digest mismatch, expected %s but got %s", w.digest, actual)
+	}
+	if err := os.Chmod(w.File.Name(), 0500); err != nil {
+		_ = os.Remove(w.File.Name())
+		return err
+	}
+	w.cache.lock.Lock()
+	defer w.cache.lock.Unlock()
+	if err := os.Rename(w.File.Name(), w.cache.path(w.digest)); err != nil {
+		_ = os.Remove(w.File.Name())
+		return fmt.Errorf("failed to cache artefact: %w", err)
+	}
+	return nil
+}
+
+// Evict the least recently used artefacts until the cache is within its
+// maximum size.
+//
+// Evicted artefacts remain in the deployment directories they are linked
+// into.
+func (c *Cache) Evict() error {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	entries, err := os.ReadDir(c.dir)
+	if err != nil {
+		return fmt.Errorf("failed to read artefact cache: %w", err)
+	}
+	type cached struct {
+		path    string
+		size    int64
+		modTime time.Time
+	}
+	artefacts := make([]cached, 0, len(entries))
+	var size int64
+	for _, entry := range entries {
+		if !entry.Type().IsRegular() {
+			continue
+		}
+		info, err := entry.Info()
+		if err != nil {
+			continue
+		}
+		if _, err := ftlsha256.ParseSHA256(entry.Name()); err != nil {
+			// Clean up abandoned partial downloads.
+			if strings.HasPrefix(entry.Name(), ".download-") && time.Since(info.ModTime()) > abandonedDownloadAge {
+				_ = os.Remove(filepath.Join(c.dir, entry.Name()))
+			}
+			continue
+		}
+		artefacts = append(artefacts, cached{path: filepath.Join(c.dir, entry.Name()), size: info.Size(), modTime: info.ModTime()})
+		size += info.Size()
+	}
+	sort.Slice(artefacts, func(i, j int) bool { return artefacts[i].modTime.Before(artefacts[j].modTime) })
+	for _, artefact := range artefacts {
+		if size <= c.maxSize {
+			break
+		}
+		if err := os.Remove(artefact.path); err != nil && !errors.Is(err, os.ErrNotExist) {
+			return fmt.Errorf("failed to evict artefact: %w", err)
+		}
+		size -= artefact.size
+	}
+	return nil
+}
@@ -0,0 +1,101 @@
+package download
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/alecthomas/assert/v2"
+
+	ftlv1 "github.com/TBD54566975/ftl/backend/protos/xyz/block/ftl/v1"
+	ftlsha256 "github.com/TBD54566975/ftl/internal/sha256"
+)
+
+func cacheArtefact(t *testing.T, c *Cache, path string, content string) *ftlv1.DeploymentArtefact {
+	t.Helper()
+	digest := ftlsha256.Sum([]byte(content))
+	w, err := c.create(digest)
+	assert.NoError(t, err)
+	_, err = w.Write([]byte(content))
+	assert.NoError(t, err)
+	assert.NoError(t, w.commit())
+	return &ftlv1.DeploymentArtefact{Path: path, Digest: digest.String()}
+}
+
+func TestCacheLink(t *testing.T) {
+	c, err := NewCache(t.TempDir(), 1024)
+	assert.NoError(t, err)
+	artefact := cacheArtefact(t, c, "bin/main", "hello")
+
+	dest := t.TempDir()
+	ok, err := c.link(artefact, dest)
+	assert.NoError(t, err)
+	assert.True(t, ok)
+	content, err := os.ReadFile(filepath.Join(dest, "bin/main"))
+	assert.NoError(t, err)
+	assert.Equal(t, "hello", string(content))
+
+	// Artefacts are hard-linked rather than copied.
+	cached, err := os.Stat(c.path(ftlsha256.MustParseSHA256(artefact.Digest)))
+	assert.NoError(t, err)
+	linked, err := os.Stat(filepath.Join(dest, "bin/main"))
+	assert.NoError(t, err)
+	assert.True(t, os.SameFile(cached, linked))
+
+	ok, err = c.link(&ftlv1.DeploymentArtefact{Path: "missing", Digest: ftlsha256.Sum([]byte("missing")).String()}, dest)
+	assert.NoError(t, err)
+	assert.False(t, ok)
+
+	_, err = c.link(&ftlv1.DeploymentArtefact{Path: "../escape", Digest: artefact.Digest}, dest)
+	assert.Error(t, err)
+}
+
+func TestCacheCorruptArtefact(t *testing.T) {
+	c, err := NewCache(t.TempDir(), 1024)
+	assert.NoError(t, err)
+	artefact := cacheArtefact(t, c, "main", "hello")
+	path := c.path(ftlsha256.MustParseSHA256(artefact.Digest))
+	assert.NoError(t, os.Chmod(path, 0600))
+	assert.NoError(t, os.WriteFile(path, []byte("corrupt"), 0600))
+
+	ok, err := c.link(artefact, t.TempDir())
+	assert.NoError(t, err)
+	assert.False(t, ok)
+	_, err = os.Stat(path)
+	assert.True(t, os.IsNotExist(err))
+
+	w, err := c.create(ftlsha256.Sum([]byte("hello")))
+	assert.NoError(t, err)
+	_, err = w.Write([]byte("corrupt"))
+	assert.NoError(t, err)
+	assert.Error(t, w.commit())
+	_, err = os.Stat(path)
+	assert.True(t, os.IsNotExist(err))
+}
+
+func TestCacheEvict(t *testing.T) {
+	c, err := NewCache(t.TempDir(), 10)
+	assert.NoError(t, err)
+	oldest := cacheArtefact(t, c, "a", "aaaa")
+	used := cacheArtefact(t, c, "b", "bbbb")
+	newest := cacheArtefact(t, c, "c", "cccc")
+	past := time.Now().Add(-time.Minute)
+	for i, artefact := range []*ftlv1.DeploymentArtefact{oldest, used, newest} {
+		at := past.Add(time.Second * time.Duration(i))
+		assert.NoError(t, os.Chtimes(c.path(ftlsha256.MustParseSHA256(artefact.Digest)), at, at))
+	}
+	// Using an artefact makes it the most recently used.
+	ok, err := c.link(used, t.TempDir())
+	assert.NoError(t, err)
+	assert.True(t, ok)
+
+	assert.NoError(t, c.Evict())
+	for _, test := range []struct {
+		artefact *ftlv1.DeploymentArtefact
+		cached   bool
+	}{{oldest, false}, {used, true}, {newest, true}} {
+		_, err := os.Stat(c.path(ftlsha256.MustParseSHA256(test.artefact.Digest)))
+		assert.Equal(t, test.cached, err == nil, "%s", test.artefact.Path)
+	}
+}
@@ -52,6 +52,15 @@ func (d *DeploymentName) String() string {
 	return string(*d)
 }
 
+// Module returns the name of the module the deployment is of.
+func (d *DeploymentName) Module() string {
+	name := string(*d)
+	if i := strings.LastIndex(name, "-"); i >= 0 {
+		return name[:i]
+	}
+	return name
+}
+
 func (d *DeploymentName) UnmarshalText(bytes []byte) error {
 	name, err := ParseDeploymentName(string(bytes))
 	if err != nil {
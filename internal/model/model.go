@@ -16,6 +16,16 @@ type Deployment struct {
 	Name      DeploymentName
 	Schema    *schema.Module
 	Artefacts []*Artefact
+	Limits    DeploymentLimits
+}
+
+// DeploymentLimits are the resource limits applied to each process of a
+// deployment. Zero values are unlimited.
+type DeploymentLimits struct {
+	// CPU is the number of CPU cores, which may be fractional.
+	CPU         float64 `json:"cpu,omitempty"`
+	MemoryBytes int64   `json:"memoryBytes,omitempty"`
+	OpenFiles   int64   `json:"openFiles,omitempty"`
 }
 
 // Close is a convenience function to close all artefacts.
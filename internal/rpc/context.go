@@ -19,6 +19,7 @@ import (
 type ftlDirectRoutingKey struct{}
 type ftlVerbKey struct{}
 type requestIDKey struct{}
+type clientAddrKey struct{}
 
 // WithDirectRouting ensures any hops in Verb routing do not redirect.
 //
@@ -75,6 +76,19 @@ func WithRequestName(ctx context.Context, key model.RequestName) context.Context
 	return context.WithValue(ctx, requestIDKey{}, key.String())
 }
 
+// ClientAddrFromContext returns the address of the client that originated the
+// request, if any.
+func ClientAddrFromContext(ctx context.Context) (string, bool) {
+	addr, ok := ctx.Value(clientAddrKey{}).(string)
+	return addr, ok
+}
+
+// WithClientAddr adds the address of the client that originated the request
+// to the context.
+func WithClientAddr(ctx context.Context, addr string) context.Context {
+	return context.WithValue(ctx, clientAddrKey{}, addr)
+}
+
 func DefaultClientOptions(level log.Level) []connect.ClientOption {
 	interceptors := []connect.Interceptor{MetadataInterceptor(log.Debug), otelInterceptor()}
 	if ftl.Version != "dev" {
@@ -128,10 +142,11 @@ func (m *metadataInterceptor) WrapStreamingHandler(req connect.StreamingHandlerF
 	return func(ctx context.Context, s connect.StreamingHandlerConn) error {
 		logger := log.FromContext(ctx)
 		logger.Tracef("%s (streaming handler)", s.Spec().Procedure)
-		ctx, err := propagateHeaders(ctx, s.Spec().IsClient, s.RequestHeader())
+		ctx, cancel, err := propagateHeaders(ctx, s.Spec().IsClient, s.RequestHeader())
 		if err != nil {
 			return err
 		}
+		defer cancel()
 		err = req(ctx, s)
 		if err != nil {
 			if connect.CodeOf(err) == connect.CodeCanceled {
@@ -148,10 +163,11 @@ func (m *metadataInterceptor) WrapUnary(uf connect.UnaryFunc) connect.UnaryFunc
 	return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
 		logger := log.FromContext(ctx)
 		logger.Tracef("%s (unary)", req.Spec().Procedure)
-		ctx, err := propagateHeaders(ctx, req.Spec().IsClient, req.Header())
+		ctx, cancel, err := propagateHeaders(ctx, req.Spec().IsClient, req.Header())
 		if err != nil {
 			return nil, err
 		}
+		defer cancel()
 		resp, err := uf(ctx, req)
 		if err != nil {
 			logger.Logf(m.errorLevel, "Unary RPC failed: %s: %s", err, req.Spec().Procedure)
@@ -177,7 +193,12 @@ func ClientFromContext[Client Pingable](ctx context.Context) Client {
 	return value.(Client) //nolint:forcetypeassert
 }
 
-func propagateHeaders(ctx context.Context, isClient bool, header http.Header) (context.Context, error) {
+// propagateHeaders from the context to outgoing requests, or from incoming
+// requests to the context.
+//
+// The returned cancel function must be called once the request completes.
+func propagateHeaders(ctx context.Context, isClient bool, header http.Header) (context.Context, context.CancelFunc, error) {
+	cancel := func() {}
 	if isClient {
 		if IsDirectRouted(ctx) {
 			headers.SetDirectRouted(header)
@@ -187,28 +208,43 @@ func propagateHeaders(ctx context.Context, isClient bool, header http.Header) (c
 		}
 		if key, ok, err := RequestNameFromContext(ctx); ok {
 			if err != nil {
-				return nil, err
+				return nil, nil, err
 			}
 			if ok {
 				headers.SetRequestName(header, key)
 			}
 		}
+		if addr, ok := ClientAddrFromContext(ctx); ok {
+			headers.SetClientAddr(header, addr)
+		}
+		if deadline, ok := ctx.Deadline(); ok {
+			headers.SetDeadline(header, deadline)
+		}
 	} else {
 		if headers.IsDirectRouted(header) {
 			ctx = WithDirectRouting(ctx)
 		}
 		if verbs, err := headers.GetCallers(header); err != nil {
-			return nil, err
+			return nil, nil, err
 		} else { //nolint:revive
 			ctx = WithVerbs(ctx, verbs)
 		}
 		if key, ok, err := headers.GetRequestName(header); err != nil {
-			return nil, err
+			return nil, nil, err
 		} else if ok {
 			ctx = WithRequestName(ctx, key)
 		}
+		if addr, ok := headers.GetClientAddr(header); ok {
+			ctx = WithClientAddr(ctx, addr)
+		}
+		// Deadlines only ever shorten the context of the request.
+		if deadline, ok, err := headers.GetDeadline(header); err != nil {
+			return nil, nil, err
+		} else if ok {
+			ctx, cancel = context.WithDeadline(ctx, deadline)
+		}
 	}
-	return ctx, nil
+	return ctx, cancel, nil
 }
 
 // versionInterceptor reports a warning to the client if the client is older than the server.
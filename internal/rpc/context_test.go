@@ -4,6 +4,7 @@ import (
 	"context"
 	"net/http"
 	"testing"
+	"time"
 
 	"github.com/TBD54566975/ftl/backend/protos/xyz/block/ftl/v1/ftlv1connect"
 	"github.com/alecthomas/assert/v2"
@@ -19,3 +20,21 @@ func TestRPCContext(t *testing.T) {
 	assert.Equal(t, verbClient, ClientFromContext[ftlv1connect.VerbServiceClient](ctx))
 	assert.Equal(t, controllerClient, ClientFromContext[ftlv1connect.ControllerServiceClient](ctx))
 }
+
+func TestPropagateDeadline(t *testing.T) {
+	deadline := time.Now().Add(time.Minute).Truncate(time.Millisecond)
+	ctx, cancel := context.WithDeadline(context.Background(), deadline)
+	defer cancel()
+
+	header := http.Header{}
+	_, cancelClient, err := propagateHeaders(ctx, true, header)
+	assert.NoError(t, err)
+	defer cancelClient()
+
+	ctx, cancelServer, err := propagateHeaders(context.Background(), false, header)
+	assert.NoError(t, err)
+	defer cancelServer()
+	actual, ok := ctx.Deadline()
+	assert.True(t, ok)
+	assert.True(t, actual.Equal(deadline), "expected %s but got %s", deadline, actual)
+}
@@ -3,6 +3,7 @@ package headers
 import (
 	"fmt"
 	"net/http"
+	"time"
 
 	"github.com/alecthomas/types/optional"
 
@@ -19,6 +20,19 @@ const (
 	VerbHeader = "FTL-Verb"
 	// RequestIDHeader is the header used to pass the inbound request ID.
 	RequestIDHeader = "FTL-Request-ID"
+	// DeadlineHeader is the header used to pass the absolute deadline of the
+	// inbound request, in RFC3339 format.
+	DeadlineHeader = "FTL-Deadline"
+	// DeploymentHeader is the header used to pass the deployment a call is
+	// routed to, so that a Runner hosting several deployments can dispatch it.
+	DeploymentHeader = "FTL-Deployment"
+	// ClientAddrHeader is the header used to pass the address of the client
+	// that originated a request, so that it survives hops through Runners.
+	ClientAddrHeader = "FTL-Client-Addr"
+	// DrainingHeader is set on the errors a Runner returns for calls that are
+	// rejected because their deployment is draining, so that the Controller
+	// can route them elsewhere.
+	DrainingHeader = "FTL-Draining"
 )
 
 func IsDirectRouted(header http.Header) bool {
@@ -29,6 +43,14 @@ func SetDirectRouted(header http.Header) {
 	header.Set(DirectRoutingHeader, "1")
 }
 
+func IsDraining(header http.Header) bool {
+	return header.Get(DrainingHeader) != ""
+}
+
+func SetDraining(header http.Header) {
+	header.Set(DrainingHeader, "1")
+}
+
 func SetRequestName(header http.Header, key model.RequestName) {
 	header.Set(RequestIDHeader, key.String())
 }
@@ -49,6 +71,56 @@ func GetRequestName(header http.Header) (model.RequestName, bool, error) {
 	return key, true, nil
 }
 
+func SetDeadline(header http.Header, deadline time.Time) {
+	header.Set(DeadlineHeader, deadline.UTC().Format(time.RFC3339Nano))
+}
+
+// GetDeadline from an incoming request.
+//
+// Will return (time.Time{}, false, nil) if no deadline is present.
+func GetDeadline(header http.Header) (time.Time, bool, error) {
+	value := header.Get(DeadlineHeader)
+	if value == "" {
+		return time.Time{}, false, nil
+	}
+	deadline, err := time.Parse(time.RFC3339Nano, value)
+	if err != nil {
+		return time.Time{}, false, fmt.Errorf("invalid %s header %q: %w", DeadlineHeader, value, err)
+	}
+	return deadline, true, nil
+}
+
+func SetDeployment(header http.Header, deployment model.DeploymentName) {
+	header.Set(DeploymentHeader, deployment.String())
+}
+
+// GetDeployment a request is routed to.
+//
+// Will return ("", false, nil) if no deployment is present.
+func GetDeployment(header http.Header) (model.DeploymentName, bool, error) {
+	value := header.Get(DeploymentHeader)
+	if value == "" {
+		return "", false, nil
+	}
+	deployment, err := model.ParseDeploymentName(value)
+	if err != nil {
+		return "", false, fmt.Errorf("invalid %s header %q: %w", DeploymentHeader, value, err)
+	}
+	return deployment, true, nil
+}
+
+func SetClientAddr(header http.Header, addr string) {
+	header.Set(ClientAddrHeader, addr)
+}
+
+// GetClientAddr of the client that originated a request.
+//
+// Will return ("", false) if no client address is present.
+func GetClientAddr(header http.Header) (string, bool) {
+	addr := header.Get(ClientAddrHeader)
+	return addr, addr != ""
+}
+
 // GetCallers history from an incoming request.
 func GetCallers(header http.Header) ([]*schema.VerbRef, error) {
 	headers := header.Values(VerbHeader)
@@ -68,3 +68,15 @@ func FlatMap[T, U any](slice []T, fn func(T) []U) []U {
 	}
 	return result
 }
+
+// Find returns the first element in a slice for which the given function
+// returns true.
+func Find[T any](slice []T, fn func(T) bool) (T, bool) {
+	for _, v := range slice {
+		if fn(v) {
+			return v, true
+		}
+	}
+	var zero T
+	return zero, false
+}
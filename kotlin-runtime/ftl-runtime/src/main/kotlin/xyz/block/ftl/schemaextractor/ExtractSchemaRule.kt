@@ -318,7 +318,13 @@ class SchemaExtractor(
   private fun extractPathComponents(path: String): List<IngressPathComponent> {
     return path.split("/").filter { it.isNotEmpty() }.map { part ->
       if (part.startsWith("{") && part.endsWith("}")) {
-        IngressPathComponent(ingressPathParameter = IngressPathParameter(name = part.substring(1, part.length - 1)))
+        val name = part.substring(1, part.length - 1)
+        IngressPathComponent(
+          ingressPathParameter = IngressPathParameter(
+            name = name.removeSuffix("..."),
+            catchAll = name.endsWith("..."),
+          )
+        )
       } else {
         IngressPathComponent(ingressPathLiteral = IngressPathLiteral(text = part))
       }